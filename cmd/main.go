@@ -1,16 +1,21 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"os/signal"
 	"runtime"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/urfave/cli/v2"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
 	"github.com/v8platform/ras-grpc-gw/pkg/health"
 	"github.com/v8platform/ras-grpc-gw/pkg/logger"
 	ras "github.com/v8platform/ras-grpc-gw/pkg/server"
@@ -49,7 +54,7 @@ func main() {
 				Name: "Aleksey Khorev",
 			},
 		},
-		UsageText:   "ras-grpc-wg [OPTIONS] [HOST:PORT]",
+		UsageText:   "ras-grpc-wg [OPTIONS] [HOST:PORT...]",
 		Copyright:   "(c) 2021 Khorevaa",
 		Description: "GRPC gateway for RAS 1S.Enterprise",
 		Flags: []cli.Flag{
@@ -58,6 +63,16 @@ func main() {
 				Value: ":3002",
 				Usage: "host:port to bind grpc server",
 			},
+			&cli.StringSliceFlag{
+				Name:    "cluster",
+				Usage:   "static route cluster_id=host:port to the RAS serving the cluster",
+				EnvVars: []string{"RAS_CLUSTERS"},
+			},
+			&cli.StringSliceFlag{
+				Name:    "allow-host",
+				Usage:   "host:port of a RAS service clients may register in addition to the arguments",
+				EnvVars: []string{"RAS_ALLOWED_HOSTS"},
+			},
 			&cli.StringFlag{
 				Name:    "health",
 				Value:   "0.0.0.0:8080",
@@ -65,6 +80,14 @@ func main() {
 				EnvVars: []string{"HEALTH_ADDR"},
 			},
 		},
+		Commands: []*cli.Command{
+			{
+				Name:      "hash-password",
+				Usage:     "print the hash of the password read from stdin for AUTH_USERS_FILE",
+				UsageText: "echo -n secret | ras-grpc-gw hash-password",
+				Action:    hashPassword,
+			},
+		},
 		Action: runServer,
 	}
 
@@ -73,30 +96,64 @@ func main() {
 	}
 }
 
+func hashPassword(c *cli.Context) error {
+	password, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+	password = strings.TrimRight(password, "\r\n")
+	if password == "" {
+		return fmt.Errorf("empty password")
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hash)
+	return nil
+}
+
 func runServer(c *cli.Context) error {
 	rasAddr := "localhost:1545"
 	if c.Args().Present() {
 		rasAddr = c.Args().First()
 	}
+	// Остальные адреса RAS выбираются клиентом по ras_host или cluster_id
+	var rasHosts []string
+	if c.Args().Len() > 1 {
+		rasHosts = c.Args().Tail()
+	}
 
 	bindAddr := c.String("bind")
 	healthAddr := c.String("health")
 
 	logger.Log.Info("Configuration",
 		zap.String("ras_addr", rasAddr),
+		zap.Strings("ras_hosts", rasHosts),
+		zap.Strings("allowed_hosts", c.StringSlice("allow-host")),
 		zap.String("bind_addr", bindAddr),
 		zap.String("health_addr", healthAddr),
 	)
 
 	// Создание gRPC сервера
-	server := ras.NewRASServer(rasAddr)
+	server := ras.NewRASServer(rasAddr, rasHosts...)
+	server.AllowHosts(c.StringSlice("allow-host")...)
 
-	// Создание HTTP health check сервера
-	healthSrv := health.NewServer(healthAddr, server)
+	for _, route := range c.StringSlice("cluster") {
+		clusterID, host, ok := strings.Cut(route, "=")
+		if !ok || clusterID == "" || host == "" {
+			return fmt.Errorf("invalid cluster route %q, want cluster_id=host:port", route)
+		}
+		if host != rasAddr && !slices.Contains(rasHosts, host) {
+			return fmt.Errorf("cluster route %q points to RAS host not listed in arguments", route)
+		}
+		server.Clusters().Register(clusterID, host)
+	}
 
-	// Регистрация дополнительных HTTP endpoints
-	// POST /api/v1/sessions/terminate - для cluster-service HTTP client
-	healthSrv.SetHandler("/api/v1/sessions/terminate", server.GetTerminateSessionHandler())
+	// Создание HTTP health check сервера
+	healthSrv := newHealthServer(healthAddr, server)
 
 	// Канал для ошибок серверов
 	serverErrors := make(chan error, 2)
@@ -152,3 +209,14 @@ func runServer(c *cli.Context) error {
 
 	return nil
 }
+
+// newHealthServer создает HTTP сервер health checks с дополнительными endpoints шлюза
+func newHealthServer(addr string, server *ras.RASServer) *health.Server {
+	healthSrv := health.NewServer(addr, server)
+
+	// Регистрация дополнительных HTTP endpoints
+	// POST /api/v1/sessions/terminate - для cluster-service HTTP client
+	healthSrv.SetHandler("/api/v1/sessions/terminate", server.GetTerminateSessionHandler())
+
+	return healthSrv
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	access_service "github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	ras "github.com/v8platform/ras-grpc-gw/pkg/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestMain(m *testing.M) {
+	_ = logger.Init(false)
+	m.Run()
+}
+
+// serveGateway starts server on a free local port and returns the address
+func serveGateway(t *testing.T, server *ras.RASServer) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(addr) }()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.GracefulStop(ctx)
+	})
+
+	require.Eventually(t, func() bool {
+		select {
+		case err := <-served:
+			require.NoError(t, err)
+		default:
+		}
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			return false
+		}
+		_ = conn.Close()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return addr
+}
+
+func postTerminate(handler http.Handler, token, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/terminate", strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestHealthServer_TerminateRouteServedAfterStart(t *testing.T) {
+	t.Setenv("AUTH_ENABLED", "")
+	t.Setenv("TLS_ENABLED", "")
+
+	server := ras.NewRASServer("127.0.0.1:1")
+	// Registered the way runServer does, before the gRPC server starts
+	handler := newHealthServer("", server).Handler()
+
+	w := postTerminate(handler, "", "{")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code, "service is not configured before Serve")
+
+	serveGateway(t, server)
+
+	require.Eventually(t, func() bool {
+		w = postTerminate(handler, "", "{")
+		return w.Code != http.StatusServiceUnavailable
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, http.StatusBadRequest, w.Code, "the request must reach the terminate handler")
+	assert.Contains(t, w.Body.String(), "invalid request body")
+}
+
+func TestHealthServer_TerminateRouteForbidsViewer(t *testing.T) {
+	hash, err := auth.HashPassword("secret")
+	require.NoError(t, err)
+	users := filepath.Join(t.TempDir(), "users.yaml")
+	data := "users:\n  - name: viewer\n    password_hash: " + hash + "\n    roles: [viewer]\n"
+	require.NoError(t, os.WriteFile(users, []byte(data), 0o600))
+
+	t.Setenv("AUTH_ENABLED", "true")
+	t.Setenv("AUTH_USERS_FILE", users)
+	t.Setenv("TLS_ENABLED", "")
+
+	server := ras.NewRASServer("127.0.0.1:1")
+	handler := newHealthServer("", server).Handler()
+	addr := serveGateway(t, server)
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	resp, err := access_service.NewTokenServiceClient(conn).GetToken(context.Background(),
+		&access_service.GetTokenRequest{User: "viewer", Password: "secret"})
+	require.NoError(t, err)
+
+	body := `{"cluster_id": "cluster-a", "session_id": "s-1"}`
+
+	var w *httptest.ResponseRecorder
+	require.Eventually(t, func() bool {
+		w = postTerminate(handler, resp.GetAccessToken(), body)
+		return w.Code != http.StatusServiceUnavailable
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+
+	w = postTerminate(handler, "", body)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
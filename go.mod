@@ -7,10 +7,14 @@ require (
 	github.com/spf13/cast v1.4.1
 	github.com/stretchr/testify v1.8.1
 	github.com/urfave/cli/v2 v2.3.0
+	github.com/v8platform/encoder v0.0.3
+	github.com/v8platform/protoc-gen-go-ras v0.0.0-20210902165457-013367855358
 	github.com/v8platform/protos v0.2.0
+	go.etcd.io/bbolt v1.3.11
 	go.uber.org/zap v1.27.0
 	google.golang.org/grpc v1.68.1
 	google.golang.org/protobuf v1.36.10
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -23,12 +27,9 @@ require (
 	github.com/satori/go.uuid v1.2.0 // indirect
 	github.com/shurcooL/sanitized_anchor_name v1.0.0 // indirect
 	github.com/stretchr/objx v0.5.0 // indirect
-	github.com/v8platform/encoder v0.0.3 // indirect
-	github.com/v8platform/protoc-gen-go-ras v0.0.0-20210902165457-013367855358 // indirect
 	go.uber.org/multierr v1.11.0 // indirect
 	golang.org/x/net v0.29.0 // indirect
 	golang.org/x/sys v0.25.0 // indirect
 	golang.org/x/text v0.18.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
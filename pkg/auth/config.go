@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/repository"
+	"go.uber.org/zap"
+)
+
+// secretFileName holds the generated secret inside the data dir
+const secretFileName = "token.secret"
+
+// Config holds the token service settings
+type Config struct {
+	Enabled    bool
+	UsersFile  string
+	PolicyFile string
+	DataDir    string // Issued tokens are kept here, in memory if empty
+	Secret     []byte
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// LoadConfig loads the token service settings from environment variables.
+// A missing secret is generated, and kept in the data dir if there is one.
+//
+// Environment variables:
+//
+//	AUTH_ENABLED=true             - Require tokens on all RAS services, needs AUTH_USERS_FILE
+//	AUTH_USERS_FILE=/path         - YAML file with users
+//	AUTH_POLICY_FILE=/path        - YAML file with the role policy
+//	AUTH_DATA_DIR=/path           - Directory of the issued tokens database
+//	AUTH_TOKEN_SECRET=...         - Secret signing the tokens
+//	AUTH_TOKEN_SECRET_FILE=/path  - File holding the secret
+//	AUTH_ACCESS_TTL=15m           - Access token lifetime
+//	AUTH_REFRESH_TTL=24h          - Refresh token lifetime
+func LoadConfig(logger *zap.Logger) (*Config, error) {
+
+	cfg := &Config{
+		Enabled:    os.Getenv("AUTH_ENABLED") == "true",
+		UsersFile:  os.Getenv("AUTH_USERS_FILE"),
+		PolicyFile: os.Getenv("AUTH_POLICY_FILE"),
+		DataDir:    os.Getenv("AUTH_DATA_DIR"),
+	}
+
+	var err error
+	if cfg.AccessTTL, err = durationEnv("AUTH_ACCESS_TTL", DefaultAccessTTL); err != nil {
+		return nil, err
+	}
+	if cfg.RefreshTTL, err = durationEnv("AUTH_REFRESH_TTL", DefaultRefreshTTL); err != nil {
+		return nil, err
+	}
+
+	switch secret, secretFile := os.Getenv("AUTH_TOKEN_SECRET"), os.Getenv("AUTH_TOKEN_SECRET_FILE"); {
+	case secret != "":
+		cfg.Secret = []byte(secret)
+	case secretFile != "":
+		data, err := os.ReadFile(secretFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token secret: %w", err)
+		}
+		cfg.Secret = []byte(strings.TrimSpace(string(data)))
+	case cfg.DataDir != "":
+		if cfg.Secret, err = loadOrCreateSecret(filepath.Join(cfg.DataDir, secretFileName)); err != nil {
+			return nil, err
+		}
+	default:
+		logger.Warn("AUTH_TOKEN_SECRET not set, using a random secret - tokens are invalidated on restart")
+		cfg.Secret = randomSecret()
+	}
+
+	// Without users no token is ever issued and every call would be rejected
+	if cfg.Enabled && cfg.UsersFile == "" {
+		return nil, errors.New("AUTH_ENABLED=true requires AUTH_USERS_FILE")
+	}
+	if cfg.UsersFile == "" {
+		logger.Warn("AUTH_USERS_FILE not set, TokenService rejects all credentials")
+	}
+	if cfg.DataDir == "" {
+		logger.Warn("AUTH_DATA_DIR not set, issued tokens are kept in memory and lost on restart")
+	}
+
+	return cfg, nil
+}
+
+// NewManager creates the token manager described by the config.
+// Close the manager to release the token database.
+func (c *Config) NewManager() (*Manager, error) {
+
+	opts := []ManagerOption{WithAccessTTL(c.AccessTTL), WithRefreshTTL(c.RefreshTTL)}
+
+	if c.DataDir != "" {
+		repo, err := repository.OpenBoltTokenRepository(c.DataDir)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithRepository(repo))
+
+		m, err := NewManager(c.Secret, opts...)
+		if err != nil {
+			_ = repo.Close()
+			return nil, err
+		}
+		return m, nil
+	}
+
+	return NewManager(c.Secret, opts...)
+}
+
+// NewUserStore loads the users of the config, without a users file
+// the store is empty
+func (c *Config) NewUserStore() (UserStore, error) {
+	if c.UsersFile == "" {
+		return NoUsers, nil
+	}
+	return LoadUserFile(c.UsersFile)
+}
+
+// NewPolicy loads the role policy of the config,
+// without a policy file DefaultPolicy applies
+func (c *Config) NewPolicy() (*Policy, error) {
+	if c.PolicyFile == "" {
+		return DefaultPolicy(), nil
+	}
+	return LoadPolicyFile(c.PolicyFile)
+}
+
+// NoUsers is a UserStore rejecting all credentials
+var NoUsers UserStore = noUsers{}
+
+type noUsers struct{}
+
+func (noUsers) Authenticate(context.Context, string, string) (User, error) {
+	return User{}, ErrInvalidCredentials
+}
+
+// loadOrCreateSecret reads the hex secret at path, generating it on first use
+func loadOrCreateSecret(path string) ([]byte, error) {
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		secret, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid token secret %s: %w", path, err)
+		}
+		return secret, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read token secret: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create data dir: %w", err)
+	}
+
+	secret := randomSecret()
+	if err := os.WriteFile(path, []byte(hex.EncodeToString(secret)), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write token secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+func durationEnv(name string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s %q: want a positive duration like 15m", name, value)
+	}
+	return d, nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	t.Setenv("AUTH_TOKEN_SECRET", "")
+	t.Setenv("AUTH_TOKEN_SECRET_FILE", "")
+	t.Setenv("AUTH_DATA_DIR", "")
+	t.Setenv("AUTH_ENABLED", "")
+	t.Setenv("AUTH_ACCESS_TTL", "")
+
+	cfg, err := LoadConfig(zap.NewNop())
+	require.NoError(t, err)
+	assert.False(t, cfg.Enabled, "authentication is opt-in")
+	assert.Equal(t, DefaultAccessTTL, cfg.AccessTTL)
+	assert.Len(t, cfg.Secret, MinSecretSize)
+}
+
+func TestLoadConfig_EnabledRequiresUsers(t *testing.T) {
+	t.Setenv("AUTH_TOKEN_SECRET", "")
+	t.Setenv("AUTH_DATA_DIR", "")
+	t.Setenv("AUTH_ENABLED", "true")
+	t.Setenv("AUTH_USERS_FILE", "")
+
+	_, err := LoadConfig(zap.NewNop())
+	assert.ErrorContains(t, err, "AUTH_USERS_FILE")
+
+	t.Setenv("AUTH_USERS_FILE", "/etc/ras-grpc-gw/users.yaml")
+	cfg, err := LoadConfig(zap.NewNop())
+	require.NoError(t, err)
+	assert.True(t, cfg.Enabled)
+}
+
+func TestLoadConfig_KeepsSecretInDataDir(t *testing.T) {
+	t.Setenv("AUTH_TOKEN_SECRET", "")
+	t.Setenv("AUTH_TOKEN_SECRET_FILE", "")
+	t.Setenv("AUTH_DATA_DIR", t.TempDir())
+	t.Setenv("AUTH_ACCESS_TTL", "5m")
+
+	first, err := LoadConfig(zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, first.AccessTTL)
+
+	second, err := LoadConfig(zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, first.Secret, second.Secret, "the generated secret is reused after restart")
+}
+
+func TestLoadConfig_InvalidTTL(t *testing.T) {
+	t.Setenv("AUTH_ACCESS_TTL", "soon")
+
+	_, err := LoadConfig(zap.NewNop())
+	assert.Error(t, err)
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "context"
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying the caller identity
+func NewContext(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the caller identity stored by NewContext
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
@@ -0,0 +1,59 @@
+// Package auth issues and validates the tokens of the gateway's TokenService.
+//
+// # Overview
+//
+// Clients exchange a user name and password for a pair of tokens:
+//   - Access token: short-lived, HMAC-SHA256 signed JWT carrying the user and roles
+//   - Refresh token: opaque random string, valid once and rotated on every refresh
+//
+// Every issued token is recorded in a domain.TokenRepository: access tokens
+// by their jti claim, refresh tokens by their SHA-256 digest. With
+// AUTH_DATA_DIR the records are kept in a bbolt database and survive
+// restarts, otherwise they live in memory. Presenting a refresh token that
+// was already rotated revokes the whole chain it belongs to, as the token
+// has leaked.
+//
+// # Users
+//
+// Credentials are checked by a UserStore. The bundled FileUserStore reads a
+// YAML file with PBKDF2-SHA256 password hashes:
+//
+//	users:
+//	  - name: admin
+//	    password_hash: pbkdf2-sha256$600000$<salt>$<hash>
+//	    roles: [admin]
+//
+// Hashes are produced by HashPassword, e.g. via "ras-grpc-gw hash-password".
+//
+// # Roles
+//
+// A Policy grants roles the right to call methods, optionally limited to
+// clusters and infobases. Method, cluster and infobase patterns are globs:
+//
+//	roles:
+//	  viewer:
+//	    rules:
+//	      - methods: ["*/Get*"]
+//	  operator:
+//	    include: [viewer]
+//	    rules:
+//	      - methods: ["/ras.service.api.v1.SessionsService/TerminateSession"]
+//	        clusters: ["test-*"]
+//
+// Without AUTH_POLICY_FILE the DefaultPolicy roles viewer, operator and
+// admin apply.
+//
+// # Environment Variables
+//
+//	AUTH_ENABLED             Require tokens on all RAS services (default false), requires AUTH_USERS_FILE
+//	AUTH_USERS_FILE          Path to the YAML user file
+//	AUTH_POLICY_FILE         Path to the YAML role policy
+//	AUTH_DATA_DIR            Directory of the issued tokens database
+//	AUTH_TOKEN_SECRET        Secret signing the access tokens
+//	AUTH_TOKEN_SECRET_FILE   File holding the secret, used if AUTH_TOKEN_SECRET is empty
+//	AUTH_ACCESS_TTL          Access token lifetime (default 15m)
+//	AUTH_REFRESH_TTL         Refresh token lifetime (default 24h)
+//
+// Without a secret a random one is generated and stored in AUTH_DATA_DIR;
+// without a data dir either, tokens do not survive a restart.
+package auth
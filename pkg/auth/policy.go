@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Built-in roles of DefaultPolicy
+const (
+	RoleViewer   = "viewer"
+	RoleOperator = "operator"
+	RoleAdmin    = "admin"
+)
+
+// Policy maps roles to the calls they may make. Anything not allowed
+// by a rule of one of the caller's roles is denied.
+type Policy struct {
+	Roles map[string]Role `yaml:"roles"`
+}
+
+// Role is a named set of rules, optionally extending other roles
+type Role struct {
+	Include []string `yaml:"include"`
+	Rules   []Rule   `yaml:"rules"`
+}
+
+// Rule allows the methods matching one of Methods on the clusters and
+// infobases matching Clusters and Infobases. An empty list matches anything.
+// Patterns are globs where "*" matches any string and "?" any character.
+type Rule struct {
+	Methods   []string `yaml:"methods"`
+	Clusters  []string `yaml:"clusters"`
+	Infobases []string `yaml:"infobases"`
+}
+
+// Request is a call checked against the policy
+type Request struct {
+	Method     string // Full gRPC method name
+	ClusterID  string
+	InfobaseID string
+}
+
+// DefaultPolicy is used without a policy file:
+//   - viewer reads clusters, infobases and sessions
+//   - operator also terminates sessions and manages locks
+//   - admin may call everything
+func DefaultPolicy() *Policy {
+	return &Policy{Roles: map[string]Role{
+		RoleViewer: {Rules: []Rule{{Methods: []string{
+			"/ras.service.api.v1.AuthService/*",
+			"/ras.service.api.v1.RASService/Authenticate*",
+			"*/Get*",
+			"*/List*",
+			"*/Watch*",
+		}}}},
+		RoleOperator: {Include: []string{RoleViewer}, Rules: []Rule{{Methods: []string{
+			"*/Terminate*",
+			"*/Disconnect*",
+			"*/Lock*",
+			"*/Unlock*",
+			"*/*Maintenance",
+			"*/*ScheduledLock*",
+		}}}},
+		RoleAdmin: {Rules: []Rule{{Methods: []string{"*"}}}},
+	}}
+}
+
+// LoadPolicyFile reads a policy from the YAML file at path
+func LoadPolicyFile(path string) (*Policy, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	policy := &Policy{}
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// Validate checks that rules name methods and included roles exist
+// without cycles
+func (p *Policy) Validate() error {
+
+	if len(p.Roles) == 0 {
+		return errors.New("no roles defined")
+	}
+
+	names := make([]string, 0, len(p.Roles))
+	for name := range p.Roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		role := p.Roles[name]
+		for i, rule := range role.Rules {
+			if len(rule.Methods) == 0 {
+				return fmt.Errorf("role %q: rule %d has no methods", name, i+1)
+			}
+		}
+		if err := p.checkIncludes(name, map[string]bool{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Policy) checkIncludes(name string, path map[string]bool) error {
+
+	if path[name] {
+		return fmt.Errorf("role %q includes itself", name)
+	}
+	path[name] = true
+	defer delete(path, name)
+
+	for _, include := range p.Roles[name].Include {
+		if _, ok := p.Roles[include]; !ok {
+			return fmt.Errorf("role %q includes unknown role %q", name, include)
+		}
+		if err := p.checkIncludes(include, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Allowed reports whether any of roles permits the request
+func (p *Policy) Allowed(roles []string, req Request) bool {
+	seen := make(map[string]bool)
+	for _, role := range roles {
+		if p.roleAllows(role, req, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) roleAllows(name string, req Request, seen map[string]bool) bool {
+
+	if seen[name] {
+		return false
+	}
+	seen[name] = true
+
+	role, ok := p.Roles[name]
+	if !ok {
+		return false
+	}
+
+	for _, rule := range role.Rules {
+		if rule.allows(req) {
+			return true
+		}
+	}
+
+	for _, include := range role.Include {
+		if p.roleAllows(include, req, seen) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) allows(req Request) bool {
+	return matchAny(r.Methods, req.Method) &&
+		(len(r.Clusters) == 0 || matchAny(r.Clusters, req.ClusterID)) &&
+		(len(r.Infobases) == 0 || matchAny(r.Infobases, req.InfobaseID))
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern matches value against a glob with "*" and "?".
+// Unlike path.Match, "*" also matches "/" inside method names.
+func matchPattern(pattern, value string) bool {
+
+	p, v := 0, 0
+	star, mark := -1, 0
+
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, v
+			p++
+		case star >= 0:
+			p = star + 1
+			mark++
+			v = mark
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		match          bool
+	}{
+		{"*", "", true},
+		{"*", "/a.B/C", true},
+		{"*/Get*", "/ras.service.api.v1.ClustersService/GetClusters", true},
+		{"*/Get*", "/ras.service.api.v1.ClustersService/RegCluster", false},
+		{"prod-*", "prod-1", true},
+		{"prod-*", "test-1", false},
+		{"prod-?", "prod-12", false},
+		{"a*b*c", "aXbYbZc", true},
+		{"a*b*c", "aXbYbZ", false},
+		{"exact", "exact", true},
+		{"", "x", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, matchPattern(tt.pattern, tt.value), "%q ~ %q", tt.pattern, tt.value)
+	}
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	policy := DefaultPolicy()
+	require.NoError(t, policy.Validate())
+
+	getClusters := Request{Method: "/ras.service.api.v1.ClustersService/GetClusters"}
+	terminate := Request{Method: "/ras.service.api.v1.SessionsService/TerminateSession", ClusterID: "c1"}
+	drop := Request{Method: "/infobase.service.InfobaseManagementService/DropInfobase", ClusterID: "c1", InfobaseID: "ib1"}
+
+	assert.True(t, policy.Allowed([]string{RoleViewer}, getClusters))
+	assert.False(t, policy.Allowed([]string{RoleViewer}, terminate))
+	assert.False(t, policy.Allowed([]string{RoleViewer}, drop))
+
+	assert.True(t, policy.Allowed([]string{RoleOperator}, getClusters), "operator includes viewer")
+	assert.True(t, policy.Allowed([]string{RoleOperator}, terminate))
+	assert.False(t, policy.Allowed([]string{RoleOperator}, drop))
+
+	assert.True(t, policy.Allowed([]string{RoleAdmin}, drop))
+
+	assert.False(t, policy.Allowed(nil, getClusters))
+	assert.False(t, policy.Allowed([]string{"unknown"}, getClusters))
+	assert.True(t, policy.Allowed([]string{"unknown", RoleViewer}, getClusters))
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	data := `
+roles:
+  viewer:
+    rules:
+      - methods: ["*/Get*"]
+  test-operator:
+    include: [viewer]
+    rules:
+      - methods: ["*/DropInfobase"]
+        clusters: ["test-*"]
+        infobases: ["sandbox-*"]
+`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	policy, err := LoadPolicyFile(path)
+	require.NoError(t, err)
+
+	roles := []string{"test-operator"}
+	drop := "/infobase.service.InfobaseManagementService/DropInfobase"
+
+	assert.True(t, policy.Allowed(roles, Request{Method: drop, ClusterID: "test-1", InfobaseID: "sandbox-1"}))
+	assert.False(t, policy.Allowed(roles, Request{Method: drop, ClusterID: "prod-1", InfobaseID: "sandbox-1"}))
+	assert.False(t, policy.Allowed(roles, Request{Method: drop, ClusterID: "test-1", InfobaseID: "accounting"}))
+	assert.False(t, policy.Allowed(roles, Request{Method: drop}), "scoped rules need the scope")
+	assert.True(t, policy.Allowed(roles, Request{Method: "/x.Service/GetThing", ClusterID: "prod-1"}))
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	tests := map[string]*Policy{
+		"empty":          {},
+		"no methods":     {Roles: map[string]Role{"a": {Rules: []Rule{{Clusters: []string{"*"}}}}}},
+		"unknown role":   {Roles: map[string]Role{"a": {Include: []string{"b"}}}},
+		"include cycle":  {Roles: map[string]Role{"a": {Include: []string{"b"}}, "b": {Include: []string{"a"}}}},
+		"include itself": {Roles: map[string]Role{"a": {Include: []string{"a"}}}},
+	}
+
+	for name, policy := range tests {
+		assert.Error(t, policy.Validate(), name)
+	}
+}
@@ -0,0 +1,437 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/domain"
+	"github.com/v8platform/ras-grpc-gw/pkg/repository"
+)
+
+const (
+	// DefaultAccessTTL is the lifetime of access tokens
+	DefaultAccessTTL = 15 * time.Minute
+	// DefaultRefreshTTL is the lifetime of refresh tokens
+	DefaultRefreshTTL = 24 * time.Hour
+
+	// MinSecretSize is the shortest accepted signing secret
+	MinSecretSize = 32
+
+	tokenIssuer = "ras-grpc-gw"
+
+	sweepInterval = time.Minute
+	fetchPageSize = 256
+)
+
+var (
+	// ErrInvalidToken is returned for malformed or forged tokens
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrTokenExpired is returned for tokens past their lifetime
+	ErrTokenExpired = errors.New("token expired")
+	// ErrTokenRevoked is returned for refresh tokens that were already used
+	ErrTokenRevoked = errors.New("token revoked")
+)
+
+// accessHeader is the only JWT header accepted, which rules out "alg": "none"
+var accessHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Identity is the principal an access token was issued to
+type Identity struct {
+	Subject   string
+	Roles     []string
+	TokenID   string
+	ExpiresAt time.Time
+}
+
+// TokenPair is the result of a login or a refresh
+type TokenPair struct {
+	AccessToken      string
+	RefreshToken     string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
+type accessClaims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Roles     []string `json:"roles,omitempty"`
+	IssuedAt  int64    `json:"iat"`
+	ExpiresAt int64    `json:"exp"`
+	ID        string   `json:"jti"`
+}
+
+// Manager issues, validates and rotates tokens. Issued tokens are
+// recorded in a domain.TokenRepository, so they can be revoked.
+type Manager struct {
+	signKey    []byte
+	hashKey    []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex // Serializes issuing and rotation of tokens
+	tokens    domain.TokenRepository
+	lastSweep time.Time
+}
+
+// ManagerOption configures the token manager
+type ManagerOption func(*Manager)
+
+// WithAccessTTL sets the lifetime of access tokens
+func WithAccessTTL(ttl time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.accessTTL = ttl
+	}
+}
+
+// WithRepository records the issued tokens in repo instead of memory
+func WithRepository(repo domain.TokenRepository) ManagerOption {
+	return func(m *Manager) {
+		m.tokens = repo
+	}
+}
+
+// WithRefreshTTL sets the lifetime of refresh tokens
+func WithRefreshTTL(ttl time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.refreshTTL = ttl
+	}
+}
+
+// NewManager creates a token manager signing with secret
+func NewManager(secret []byte, opts ...ManagerOption) (*Manager, error) {
+
+	if len(secret) < MinSecretSize {
+		return nil, fmt.Errorf("token secret must be at least %d bytes", MinSecretSize)
+	}
+
+	m := &Manager{
+		signKey:    deriveKey(secret, "access-token"),
+		hashKey:    deriveKey(secret, "request-hash"),
+		accessTTL:  DefaultAccessTTL,
+		refreshTTL: DefaultRefreshTTL,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.accessTTL <= 0 || m.refreshTTL <= 0 {
+		return nil, errors.New("token lifetimes must be positive")
+	}
+	if m.tokens == nil {
+		m.tokens = repository.NewMemoryTokenRepository()
+	}
+
+	return m, nil
+}
+
+// NewRandomManager creates a token manager with a random secret,
+// its tokens are worthless after a restart
+func NewRandomManager(opts ...ManagerOption) (*Manager, error) {
+	return NewManager(randomSecret(), opts...)
+}
+
+// Issue creates a new token pair for user
+func (m *Manager) Issue(ctx context.Context, user User) (TokenPair, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.issue(ctx, user, rand.Text(), "")
+}
+
+// Validate checks the signature, lifetime and revocation of an access token
+func (m *Manager) Validate(ctx context.Context, token string) (Identity, error) {
+
+	claims, err := m.parseAccess(token)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	expiresAt := time.Unix(claims.ExpiresAt, 0)
+	if !m.now().Before(expiresAt) {
+		return Identity{}, ErrTokenExpired
+	}
+
+	t, err := m.tokens.GetByID(ctx, claims.ID)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		return Identity{}, ErrInvalidToken
+	}
+	if err != nil {
+		return Identity{}, err
+	}
+	if t.Revoked {
+		return Identity{}, ErrTokenRevoked
+	}
+
+	return Identity{
+		Subject:   claims.Subject,
+		Roles:     claims.Roles,
+		TokenID:   claims.ID,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Refresh exchanges a refresh token for a new pair. Each refresh token
+// is accepted once; reusing one revokes every token of its login.
+// A non-empty accessToken, expired or not, must belong to the same user.
+func (m *Manager) Refresh(ctx context.Context, refreshToken, accessToken string) (TokenPair, error) {
+
+	var subject string
+	if accessToken != "" {
+		claims, err := m.parseAccess(accessToken)
+		if err != nil {
+			return TokenPair{}, err
+		}
+		subject = claims.Subject
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rt, err := m.tokens.GetByID(ctx, digest(refreshToken))
+	if errors.Is(err, domain.ErrTokenNotFound) || err == nil && rt.Kind != domain.RefreshTokenKind {
+		return TokenPair{}, ErrInvalidToken
+	}
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	if rt.Revoked {
+		return TokenPair{}, ErrTokenRevoked
+	}
+
+	if rt.Rotated {
+		if err := m.revokeFamily(ctx, rt.Family); err != nil {
+			return TokenPair{}, err
+		}
+		return TokenPair{}, ErrTokenRevoked
+	}
+
+	if rt.Expired(m.now()) {
+		return TokenPair{}, ErrTokenExpired
+	}
+
+	if subject != "" && subject != rt.Subject {
+		return TokenPair{}, ErrInvalidToken
+	}
+
+	rt.Rotated = true
+	if err := m.tokens.Update(ctx, &rt); err != nil {
+		return TokenPair{}, err
+	}
+
+	return m.issue(ctx, User{Name: rt.Subject, Roles: rt.Scopes}, rt.Family, rt.ID)
+}
+
+// Revoke invalidates the token with the given ID (the jti of an access
+// token) and every other token of the same login
+func (m *Manager) Revoke(ctx context.Context, tokenID string) error {
+
+	t, err := m.tokens.GetByID(ctx, tokenID)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		return ErrInvalidToken
+	}
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.revokeFamily(ctx, t.Family)
+}
+
+// Close releases the token repository
+func (m *Manager) Close() error {
+	if closer, ok := m.tokens.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Hash signs token for the hash field of TokenService messages
+func (m *Manager) Hash(token string) string {
+	mac := hmac.New(sha256.New, m.hashKey)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckHash reports whether hash is the signature of token
+func (m *Manager) CheckHash(token, hash string) bool {
+	return hmac.Equal([]byte(m.Hash(token)), []byte(hash))
+}
+
+func (m *Manager) issue(ctx context.Context, user User, family, parentID string) (TokenPair, error) {
+
+	now := m.now()
+	m.sweep(ctx, now)
+
+	claims := accessClaims{
+		Issuer:    tokenIssuer,
+		Subject:   user.Name,
+		Roles:     user.Roles,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(m.accessTTL).Unix(),
+		ID:        rand.Text(),
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	unsigned := accessHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+	access := unsigned + "." + base64.RawURLEncoding.EncodeToString(m.sign(unsigned))
+	refresh := rand.Text() + rand.Text()
+
+	pair := TokenPair{
+		AccessToken:      access,
+		RefreshToken:     refresh,
+		AccessExpiresAt:  time.Unix(claims.ExpiresAt, 0),
+		RefreshExpiresAt: now.Add(m.refreshTTL),
+	}
+
+	err = m.tokens.Store(ctx, &domain.Token{
+		ID:        claims.ID,
+		Kind:      domain.AccessTokenKind,
+		Subject:   user.Name,
+		Scopes:    user.Roles,
+		IssuedAt:  now,
+		ExpiresAt: pair.AccessExpiresAt,
+		Family:    family,
+		ParentID:  parentID,
+	})
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("failed to store access token: %w", err)
+	}
+
+	err = m.tokens.Store(ctx, &domain.Token{
+		ID:        digest(refresh),
+		Kind:      domain.RefreshTokenKind,
+		Subject:   user.Name,
+		Scopes:    user.Roles,
+		IssuedAt:  now,
+		ExpiresAt: pair.RefreshExpiresAt,
+		Family:    family,
+		ParentID:  parentID,
+	})
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("failed to store refresh token: %w", err)
+	}
+
+	return pair, nil
+}
+
+func (m *Manager) parseAccess(token string) (accessClaims, error) {
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != accessHeader {
+		return accessClaims{}, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, m.sign(parts[0]+"."+parts[1])) {
+		return accessClaims{}, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return accessClaims{}, ErrInvalidToken
+	}
+
+	var claims accessClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return accessClaims{}, ErrInvalidToken
+	}
+	if claims.Issuer != tokenIssuer || claims.Subject == "" {
+		return accessClaims{}, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+func (m *Manager) sign(data string) []byte {
+	mac := hmac.New(sha256.New, m.signKey)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// revokeFamily revokes all tokens of a login
+func (m *Manager) revokeFamily(ctx context.Context, family string) error {
+	return m.each(ctx, func(t domain.Token) error {
+		if t.Family != family || t.Revoked {
+			return nil
+		}
+		t.Revoked = true
+		return m.tokens.Update(ctx, &t)
+	})
+}
+
+// sweep drops expired tokens at most once per sweepInterval.
+// Rotated and revoked tokens are kept until they expire, so their
+// reuse is still detected.
+func (m *Manager) sweep(ctx context.Context, now time.Time) {
+
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+
+	_ = m.each(ctx, func(t domain.Token) error {
+		if t.Expired(now) {
+			return m.tokens.Delete(ctx, t.ID)
+		}
+		return nil
+	})
+}
+
+// each calls fn for all stored tokens
+func (m *Manager) each(ctx context.Context, fn func(t domain.Token) error) error {
+
+	var cursor string
+	for {
+		tokens, next, err := m.tokens.Fetch(ctx, cursor, fetchPageSize)
+		if err != nil {
+			return err
+		}
+		for _, t := range tokens {
+			if err := fn(t); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func deriveKey(secret []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func digest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomSecret() []byte {
+	secret := make([]byte, MinSecretSize)
+	_, _ = rand.Read(secret)
+	return secret
+}
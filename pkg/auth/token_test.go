@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newTestManager(t *testing.T, now *time.Time) *Manager {
+	t.Helper()
+	m, err := NewManager(testSecret, WithAccessTTL(time.Minute), WithRefreshTTL(time.Hour))
+	require.NoError(t, err)
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func TestNewManager_RejectsShortSecret(t *testing.T) {
+	_, err := NewManager([]byte("short"))
+	assert.Error(t, err)
+
+	_, err = NewManager(testSecret, WithAccessTTL(0))
+	assert.Error(t, err)
+}
+
+func TestManager_IssueAndValidate(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	pair, err := m.Issue(ctx, User{Name: "admin", Roles: []string{"admin"}})
+	require.NoError(t, err)
+	assert.NotEmpty(t, pair.RefreshToken)
+	assert.Equal(t, now.Add(time.Minute).Unix(), pair.AccessExpiresAt.Unix())
+
+	id, err := m.Validate(ctx, pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", id.Subject)
+	assert.Equal(t, []string{"admin"}, id.Roles)
+	assert.NotEmpty(t, id.TokenID)
+
+	now = now.Add(2 * time.Minute)
+	_, err = m.Validate(ctx, pair.AccessToken)
+	assert.ErrorIs(t, err, ErrTokenExpired)
+}
+
+func TestManager_ValidateRejectsForgedTokens(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	pair, err := m.Issue(ctx, User{Name: "viewer"})
+	require.NoError(t, err)
+	parts := strings.Split(pair.AccessToken, ".")
+
+	other, err := NewManager([]byte("another-secret-another-secret-00"))
+	require.NoError(t, err)
+	foreign, err := other.Issue(ctx, User{Name: "viewer"})
+	require.NoError(t, err)
+
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"ras-grpc-gw","sub":"admin","exp":9999999999}`))
+
+	for name, token := range map[string]string{
+		"empty":     "",
+		"garbage":   "not-a-token",
+		"foreign":   foreign.AccessToken,
+		"alg none":  none + "." + parts[1] + ".",
+		"payload":   parts[0] + "." + forged + "." + parts[2],
+		"signature": parts[0] + "." + parts[1] + ".AAAA",
+		"refresh":   pair.RefreshToken,
+	} {
+		_, err := m.Validate(ctx, token)
+		assert.ErrorIs(t, err, ErrInvalidToken, name)
+	}
+}
+
+func TestManager_RefreshRotates(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	first, err := m.Issue(ctx, User{Name: "operator", Roles: []string{"operator"}})
+	require.NoError(t, err)
+
+	second, err := m.Refresh(ctx, first.RefreshToken, first.AccessToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.RefreshToken, second.RefreshToken)
+
+	id, err := m.Validate(ctx, second.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "operator", id.Subject)
+	assert.Equal(t, []string{"operator"}, id.Roles)
+
+	// Expired access tokens may still accompany a refresh
+	now = now.Add(30 * time.Minute)
+	_, err = m.Refresh(ctx, second.RefreshToken, second.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestManager_RefreshReuseRevokesChain(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	first, err := m.Issue(ctx, User{Name: "operator"})
+	require.NoError(t, err)
+	second, err := m.Refresh(ctx, first.RefreshToken, "")
+	require.NoError(t, err)
+
+	other, err := m.Issue(ctx, User{Name: "operator"})
+	require.NoError(t, err)
+
+	_, err = m.Refresh(ctx, first.RefreshToken, "")
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	_, err = m.Refresh(ctx, second.RefreshToken, "")
+	assert.ErrorIs(t, err, ErrTokenRevoked, "the whole chain is revoked")
+	_, err = m.Validate(ctx, second.AccessToken)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	_, err = m.Refresh(ctx, other.RefreshToken, "")
+	assert.NoError(t, err, "other logins of the user are kept")
+}
+
+func TestManager_RefreshChecks(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	operator, err := m.Issue(ctx, User{Name: "operator"})
+	require.NoError(t, err)
+	viewer, err := m.Issue(ctx, User{Name: "viewer"})
+	require.NoError(t, err)
+
+	_, err = m.Refresh(ctx, "unknown", "")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = m.Refresh(ctx, operator.RefreshToken, viewer.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken, "access token of another user")
+
+	_, err = m.Refresh(ctx, operator.RefreshToken, "forged")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	now = now.Add(2 * time.Hour)
+	_, err = m.Refresh(ctx, operator.RefreshToken, "")
+	assert.ErrorIs(t, err, ErrTokenExpired)
+}
+
+func TestManager_Hash(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+
+	hash := m.Hash("token")
+	assert.True(t, m.CheckHash("token", hash))
+	assert.False(t, m.CheckHash("token2", hash))
+	assert.False(t, m.CheckHash("token", ""))
+}
+
+func TestManager_Revoke(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	pair, err := m.Issue(ctx, User{Name: "admin"})
+	require.NoError(t, err)
+	id, err := m.Validate(ctx, pair.AccessToken)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Revoke(ctx, id.TokenID))
+
+	_, err = m.Validate(ctx, pair.AccessToken)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+	_, err = m.Refresh(ctx, pair.RefreshToken, "")
+	assert.ErrorIs(t, err, ErrTokenRevoked, "the refresh token of the login is revoked too")
+
+	assert.ErrorIs(t, m.Revoke(ctx, "unknown"), ErrInvalidToken)
+}
+
+func TestManager_SurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	cfg := &Config{DataDir: dir, Secret: testSecret, AccessTTL: time.Minute, RefreshTTL: time.Hour}
+	m, err := cfg.NewManager()
+	require.NoError(t, err)
+	pair, err := m.Issue(ctx, User{Name: "admin", Roles: []string{"admin"}})
+	require.NoError(t, err)
+	require.NoError(t, m.Close())
+
+	m, err = cfg.NewManager()
+	require.NoError(t, err)
+	defer m.Close()
+
+	id, err := m.Validate(ctx, pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", id.Subject)
+
+	_, err = m.Refresh(ctx, pair.RefreshToken, pair.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestManager_SweepsExpiredTokens(t *testing.T) {
+	now := time.Now()
+	m := newTestManager(t, &now)
+	ctx := context.Background()
+
+	_, err := m.Issue(ctx, User{Name: "admin"})
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Hour)
+	_, err = m.Issue(ctx, User{Name: "admin"})
+	require.NoError(t, err)
+
+	tokens, _, err := m.tokens.Fetch(ctx, "", 0)
+	require.NoError(t, err)
+	assert.Len(t, tokens, 2, "only the new pair is kept")
+}
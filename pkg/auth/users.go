@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	passwordScheme     = "pbkdf2-sha256"
+	passwordIterations = 600000
+	passwordSaltSize   = 16
+	passwordKeySize    = 32
+)
+
+// ErrInvalidCredentials is returned for an unknown user or a wrong password
+var ErrInvalidCredentials = errors.New("invalid user or password")
+
+// User is an authenticated principal
+type User struct {
+	Name  string
+	Roles []string
+}
+
+// UserStore checks user credentials
+type UserStore interface {
+	// Authenticate returns the user if password matches,
+	// ErrInvalidCredentials otherwise
+	Authenticate(ctx context.Context, name, password string) (User, error)
+}
+
+// UserEntry is a user of FileUserStore
+type UserEntry struct {
+	Name         string   `yaml:"name"`
+	PasswordHash string   `yaml:"password_hash"`
+	Roles        []string `yaml:"roles"`
+}
+
+// FileUserStore keeps users with hashed passwords in memory
+type FileUserStore struct {
+	users map[string]UserEntry
+}
+
+var _ UserStore = (*FileUserStore)(nil)
+
+// dummyHash is checked for unknown users, so they take as long as known ones
+var dummyHash = sync.OnceValue(func() string {
+	hash, err := HashPassword("dummy")
+	if err != nil {
+		panic(err)
+	}
+	return hash
+})
+
+// NewFileUserStore creates a store of the given users
+func NewFileUserStore(entries ...UserEntry) (*FileUserStore, error) {
+
+	s := &FileUserStore{users: make(map[string]UserEntry, len(entries))}
+
+	for _, entry := range entries {
+		if entry.Name == "" {
+			return nil, errors.New("user name is required")
+		}
+		if _, exists := s.users[entry.Name]; exists {
+			return nil, fmt.Errorf("duplicate user %q", entry.Name)
+		}
+		if _, _, _, err := parsePasswordHash(entry.PasswordHash); err != nil {
+			return nil, fmt.Errorf("user %q: %w", entry.Name, err)
+		}
+		s.users[entry.Name] = entry
+	}
+
+	return s, nil
+}
+
+// LoadUserFile reads users from the YAML file at path
+func LoadUserFile(path string) (*FileUserStore, error) {
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read user file: %w", err)
+	}
+
+	var file struct {
+		Users []UserEntry `yaml:"users"`
+	}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse user file %s: %w", path, err)
+	}
+
+	return NewFileUserStore(file.Users...)
+}
+
+// Authenticate checks the password of the user
+func (s *FileUserStore) Authenticate(_ context.Context, name, password string) (User, error) {
+
+	entry, ok := s.users[name]
+	if !ok {
+		CheckPassword(dummyHash(), password)
+		return User{}, ErrInvalidCredentials
+	}
+
+	if !CheckPassword(entry.PasswordHash, password) {
+		return User{}, ErrInvalidCredentials
+	}
+
+	return User{Name: entry.Name, Roles: entry.Roles}, nil
+}
+
+// HashPassword returns the PBKDF2-SHA256 hash of password
+// in the "pbkdf2-sha256$<iterations>$<salt>$<key>" form
+func HashPassword(password string) (string, error) {
+
+	salt := make([]byte, passwordSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key, err := pbkdf2.Key(sha256.New, password, salt, passwordIterations, passwordKeySize)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{
+		passwordScheme,
+		strconv.Itoa(passwordIterations),
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	}, "$"), nil
+}
+
+// CheckPassword reports whether password matches the hash made by HashPassword
+func CheckPassword(hash, password string) bool {
+
+	iterations, salt, key, err := parsePasswordHash(hash)
+	if err != nil {
+		return false
+	}
+
+	derived, err := pbkdf2.Key(sha256.New, password, salt, iterations, len(key))
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(derived, key) == 1
+}
+
+func parsePasswordHash(hash string) (iterations int, salt, key []byte, err error) {
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != passwordScheme {
+		return 0, nil, nil, errors.New("password hash must be in pbkdf2-sha256$<iterations>$<salt>$<key> form")
+	}
+
+	iterations, err = strconv.Atoi(parts[1])
+	if err != nil || iterations <= 0 {
+		return 0, nil, nil, errors.New("invalid password hash iterations")
+	}
+	if salt, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+		return 0, nil, nil, errors.New("invalid password hash salt")
+	}
+	if key, err = base64.RawStdEncoding.DecodeString(parts[3]); err != nil || len(key) == 0 {
+		return 0, nil, nil, errors.New("invalid password hash key")
+	}
+
+	return iterations, salt, key, nil
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashPassword(t *testing.T) {
+	hash, err := HashPassword("secret")
+	require.NoError(t, err)
+
+	assert.True(t, CheckPassword(hash, "secret"))
+	assert.False(t, CheckPassword(hash, "Secret"))
+	assert.False(t, CheckPassword("plain", "plain"))
+
+	again, err := HashPassword("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again, "hashes must be salted")
+}
+
+func TestLoadUserFile(t *testing.T) {
+	hash, err := HashPassword("secret")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "users.yaml")
+	data := "users:\n  - name: admin\n    password_hash: " + hash + "\n    roles: [admin, operator]\n"
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+
+	store, err := LoadUserFile(path)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	user, err := store.Authenticate(ctx, "admin", "secret")
+	require.NoError(t, err)
+	assert.Equal(t, User{Name: "admin", Roles: []string{"admin", "operator"}}, user)
+
+	_, err = store.Authenticate(ctx, "admin", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = store.Authenticate(ctx, "nobody", "secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestNewFileUserStore_Validation(t *testing.T) {
+	_, err := NewFileUserStore(UserEntry{Name: "admin", PasswordHash: "plain"})
+	assert.Error(t, err)
+
+	_, err = NewFileUserStore(UserEntry{PasswordHash: "plain"})
+	assert.Error(t, err)
+}
+
+func TestNoUsers(t *testing.T) {
+	_, err := NoUsers.Authenticate(context.Background(), "admin", "")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
@@ -1,14 +1,15 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"github.com/spf13/cast"
+	codec256 "github.com/v8platform/encoder/ras/codec256"
 	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
 	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"io"
 	"log"
 	"net"
 	"sync"
@@ -18,6 +19,9 @@ import (
 
 var defaultVersion = "10.0"
 
+// aliveCheckIdle время простоя, после которого соединение проверяется перед запросом
+const aliveCheckIdle = time.Second
+
 var _ clientv1.ClientImpl = (*ClientConn)(nil)
 var _ clientv1.ClientServiceImpl = (*ClientConn)(nil)
 
@@ -51,6 +55,8 @@ type ClientConn struct {
 	_closed    uint32 // atomic
 	_connected uint32 // atomic
 	_locked    uint32 // atomic
+	_inflight  int32  // atomic, количество выполняемых запросов
+	_gen       uint32 // atomic, номер установленного соединения
 
 	stats     Stats
 	mu        *sync.Mutex // Блокировка всего клиента
@@ -75,6 +81,8 @@ type Options struct {
 	NegotiateMessage   *protocolv1.NegotiateMessage
 	ConnectMessage     *protocolv1.ConnectMessage
 	OpenEndpoint       *protocolv1.EndpointOpen
+	ReconnectAttempts  int     // Количество попыток подключения к RAS
+	ReconnectBackoff   Backoff // Задержка между попытками подключения
 }
 
 var defaultClientOptions = Options{
@@ -87,6 +95,8 @@ var defaultClientOptions = Options{
 		Service: "v8.service.Admin.Cluster",
 		Version: defaultVersion,
 	},
+	ReconnectAttempts: 5,
+	ReconnectBackoff:  defaultBackoff,
 }
 
 func (c *ClientConn) GetEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
@@ -134,6 +144,10 @@ func (c *ClientConn) addEndpoint(endpoint *protocolv1.Endpoint) {
 
 func (c *ClientConn) turnEndpoint(ctx context.Context) (*protocolv1.Endpoint, error) {
 
+	if err := c.reconnect(ctx); err != nil {
+		return nil, err
+	}
+
 	EndpointOpenAck, err := c.EndpointOpen(ctx, &protocolv1.EndpointOpen{
 		Service: "v8.service.Admin.Cluster",
 		Version: c.version,
@@ -174,43 +188,86 @@ func (c *ClientConn) EndpointMessage(ctx context.Context, req *protocolv1.Endpoi
 
 }
 
+// endpointMessage отправляет сообщение endpoint, открытого на соединении с номером gen.
+// После переподключения идентификаторы endpoint относятся уже к новому соединению,
+// поэтому сообщение для endpoint прошлого соединения не отправляется.
+func (c *ClientConn) endpointMessage(ctx context.Context, gen uint32, req *protocolv1.EndpointMessage) (*protocolv1.EndpointMessage, error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	// Check context
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if !c.connected() || c.generation() != gen {
+		return nil, errStaleEndpoint
+	}
+
+	// RAS мог закрыть соединение, пока оно простаивало
+	if time.Since(c.UsedAt()) >= aliveCheckIdle && !c.alive() {
+		return nil, errStaleEndpoint
+	}
+
+	packet, err := protocolv1.NewPacket(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := packet.WriteTo(c); err != nil {
+		return nil, &sendError{err}
+	}
+	ackPacket, err := protocolv1.NewPacket(c)
+	if err != nil {
+		return nil, err
+	}
+	resp := new(protocolv1.EndpointMessage)
+	return resp, ackPacket.Unpack(resp)
+}
+
 func (c *ClientConn) Read(p []byte) (n int, err error) {
 
-	if c.closed() {
-		if err := c.reconnect(); err != nil {
-			return 0, err
-		}
+	if !c.connected() {
+		return 0, errNotConnected
 	}
 
 	err = c.conn.SetReadDeadline(time.Now().Add(c.Timeout))
 	if err != nil {
-		return 0, err
+		return 0, c.fail(err)
 	}
 	defer func() {
 		c.SetUsedAt(time.Now())
 	}()
 
-	return c.conn.Read(p)
+	n, err = c.conn.Read(p)
+	if err != nil {
+		return n, c.fail(err)
+	}
+	return n, nil
 
 }
 
 func (c *ClientConn) Write(p []byte) (n int, err error) {
 
-	if c.closed() {
-		if err := c.reconnect(); err != nil {
-			return 0, err
-		}
+	if !c.connected() {
+		return 0, errNotConnected
 	}
 
 	err = c.conn.SetWriteDeadline(time.Now().Add(c.Timeout))
 	if err != nil {
-		return 0, err
+		return 0, c.fail(err)
 	}
 	defer func() {
 		c.SetUsedAt(time.Now())
 	}()
 
-	return c.conn.Write(p)
+	n, err = c.conn.Write(p)
+	if err != nil {
+		return n, c.fail(err)
+	}
+	return n, nil
 }
 
 func (c *ClientConn) UsedAt() time.Time {
@@ -230,72 +287,109 @@ func (c *ClientConn) Close() error {
 
 	ctx := context.Background()
 	var err error
-	// c.endpoints.Range(func(key, value interface{}) bool {
-	//
-	// 	// err = c.request(ctx, &protocolv1.EndpointClose{EndpointId: key.(int32)}, nil)
-	// 	// if err != nil {
-	// 	// 	return false
-	// 	// }
-	// 	//
-	// 	return true
-	// })
-
-	if atomic.CompareAndSwapUint32(&c._connected, 0, 1) {
+	gen := c.generation()
+	c.endpoints.Range(func(key, value interface{}) bool {
 
-		_, err = c.Disconnect(ctx, &protocolv1.DisconnectMessage{})
+		err = c.closeEndpoint(ctx, gen, value.(*protocolv1.Endpoint))
 		if err != nil {
-			return err
+			return false
 		}
 
+		return true
+	})
+
+	if dErr := c.disconnect(ctx); err == nil {
+		err = dErr
 	}
+	return err
+}
 
-	if c.closed() {
-		return nil
+// disconnect отключается от RAS. В отличие от Close клиент остается
+// рабочим и подключится заново при следующем запросе.
+func (c *ClientConn) disconnect(ctx context.Context) error {
+
+	var err error
+	if c.connected() {
+		_, err = c.Disconnect(ctx, &protocolv1.DisconnectMessage{})
 	}
 
-	return c.conn.Close()
-}
+	c.Lock()
+	defer c.Unlock()
 
-func (c *ClientConn) Lock() {
-	c.connMu.Lock()
-}
+	atomic.StoreUint32(&c._connected, 0)
+	c.forgetEndpoints()
 
-func (c *ClientConn) Unlock() {
-	c.connMu.Unlock()
+	if c.conn == nil {
+		return err
+	}
+
+	if cErr := c.conn.Close(); err == nil {
+		err = cErr
+	}
+	c.conn = nil
+	return err
 }
 
-func (c *ClientConn) reconnect() (err error) {
+// closeEndpoint закрывает endpoint, открытый на соединении с номером gen.
+// Endpoint прошлого соединения в RAS уже не существует, закрывать его не нужно.
+//
+// Сообщение protocolv1.EndpointClose не содержит идентификатора endpoint
+// и не сериализуется, поэтому пакет закрытия формируется здесь.
+func (c *ClientConn) closeEndpoint(ctx context.Context, gen uint32, endpoint *protocolv1.Endpoint) error {
 
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.Lock()
+	defer c.Unlock()
 
-	if c.connected() {
-		return nil
+	// Check context
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
 	}
 
-	c.endpoints = &sync.Map{}
+	if !c.connected() || c.generation() != gen {
+		return nil
+	}
 
-	ctx := context.Background()
+	c.endpoints.Delete(cast.ToString(endpoint.GetId()))
 
-	err = c.populateConn()
-	if err != nil {
+	buf := &bytes.Buffer{}
+	if err := codec256.FormatNullable(buf, endpoint.GetId()); err != nil {
 		return err
 	}
 
-	err = c.NegotiateMessage.Formatter(c.conn, 0)
-	if err != nil {
-		return
+	packet := &protocolv1.Packet{
+		Type: protocolv1.PacketType_PACKET_TYPE_ENDPOINT_CLOSE,
+		Size: int32(buf.Len()),
+		Data: buf.Bytes(),
 	}
+	_, err := packet.WriteTo(c)
+	return err
+}
 
-	_, err = c.connect(ctx, c.ConnectMessage)
-	if err != nil {
-		return
-	}
+// healthy проверяет, что соединение не закрыто и может использоваться пулом
+func (c *ClientConn) healthy() bool {
+	return atomic.LoadUint32(&c._closed) == 0
+}
 
-	atomic.StoreUint32(&c._connected, 1)
+func (c *ClientConn) acquire() {
+	atomic.AddInt32(&c._inflight, 1)
+}
 
-	return err
+func (c *ClientConn) release() {
+	atomic.AddInt32(&c._inflight, -1)
+}
 
+func (c *ClientConn) inflight() int32 {
+	return atomic.LoadInt32(&c._inflight)
+}
+
+func (c *ClientConn) Lock() {
+	c.connMu.Lock()
+}
+
+func (c *ClientConn) Unlock() {
+	c.connMu.Unlock()
 }
 
 func (x *ClientConn) connect(ctx context.Context, req *protocolv1.ConnectMessage) (*protocolv1.ConnectMessageAck, error) {
@@ -326,9 +420,28 @@ func (c *ClientConn) connected() bool {
 	return atomic.LoadUint32(&c._connected) == 1
 }
 
-func (c *ClientConn) populateConn() (err error) {
+func (c *ClientConn) forgetEndpoints() {
+	c.endpoints.Range(func(key, _ interface{}) bool {
+		c.endpoints.Delete(key)
+		return true
+	})
+}
+
+func (c *ClientConn) generation() uint32 {
+	return atomic.LoadUint32(&c._gen)
+}
+
+// fail помечает соединение разорванным. Поток ответов RAS после ошибки
+// чтения или записи рассинхронизирован, поэтому соединение не переиспользуется.
+func (c *ClientConn) fail(err error) error {
+	atomic.StoreUint32(&c._connected, 0)
+	return err
+}
+
+func (c *ClientConn) populateConn(ctx context.Context) (err error) {
 
-	conn, err := net.Dial("tcp", c.host)
+	dialer := &net.Dialer{Timeout: c.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.host)
 	if err != nil {
 		return err
 	}
@@ -337,24 +450,25 @@ func (c *ClientConn) populateConn() (err error) {
 	return nil
 }
 
-func (c *ClientConn) closed() bool {
+// alive проверяет, что RAS не закрыл соединение.
+// RAS не присылает данные без запроса, поэтому любой прочитанный байт
+// или ошибка, кроме таймаута, означают, что соединение непригодно.
+func (c *ClientConn) alive() bool {
 
-	if atomic.LoadUint32(&c._closed) == 1 || c.conn == nil {
-		return true
+	if c.conn == nil {
+		return false
 	}
-	_ = c.conn.SetReadDeadline(time.Now())
-	_, err := c.conn.Read(make([]byte, 0))
+
+	// При уже истекшем сроке чтение из сокета не выполняется совсем
+	_ = c.conn.SetReadDeadline(time.Now().Add(time.Millisecond))
+	_, err := c.conn.Read(make([]byte, 1))
 	var zero time.Time
 	_ = c.conn.SetReadDeadline(zero)
 
-	if err == nil {
-		return false
-	}
-
-	netErr, _ := err.(net.Error)
-	if err != io.EOF && !netErr.Timeout() {
-		atomic.StoreUint32(&c._closed, 1)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
 		return true
 	}
+
+	atomic.StoreUint32(&c._connected, 0)
 	return false
 }
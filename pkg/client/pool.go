@@ -0,0 +1,584 @@
+package client
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/cast"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ clientv1.EndpointServiceImpl = (*Endpoint)(nil)
+
+// endpointSeq генератор идентификаторов endpoint, уникальных в пределах процесса.
+// Идентификаторы RAS уникальны только внутри одного соединения, поэтому
+// наружу (в заголовке endpoint_id) отдается собственный идентификатор пула.
+var endpointSeq uint64
+
+// PoolOptions настройки пула соединений с RAS
+type PoolOptions struct {
+	Options
+
+	MinConns int // Количество соединений, создаваемых сразу
+	MaxConns int // Максимальное количество соединений с RAS
+}
+
+var defaultPoolOptions = PoolOptions{
+	Options:  defaultClientOptions,
+	MinConns: 1,
+	MaxConns: 4,
+}
+
+// DefaultPoolOptions возвращает настройки пула по умолчанию
+func DefaultPoolOptions() PoolOptions {
+	return defaultPoolOptions
+}
+
+// Pool пул соединений с одной службой RAS.
+//
+// Новый endpoint открывается на наименее загруженном исправном соединении,
+// при необходимости пул открывает новое соединение (не более MaxConns).
+// Запросы по уже выданному endpoint_id всегда идут через соединение,
+// которому принадлежит endpoint.
+//
+// Раз в IdleCheckFrequency пул закрывает endpoint и отключает соединения,
+// не использовавшиеся дольше IdleTimeout.
+type Pool struct {
+	host string
+	opts PoolOptions
+
+	mu        *sync.Mutex
+	conns     []*ClientConn
+	endpoints *sync.Map // id -> *Endpoint
+	done      chan struct{}
+
+	evicted      uint64 // atomic, закрыто простаивающих endpoint
+	disconnected uint64 // atomic, отключено простаивающих соединений
+}
+
+// PoolStats состояние пула соединений
+type PoolStats struct {
+	Conns        int    // Соединений в пуле
+	Connected    int    // Из них подключено к RAS
+	Endpoints    int    // Открытых endpoint
+	Evicted      uint64 // Закрыто простаивающих endpoint
+	Disconnected uint64 // Отключено простаивающих соединений
+}
+
+func NewPool(host string, opts ...PoolOptions) *Pool {
+
+	opt := defaultPoolOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+
+	if opt.MaxConns < 1 {
+		opt.MaxConns = 1
+	}
+	if opt.MinConns > opt.MaxConns {
+		opt.MinConns = opt.MaxConns
+	}
+
+	p := &Pool{
+		host:      host,
+		opts:      opt,
+		mu:        &sync.Mutex{},
+		endpoints: &sync.Map{},
+		done:      make(chan struct{}),
+	}
+
+	// Соединения подключаются к RAS лениво, при первом запросе
+	for i := 0; i < opt.MinConns; i++ {
+		p.conns = append(p.conns, NewClientConn(host, opt.Options))
+	}
+
+	if opt.IdleTimeout > 0 && opt.IdleCheckFrequency > 0 {
+		go p.janitor(opt.IdleCheckFrequency)
+	}
+
+	return p
+}
+
+func (p *Pool) janitor(frequency time.Duration) {
+
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case now := <-ticker.C:
+			p.evictIdle(now)
+		}
+	}
+}
+
+// evictIdle закрывает endpoint и отключает соединения,
+// которые не использовались дольше IdleTimeout.
+// Endpoint с выполняемыми запросами не закрываются.
+func (p *Pool) evictIdle(now time.Time) {
+
+	ctx := context.Background()
+	deadline := now.Add(-p.opts.IdleTimeout)
+
+	p.endpoints.Range(func(key, value interface{}) bool {
+
+		endpoint := value.(*Endpoint)
+		if endpoint.busy() || endpoint.UsedAt().After(deadline) {
+			return true
+		}
+
+		p.endpoints.Delete(key)
+		if err := endpoint.close(ctx); err != nil {
+			log.Printf("ras: close idle endpoint %s: %v", endpoint.ID(), err)
+		}
+		atomic.AddUint64(&p.evicted, 1)
+
+		return true
+	})
+
+	p.mu.Lock()
+	conns := append([]*ClientConn(nil), p.conns...)
+	p.mu.Unlock()
+
+	for _, conn := range conns {
+
+		if !conn.connected() || conn.inflight() > 0 || conn.UsedAt().After(deadline) {
+			continue
+		}
+
+		if err := conn.disconnect(ctx); err != nil {
+			log.Printf("ras: disconnect idle connection to %s: %v", p.host, err)
+		}
+		atomic.AddUint64(&p.disconnected, 1)
+	}
+}
+
+// GetEndpoint возвращает endpoint по заголовку endpoint_id
+// или открывает новый на наименее загруженном соединении
+func (p *Pool) GetEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, id := range md["endpoint_id"] {
+			if endpoint, ok := p.getEndpoint(id); ok {
+				return endpoint, nil
+			}
+		}
+	}
+
+	conn := p.checkout()
+	defer conn.release()
+
+	endpoint := &Endpoint{
+		id:   strconv.FormatUint(atomic.AddUint64(&endpointSeq, 1), 10),
+		conn: conn,
+		mu:   &sync.Mutex{},
+	}
+	endpoint.SetUsedAt(time.Now())
+
+	if _, _, err := endpoint.open(ctx); err != nil {
+		return nil, err
+	}
+
+	p.endpoints.Store(endpoint.id, endpoint)
+
+	return endpoint, nil
+}
+
+// HasEndpoint сообщает, что endpoint с идентификатором id открыт в пуле
+func (p *Pool) HasEndpoint(id string) bool {
+	_, ok := p.getEndpoint(id)
+	return ok
+}
+
+// CloseEndpoint закрывает в RAS endpoint с идентификатором id,
+// не дожидаясь его вытеснения по IdleTimeout
+func (p *Pool) CloseEndpoint(ctx context.Context, id string) error {
+
+	val, ok := p.endpoints.LoadAndDelete(id)
+	if !ok {
+		return nil
+	}
+
+	return val.(*Endpoint).close(ctx)
+}
+
+func (p *Pool) getEndpoint(id string) (*Endpoint, bool) {
+
+	val, ok := p.endpoints.Load(id)
+	if !ok {
+		return nil, false
+	}
+
+	endpoint := val.(*Endpoint)
+	if !endpoint.conn.healthy() {
+		p.endpoints.Delete(id)
+		return nil, false
+	}
+
+	return endpoint, true
+}
+
+// checkout выбирает соединение для нового endpoint и резервирует его.
+// Неисправные соединения исключаются из пула.
+func (p *Pool) checkout() *ClientConn {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	conns := p.conns[:0]
+	for _, conn := range p.conns {
+		if conn.healthy() {
+			conns = append(conns, conn)
+			continue
+		}
+		_ = conn.Close()
+	}
+	p.conns = conns
+
+	var best *ClientConn
+	for _, conn := range p.conns {
+		if best == nil || conn.inflight() < best.inflight() {
+			best = conn
+		}
+	}
+
+	if best == nil || best.inflight() > 0 && len(p.conns) < p.opts.MaxConns {
+		best = NewClientConn(p.host, p.opts.Options)
+		p.conns = append(p.conns, best)
+	}
+
+	best.acquire()
+	return best
+}
+
+// Len возвращает текущее количество соединений в пуле
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.conns)
+}
+
+// UsedAt возвращает время последнего обмена с RAS по любому соединению пула
+// или нулевое время, если пул еще не использовался
+func (p *Pool) UsedAt() time.Time {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var usedAt time.Time
+	for _, conn := range p.conns {
+		if at := conn.UsedAt(); at.Unix() > 0 && at.After(usedAt) {
+			usedAt = at
+		}
+	}
+	return usedAt
+}
+
+// Stats возвращает количество соединений и endpoint пула
+func (p *Pool) Stats() PoolStats {
+
+	stats := PoolStats{
+		Evicted:      atomic.LoadUint64(&p.evicted),
+		Disconnected: atomic.LoadUint64(&p.disconnected),
+	}
+
+	p.mu.Lock()
+	stats.Conns = len(p.conns)
+	for _, conn := range p.conns {
+		if conn.connected() {
+			stats.Connected++
+		}
+	}
+	p.mu.Unlock()
+
+	p.endpoints.Range(func(_, _ interface{}) bool {
+		stats.Endpoints++
+		return true
+	})
+
+	return stats
+}
+
+func (p *Pool) Close() error {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	select {
+	case <-p.done:
+	default:
+		close(p.done)
+	}
+
+	var err error
+	for _, conn := range p.conns {
+		if cErr := conn.Close(); cErr != nil && err == nil {
+			err = cErr
+		}
+	}
+
+	p.conns = nil
+	p.endpoints.Range(func(key, _ interface{}) bool {
+		p.endpoints.Delete(key)
+		return true
+	})
+
+	return err
+}
+
+// Endpoint endpoint RAS, закрепленный за соединением пула.
+//
+// После переподключения соединения endpoint открывается в RAS заново,
+// и на нем повторяется выполненная ранее аутентификация,
+// поэтому endpoint_id остается действительным после перезапуска RAS.
+type Endpoint struct {
+	id   string
+	conn *ClientConn
+
+	mu       *sync.Mutex
+	raw      *protocolv1.Endpoint
+	gen      uint32        // Номер соединения, на котором открыт raw
+	auth     []*authRecord // Успешные запросы аутентификации
+	usedAt   uint32        // atomic
+	requests int32         // atomic, количество выполняемых запросов
+}
+
+// authRecord запрос аутентификации, повторяемый на новом endpoint
+type authRecord struct {
+	key     string
+	request *anypb.Any
+}
+
+// ID идентификатор endpoint, передаваемый клиенту в заголовке endpoint_id
+func (e *Endpoint) ID() string {
+	return e.id
+}
+
+func (e *Endpoint) UsedAt() time.Time {
+	unix := atomic.LoadUint32(&e.usedAt)
+	return time.Unix(int64(unix), 0)
+}
+
+func (e *Endpoint) SetUsedAt(tm time.Time) {
+	atomic.StoreUint32(&e.usedAt, uint32(tm.Unix()))
+}
+
+// busy сообщает, что по endpoint выполняется запрос
+func (e *Endpoint) busy() bool {
+	return atomic.LoadInt32(&e.requests) > 0
+}
+
+func (e *Endpoint) Request(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+
+	e.conn.acquire()
+	defer e.conn.release()
+
+	// Время использования отсчитывается и от начала, и от завершения запроса,
+	// иначе долгий запрос мог бы закончиться на уже простаивающем endpoint
+	atomic.AddInt32(&e.requests, 1)
+	e.SetUsedAt(time.Now())
+	defer func() {
+		e.SetUsedAt(time.Now())
+		atomic.AddInt32(&e.requests, -1)
+	}()
+
+	var resp *anypb.Any
+	var err error
+
+	// Запрос, не дошедший до RAS, повторяется один раз на новом соединении
+	for attempt := 0; attempt < 2; attempt++ {
+
+		var raw *protocolv1.Endpoint
+		var gen uint32
+
+		raw, gen, err = e.open(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = e.request(ctx, raw, gen, req)
+		if !retryable(err) {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	e.remember(req.GetRequest())
+
+	return resp, nil
+}
+
+// open возвращает endpoint RAS на текущем соединении.
+// Если соединение было восстановлено, открывает новый endpoint
+// и повторяет на нем аутентификацию.
+func (e *Endpoint) open(ctx context.Context) (*protocolv1.Endpoint, uint32, error) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err := e.conn.reconnect(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	gen := e.conn.generation()
+	if e.raw != nil && e.gen == gen {
+		return e.raw, gen, nil
+	}
+
+	raw, err := e.conn.turnEndpoint(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if e.conn.generation() != gen {
+		// Соединение переподключилось во время открытия endpoint
+		return nil, 0, errStaleEndpoint
+	}
+
+	for _, record := range e.auth {
+		_, err = e.request(ctx, raw, gen, &clientv1.EndpointRequest{
+			Request: record.request,
+			Respond: emptyAny,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	e.raw, e.gen = raw, gen
+
+	return raw, gen, nil
+}
+
+// close закрывает endpoint в RAS
+func (e *Endpoint) close(ctx context.Context) error {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.raw == nil {
+		return nil
+	}
+
+	raw := e.raw
+	e.raw = nil
+
+	return e.conn.closeEndpoint(ctx, e.gen, raw)
+}
+
+func (e *Endpoint) request(ctx context.Context, raw *protocolv1.Endpoint, gen uint32, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+	return clientv1.NewEndpointService(&endpointConn{ClientConn: e.conn, gen: gen}, raw).Request(ctx, req)
+}
+
+// remember сохраняет успешный запрос аутентификации для повтора после переподключения.
+// Повторная аутентификация с тем же ключом заменяет сохраненную.
+func (e *Endpoint) remember(request *anypb.Any) {
+
+	key := authKey(request)
+	if len(key) == 0 {
+		return
+	}
+
+	record := &authRecord{
+		key:     key,
+		request: proto.Clone(request).(*anypb.Any),
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i, r := range e.auth {
+		if r.key == key {
+			e.auth[i] = record
+			return
+		}
+	}
+
+	e.auth = append(e.auth, record)
+}
+
+// Authenticated сообщает, что на endpoint уже успешно выполнен такой же
+// запрос аутентификации, и повторять его не нужно
+func (e *Endpoint) Authenticated(request *anypb.Any) bool {
+
+	key := authKey(request)
+	if len(key) == 0 {
+		return false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, r := range e.auth {
+		if r.key == key {
+			return proto.Equal(r.request, request)
+		}
+	}
+
+	return false
+}
+
+var emptyAny, _ = anypb.New(&emptypb.Empty{})
+
+// authKey возвращает ключ для запросов аутентификации
+// или пустую строку для остальных запросов
+func authKey(request *anypb.Any) string {
+
+	switch {
+	case request.MessageIs((*messagesv1.AuthenticateAgentRequest)(nil)):
+		return "agent"
+	case request.MessageIs((*messagesv1.ClusterAuthenticateRequest)(nil)):
+		auth := new(messagesv1.ClusterAuthenticateRequest)
+		if err := request.UnmarshalTo(auth); err != nil {
+			return ""
+		}
+		return "cluster/" + auth.GetClusterId()
+	case request.MessageIs((*messagesv1.AuthenticateInfobaseRequest)(nil)):
+		auth := new(messagesv1.AuthenticateInfobaseRequest)
+		if err := request.UnmarshalTo(auth); err != nil {
+			return ""
+		}
+		return "infobase/" + auth.GetClusterId() + "/" + auth.GetUser()
+	default:
+		return ""
+	}
+}
+
+// endpointConn отправляет сообщения endpoint только в соединение,
+// на котором endpoint был открыт
+type endpointConn struct {
+	*ClientConn
+	gen uint32
+}
+
+func (c *endpointConn) EndpointMessage(ctx context.Context, req *protocolv1.EndpointMessage) (*protocolv1.EndpointMessage, error) {
+	return c.ClientConn.endpointMessage(ctx, c.gen, req)
+}
+
+// EndpointID возвращает идентификатор endpoint для заголовка endpoint_id
+func EndpointID(endpoint clientv1.EndpointServiceImpl) string {
+
+	switch typed := endpoint.(type) {
+	case *Endpoint:
+		return typed.ID()
+	case protocolv1.EndpointImpl:
+		return cast.ToString(typed.GetId())
+	default:
+		return ""
+	}
+}
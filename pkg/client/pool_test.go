@@ -0,0 +1,233 @@
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewPool_Options(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    PoolOptions
+		wantLen int
+		wantMax int
+	}{
+		{"defaults", defaultPoolOptions, 1, 4},
+		{"min greater than max", PoolOptions{Options: defaultClientOptions, MinConns: 5, MaxConns: 2}, 2, 2},
+		{"zero max", PoolOptions{Options: defaultClientOptions}, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPool("localhost:1545", tt.opts)
+			assert.Equal(t, tt.wantLen, p.Len())
+			assert.Equal(t, tt.wantMax, p.opts.MaxConns)
+		})
+	}
+}
+
+func TestPool_CheckoutPrefersLeastLoaded(t *testing.T) {
+	p := NewPool("localhost:1545", PoolOptions{Options: defaultClientOptions, MinConns: 2, MaxConns: 2})
+
+	first := p.checkout()
+	second := p.checkout()
+	assert.NotSame(t, first, second, "busy connection must not be chosen while an idle one exists")
+
+	first.release()
+	third := p.checkout()
+	assert.Same(t, first, third)
+}
+
+func TestPool_CheckoutGrowsUpToMax(t *testing.T) {
+	p := NewPool("localhost:1545", PoolOptions{Options: defaultClientOptions, MinConns: 1, MaxConns: 2})
+
+	for i := 0; i < 5; i++ {
+		p.checkout()
+	}
+
+	assert.Equal(t, 2, p.Len())
+}
+
+func TestPool_CheckoutDropsClosedConn(t *testing.T) {
+	p := NewPool("localhost:1545", PoolOptions{Options: defaultClientOptions, MinConns: 1, MaxConns: 1})
+
+	broken := p.conns[0]
+	atomic.StoreUint32(&broken._closed, 1)
+
+	conn := p.checkout()
+	assert.NotSame(t, broken, conn)
+	assert.Equal(t, 1, p.Len())
+}
+
+func TestPool_GetEndpointByID(t *testing.T) {
+	p := NewPool("localhost:1545")
+
+	endpoint := &Endpoint{id: "42", conn: p.conns[0], raw: &protocolv1.Endpoint{Id: 1}}
+	p.endpoints.Store(endpoint.id, endpoint)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("endpoint_id", "42"))
+	got, err := p.GetEndpoint(ctx)
+	require.NoError(t, err)
+	assert.Same(t, endpoint, got)
+}
+
+func TestPool_GetEndpointSkipsClosedConn(t *testing.T) {
+	p := NewPool("localhost:1545")
+
+	endpoint := &Endpoint{id: "43", conn: p.conns[0], raw: &protocolv1.Endpoint{Id: 1}}
+	p.endpoints.Store(endpoint.id, endpoint)
+	atomic.StoreUint32(&endpoint.conn._closed, 1)
+
+	_, ok := p.getEndpoint("43")
+	assert.False(t, ok)
+
+	_, stored := p.endpoints.Load("43")
+	assert.False(t, stored, "endpoint of a closed connection must be forgotten")
+}
+
+func TestEndpointID(t *testing.T) {
+	conn := NewClientConn("localhost:1545")
+
+	assert.Equal(t, "7", EndpointID(&Endpoint{id: "7"}))
+	assert.Equal(t, "3", EndpointID(clientv1.NewEndpointService(conn, &protocolv1.Endpoint{Id: 3})))
+	assert.Equal(t, "", EndpointID(nil))
+}
+
+func TestPool_EvictIdle(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	p := NewPool(ras.addr())
+	defer p.Close()
+
+	idle, err := p.GetEndpoint(ctx)
+	require.NoError(t, err)
+	busy, err := p.GetEndpoint(ctx)
+	require.NoError(t, err)
+
+	idle.(*Endpoint).SetUsedAt(time.Now().Add(-time.Hour))
+
+	p.evictIdle(time.Now())
+
+	stats := p.Stats()
+	assert.Equal(t, 1, stats.Endpoints)
+	assert.Equal(t, uint64(1), stats.Evicted)
+	assert.Equal(t, 1, stats.Connected, "connection with a live endpoint must stay connected")
+	assert.Equal(t, uint64(0), stats.Disconnected)
+
+	_, ok := p.getEndpoint(EndpointID(idle))
+	assert.False(t, ok)
+	_, ok = p.getEndpoint(EndpointID(busy))
+	assert.True(t, ok)
+
+	require.Eventually(t, func() bool {
+		return len(ras.closedEndpoints()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestPool_EvictIdleSkipsBusyEndpoint(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	p := NewPool(ras.addr())
+	defer p.Close()
+
+	endpoint, err := p.GetEndpoint(ctx)
+	require.NoError(t, err)
+	e := endpoint.(*Endpoint)
+
+	// Запрос начался давно и еще выполняется
+	e.SetUsedAt(time.Now().Add(-time.Hour))
+	atomic.AddInt32(&e.requests, 1)
+
+	p.evictIdle(time.Now())
+	assert.True(t, p.HasEndpoint(e.ID()), "endpoint with a request in flight must not be evicted")
+	assert.Equal(t, uint64(0), p.Stats().Evicted)
+
+	atomic.AddInt32(&e.requests, -1)
+
+	// Завершенный запрос продлевает время использования
+	e.SetUsedAt(time.Now().Add(-time.Hour))
+	_, err = clientv1.NewClustersService(endpoint).GetClusters(ctx, &messagesv1.GetClustersRequest{})
+	require.NoError(t, err)
+	assert.False(t, e.busy())
+	assert.WithinDuration(t, time.Now(), e.UsedAt(), 2*time.Second)
+
+	p.evictIdle(time.Now().Add(2 * p.opts.IdleTimeout))
+	assert.False(t, p.HasEndpoint(e.ID()), "endpoint idle after the request is evicted")
+}
+
+func TestPool_CloseEndpoint(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	p := NewPool(ras.addr())
+	defer p.Close()
+
+	endpoint, err := p.GetEndpoint(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, p.CloseEndpoint(ctx, EndpointID(endpoint)))
+	assert.False(t, p.HasEndpoint(EndpointID(endpoint)))
+	assert.Equal(t, 0, p.Stats().Endpoints)
+	require.Eventually(t, func() bool {
+		return len(ras.closedEndpoints()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, p.CloseEndpoint(ctx, EndpointID(endpoint)), "closing twice is a no-op")
+}
+
+func TestPool_EvictIdleDisconnects(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	p := NewPool(ras.addr())
+	defer p.Close()
+
+	endpoint, err := p.GetEndpoint(ctx)
+	require.NoError(t, err)
+
+	p.evictIdle(time.Now().Add(2 * p.opts.IdleTimeout))
+
+	stats := p.Stats()
+	assert.Equal(t, 0, stats.Endpoints)
+	assert.Equal(t, 0, stats.Connected)
+	assert.Equal(t, uint64(1), stats.Evicted)
+	assert.Equal(t, uint64(1), stats.Disconnected)
+
+	require.Eventually(t, func() bool {
+		return ras.disconnected() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// Выселенный endpoint открывается заново на новом соединении
+	_, err = clientv1.NewClustersService(endpoint).GetClusters(ctx, &messagesv1.GetClustersRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, p.Stats().Connected)
+}
+
+func TestClientConn_CloseClosesEndpoints(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	conn := NewClientConn(ras.addr())
+	for i := 0; i < 2; i++ {
+		_, err := conn.turnEndpoint(ctx)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, conn.Close())
+
+	require.Eventually(t, func() bool {
+		return len(ras.closedEndpoints()) == 2 && ras.disconnected() == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.ElementsMatch(t, []int32{1, 2}, ras.closedEndpoints())
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	errClientClosed  = errors.New("ras: client is closed")
+	errNotConnected  = errors.New("ras: not connected")
+	errStaleEndpoint = errors.New("ras: endpoint belongs to a closed connection")
+)
+
+// sendError ошибка отправки запроса в RAS.
+// Запрос до RAS не дошел, поэтому его можно повторить на новом соединении.
+type sendError struct {
+	err error
+}
+
+func (e *sendError) Error() string {
+	return fmt.Sprintf("ras: send request: %v", e.err)
+}
+
+func (e *sendError) Unwrap() error {
+	return e.err
+}
+
+// retryable сообщает, что запрос не был отправлен в RAS и может быть повторен
+func retryable(err error) bool {
+
+	var sErr *sendError
+	return errors.Is(err, errStaleEndpoint) ||
+		errors.Is(err, errNotConnected) ||
+		errors.As(err, &sErr)
+}
+
+// Backoff экспоненциальная задержка между попытками подключения к RAS.
+// Задержка растет от Min в Factor раз до Max и случайно
+// отклоняется на долю Jitter, чтобы клиенты не переподключались одновременно.
+type Backoff struct {
+	Min    time.Duration
+	Max    time.Duration
+	Factor float64
+	Jitter float64
+}
+
+var defaultBackoff = Backoff{
+	Min:    200 * time.Millisecond,
+	Max:    10 * time.Second,
+	Factor: 2,
+	Jitter: 0.2,
+}
+
+// Duration возвращает задержку перед попыткой attempt (начиная с 1)
+func (b Backoff) Duration(attempt int) time.Duration {
+
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	factor := b.Factor
+	if factor < 1 {
+		factor = 1
+	}
+
+	d := float64(b.Min) * math.Pow(factor, float64(attempt-1))
+	if b.Max > 0 && d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+
+	if b.Jitter > 0 {
+		d += d * b.Jitter * (2*rand.Float64() - 1)
+	}
+
+	if d < 0 {
+		return 0
+	}
+	return time.Duration(d)
+}
+
+// reconnect восстанавливает соединение с RAS, если оно разорвано.
+// Попытки подключения повторяются ReconnectAttempts раз с задержкой ReconnectBackoff.
+func (c *ClientConn) reconnect(ctx context.Context) (err error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	attempts := c.ReconnectAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	for attempt := 1; ; attempt++ {
+
+		if atomic.LoadUint32(&c._closed) == 1 {
+			return errClientClosed
+		}
+
+		if c.connected() {
+			return nil
+		}
+
+		if err = c.dial(ctx); err == nil {
+			return nil
+		}
+
+		if attempt >= attempts {
+			return err
+		}
+
+		delay := c.ReconnectBackoff.Duration(attempt)
+		log.Printf("ras: connect to %s failed (attempt %d/%d): %v, retry in %s", c.host, attempt, attempts, err, delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// dial открывает новое соединение с RAS вместо разорванного.
+// Endpoint прошлого соединения в RAS больше не существуют и забываются.
+func (c *ClientConn) dial(ctx context.Context) (err error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.conn != nil {
+		_ = c.conn.Close()
+		c.conn = nil
+	}
+
+	c.forgetEndpoints()
+
+	if err = c.populateConn(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = c.conn.Close()
+			c.conn = nil
+		}
+	}()
+
+	if err = c.conn.SetDeadline(time.Now().Add(c.Timeout)); err != nil {
+		return err
+	}
+
+	if err = c.NegotiateMessage.Formatter(c.conn, 0); err != nil {
+		return err
+	}
+
+	if _, err = c.connect(ctx, c.ConnectMessage); err != nil {
+		return err
+	}
+
+	var zero time.Time
+	if err = c.conn.SetDeadline(zero); err != nil {
+		return err
+	}
+
+	if atomic.LoadUint32(&c._closed) == 1 {
+		return errClientClosed
+	}
+
+	atomic.AddUint32(&c._gen, 1)
+	atomic.StoreUint32(&c._connected, 1)
+	c.SetUsedAt(time.Now())
+
+	return nil
+}
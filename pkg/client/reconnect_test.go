@@ -0,0 +1,300 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// fakeRAS минимальный RAS: открывает endpoint и отвечает пустым сообщением
+// на любой запрос, запоминая типы полученных сообщений по соединениям.
+type fakeRAS struct {
+	listener net.Listener
+
+	mu          sync.Mutex
+	conns       []net.Conn
+	received    [][]messagesv1.MessageType
+	closed      []int32 // Закрытые endpoint
+	disconnects int
+}
+
+func newFakeRAS(t *testing.T) *fakeRAS {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ras := &fakeRAS{listener: listener}
+	t.Cleanup(func() {
+		_ = listener.Close()
+		ras.restart()
+	})
+
+	go ras.serve()
+
+	return ras
+}
+
+func (r *fakeRAS) addr() string {
+	return r.listener.Addr().String()
+}
+
+func (r *fakeRAS) serve() {
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		r.mu.Lock()
+		r.conns = append(r.conns, conn)
+		r.received = append(r.received, nil)
+		idx := len(r.received) - 1
+		r.mu.Unlock()
+
+		go r.handle(idx, conn)
+	}
+}
+
+func (r *fakeRAS) handle(idx int, conn net.Conn) {
+	defer conn.Close()
+
+	if err := new(protocolv1.NegotiateMessage).Parse(conn, 0); err != nil {
+		return
+	}
+
+	var endpointID int32
+	for {
+		packet, err := protocolv1.NewPacket(conn)
+		if err != nil {
+			return
+		}
+
+		var reply protocolv1.PacketMessageFormatter
+		switch packet.GetType() {
+		case protocolv1.PacketType_PACKET_TYPE_CONNECT:
+			// Пакет без данных клиент читает до EOF, поэтому как и RAS
+			// отправляем подтверждение с пустым списком параметров
+			ack := &protocolv1.Packet{
+				Type: protocolv1.PacketType_PACKET_TYPE_CONNECT_ACK,
+				Size: 1,
+				Data: []byte{0},
+			}
+			if _, err := ack.WriteTo(conn); err != nil {
+				return
+			}
+			continue
+		case protocolv1.PacketType_PACKET_TYPE_ENDPOINT_OPEN:
+			open := new(protocolv1.EndpointOpen)
+			if err := packet.Unpack(open); err != nil {
+				return
+			}
+			endpointID++
+			reply = &protocolv1.EndpointOpenAck{
+				Service:    open.GetService(),
+				Version:    open.GetVersion(),
+				EndpointId: endpointID,
+			}
+		case protocolv1.PacketType_PACKET_TYPE_ENDPOINT_MESSAGE:
+			message := new(protocolv1.EndpointMessage)
+			if err := packet.Unpack(message); err != nil {
+				return
+			}
+			r.mu.Lock()
+			r.received[idx] = append(r.received[idx], message.GetMessage().GetType())
+			r.mu.Unlock()
+			reply = &protocolv1.EndpointMessage{
+				EndpointId: message.GetEndpointId(),
+				Format:     message.GetFormat(),
+				Type:       protocolv1.EndpointDataType_ENDPOINT_DATA_TYPE_VOID_MESSAGE,
+				Data:       &protocolv1.EndpointMessage_VoidMessage{VoidMessage: &protocolv1.EndpointDataVoidMessage{}},
+			}
+		case protocolv1.PacketType_PACKET_TYPE_ENDPOINT_CLOSE:
+			var id int32
+			if err := codec256.ParseNullable(bytes.NewReader(packet.GetData()), &id); err != nil {
+				return
+			}
+			r.mu.Lock()
+			r.closed = append(r.closed, id)
+			r.mu.Unlock()
+			continue
+		case protocolv1.PacketType_PACKET_TYPE_DISCONNECT:
+			r.mu.Lock()
+			r.disconnects++
+			r.mu.Unlock()
+			return
+		default:
+			return
+		}
+
+		answer, err := protocolv1.NewPacket(reply)
+		if err != nil {
+			return
+		}
+		if _, err := answer.WriteTo(conn); err != nil {
+			return
+		}
+	}
+}
+
+// restart разрывает все соединения, как при перезапуске RAS
+func (r *fakeRAS) restart() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, conn := range r.conns {
+		_ = conn.Close()
+	}
+	r.conns = nil
+}
+
+func (r *fakeRAS) messages(conn int) []messagesv1.MessageType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if conn >= len(r.received) {
+		return nil
+	}
+	return append([]messagesv1.MessageType(nil), r.received[conn]...)
+}
+
+func (r *fakeRAS) closedEndpoints() []int32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]int32(nil), r.closed...)
+}
+
+func (r *fakeRAS) disconnected() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.disconnects
+}
+
+func TestBackoff_Duration(t *testing.T) {
+	b := Backoff{Min: 100 * time.Millisecond, Max: time.Second, Factor: 2}
+
+	assert.Equal(t, 100*time.Millisecond, b.Duration(0))
+	assert.Equal(t, 100*time.Millisecond, b.Duration(1))
+	assert.Equal(t, 400*time.Millisecond, b.Duration(3))
+	assert.Equal(t, time.Second, b.Duration(10))
+
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := b.Duration(2)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, 300*time.Millisecond)
+	}
+}
+
+func TestClientConn_ReconnectGivesUp(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	opts := defaultClientOptions
+	opts.ReconnectAttempts = 3
+	opts.ReconnectBackoff = Backoff{Min: time.Millisecond, Max: time.Millisecond}
+
+	conn := NewClientConn(addr, opts)
+	_, err = conn.turnEndpoint(context.Background())
+	assert.Error(t, err)
+	assert.False(t, conn.connected())
+}
+
+func TestEndpoint_ReauthenticatesAfterRestart(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	pool := NewPool(ras.addr())
+	defer pool.Close()
+
+	endpoint, err := pool.GetEndpoint(ctx)
+	require.NoError(t, err)
+
+	_, err = clientv1.NewAuthService(endpoint).AuthenticateCluster(ctx, &messagesv1.ClusterAuthenticateRequest{
+		ClusterId: "cluster-1",
+		User:      "admin",
+	})
+	require.NoError(t, err)
+
+	ras.restart()
+	// Соединение простаивало, пока RAS перезапускался
+	endpoint.(*Endpoint).conn.SetUsedAt(time.Now().Add(-time.Minute))
+
+	_, err = clientv1.NewClustersService(endpoint).GetClusters(ctx, &messagesv1.GetClustersRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []messagesv1.MessageType{
+		messagesv1.MessageType_AUTHENTICATE_REQUEST,
+		messagesv1.MessageType_GET_CLUSTERS_REQUEST,
+	}, ras.messages(1), "authentication must be replayed on the new connection")
+
+	again, err := pool.GetEndpoint(metadata.NewIncomingContext(ctx, metadata.Pairs("endpoint_id", EndpointID(endpoint))))
+	require.NoError(t, err)
+	assert.Same(t, endpoint, again, "endpoint_id must survive the restart")
+}
+
+func TestEndpoint_RemembersLatestAuth(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	pool := NewPool(ras.addr())
+	defer pool.Close()
+
+	endpoint, err := pool.GetEndpoint(ctx)
+	require.NoError(t, err)
+
+	auth := clientv1.NewAuthService(endpoint)
+	for _, user := range []string{"first", "second"} {
+		_, err = auth.AuthenticateCluster(ctx, &messagesv1.ClusterAuthenticateRequest{ClusterId: "cluster-1", User: user})
+		require.NoError(t, err)
+	}
+	_, err = auth.AuthenticateInfobase(ctx, &messagesv1.AuthenticateInfobaseRequest{ClusterId: "cluster-1", User: "ib"})
+	require.NoError(t, err)
+
+	typed := endpoint.(*Endpoint)
+	require.Len(t, typed.auth, 2)
+
+	replayed := new(messagesv1.ClusterAuthenticateRequest)
+	require.NoError(t, typed.auth[0].request.UnmarshalTo(replayed))
+	assert.Equal(t, "second", replayed.GetUser())
+}
+
+func TestEndpoint_Authenticated(t *testing.T) {
+	ras := newFakeRAS(t)
+	ctx := context.Background()
+
+	pool := NewPool(ras.addr())
+	defer pool.Close()
+
+	endpoint, err := pool.GetEndpoint(ctx)
+	require.NoError(t, err)
+	typed := endpoint.(*Endpoint)
+
+	request, err := anypb.New(&messagesv1.ClusterAuthenticateRequest{ClusterId: "cluster-1", User: "admin", Password: "secret"})
+	require.NoError(t, err)
+	assert.False(t, typed.Authenticated(request))
+
+	_, err = endpoint.Request(ctx, &clientv1.EndpointRequest{Request: request, Respond: emptyAny})
+	require.NoError(t, err)
+	assert.True(t, typed.Authenticated(request))
+
+	other, err := anypb.New(&messagesv1.ClusterAuthenticateRequest{ClusterId: "cluster-1", User: "admin", Password: "changed"})
+	require.NoError(t, err)
+	assert.False(t, typed.Authenticated(other), "other credentials must be sent to RAS")
+
+	assert.False(t, typed.Authenticated(emptyAny))
+}
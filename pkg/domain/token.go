@@ -1,28 +1,74 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"errors"
+	"time"
+)
 
 type AccessToken string
 
+var (
+	// ErrTokenNotFound is returned by repositories for unknown token IDs
+	ErrTokenNotFound = errors.New("token not found")
+	// ErrTokenExists is returned when storing a token ID twice
+	ErrTokenExists = errors.New("token already exists")
+)
+
+// TokenKind tells access and refresh tokens apart
+type TokenKind string
+
+const (
+	AccessTokenKind  TokenKind = "access"
+	RefreshTokenKind TokenKind = "refresh"
+)
+
+// Token is an issued access or refresh token. The token string itself
+// is never stored: access tokens are kept by their jti claim,
+// refresh tokens by the SHA-256 digest of the token.
 type Token struct {
+	ID        string    `json:"id"`
+	Kind      TokenKind `json:"kind"`
+	Subject   string    `json:"subject"`
+	Scopes    []string  `json:"scopes,omitempty"`
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Revoked   bool      `json:"revoked,omitempty"`
+
+	// Refresh chain: every token of a login shares the Family,
+	// ParentID is the refresh token a token was rotated from
+	Family   string `json:"family"`
+	ParentID string `json:"parent_id,omitempty"`
+	// Rotated marks a refresh token already exchanged for a new pair
+	Rotated bool `json:"rotated,omitempty"`
+}
+
+// Expired reports whether the token lifetime is over at now
+func (t Token) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Valid reports whether the token may be used at now
+func (t Token) Valid(now time.Time) bool {
+	return !t.Revoked && !t.Expired(now)
 }
 
-// TokenUsecase represent the article's usecases
+// TokenUsecase represent the token's usecases
 type TokenUsecase interface {
 	Fetch(ctx context.Context, cursor string, num int64) ([]Token, string, error)
-	GetByID(ctx context.Context, id int64) (Token, error)
-	Update(ctx context.Context, ar *Token) error
-	GetByTitle(ctx context.Context, title string) (Token, error)
+	GetByID(ctx context.Context, id string) (Token, error)
+	Update(ctx context.Context, t *Token) error
 	Store(context.Context, *Token) error
-	Delete(ctx context.Context, id int64) error
+	Delete(ctx context.Context, id string) error
 }
 
-// TokenRepository represent the article's repository contract
+// TokenRepository represent the token's repository contract.
+// Fetch lists up to num tokens (all if num <= 0) ordered by ID
+// starting after cursor, an empty nextCursor means there are no more.
 type TokenRepository interface {
 	Fetch(ctx context.Context, cursor string, num int64) (res []Token, nextCursor string, err error)
-	GetByID(ctx context.Context, id int64) (Token, error)
-	GetByTitle(ctx context.Context, title string) (Token, error)
-	Update(ctx context.Context, ar *Token) error
-	Store(ctx context.Context, a *Token) error
-	Delete(ctx context.Context, id int64) error
+	GetByID(ctx context.Context, id string) (Token, error)
+	Update(ctx context.Context, t *Token) error
+	Store(ctx context.Context, t *Token) error
+	Delete(ctx context.Context, id string) error
 }
@@ -171,6 +171,7 @@ type ClientInfo struct {
 	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
 	LastUsed      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
 	IdleAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=idle_at,json=idleAt,proto3" json:"idle_at,omitempty"`
+	Pool          *PoolStats             `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"` // Состояние пула соединений клиента
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,6 +248,90 @@ func (x *ClientInfo) GetIdleAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ClientInfo) GetPool() *PoolStats {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+// PoolStats состояние пула соединений с RAS
+type PoolStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conns         int32                  `protobuf:"varint,1,opt,name=conns,proto3" json:"conns,omitempty"`               // Соединений в пуле
+	Connected     int32                  `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`       // Из них подключено к RAS
+	Endpoints     int32                  `protobuf:"varint,3,opt,name=endpoints,proto3" json:"endpoints,omitempty"`       // Открытых endpoint
+	Evicted       uint64                 `protobuf:"varint,4,opt,name=evicted,proto3" json:"evicted,omitempty"`           // Закрыто простаивающих endpoint
+	Disconnected  uint64                 `protobuf:"varint,5,opt,name=disconnected,proto3" json:"disconnected,omitempty"` // Отключено простаивающих соединений
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolStats) Reset() {
+	*x = PoolStats{}
+	mi := &file_access_service_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolStats) ProtoMessage() {}
+
+func (x *PoolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolStats.ProtoReflect.Descriptor instead.
+func (*PoolStats) Descriptor() ([]byte, []int) {
+	return file_access_service_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PoolStats) GetConns() int32 {
+	if x != nil {
+		return x.Conns
+	}
+	return 0
+}
+
+func (x *PoolStats) GetConnected() int32 {
+	if x != nil {
+		return x.Connected
+	}
+	return 0
+}
+
+func (x *PoolStats) GetEndpoints() int32 {
+	if x != nil {
+		return x.Endpoints
+	}
+	return 0
+}
+
+func (x *PoolStats) GetEvicted() uint64 {
+	if x != nil {
+		return x.Evicted
+	}
+	return 0
+}
+
+func (x *PoolStats) GetDisconnected() uint64 {
+	if x != nil {
+		return x.Disconnected
+	}
+	return 0
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`                                   // Хост службы RAS
@@ -259,7 +344,7 @@ type RegisterRequest struct {
 
 func (x *RegisterRequest) Reset() {
 	*x = RegisterRequest{}
-	mi := &file_access_service_client_proto_msgTypes[4]
+	mi := &file_access_service_client_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +356,7 @@ func (x *RegisterRequest) String() string {
 func (*RegisterRequest) ProtoMessage() {}
 
 func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_access_service_client_proto_msgTypes[4]
+	mi := &file_access_service_client_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +369,7 @@ func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return file_access_service_client_proto_rawDescGZIP(), []int{4}
+	return file_access_service_client_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RegisterRequest) GetHost() string {
@@ -325,7 +410,7 @@ type RegisterResponse struct {
 
 func (x *RegisterResponse) Reset() {
 	*x = RegisterResponse{}
-	mi := &file_access_service_client_proto_msgTypes[5]
+	mi := &file_access_service_client_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +422,7 @@ func (x *RegisterResponse) String() string {
 func (*RegisterResponse) ProtoMessage() {}
 
 func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_access_service_client_proto_msgTypes[5]
+	mi := &file_access_service_client_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,7 +435,7 @@ func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return file_access_service_client_proto_rawDescGZIP(), []int{5}
+	return file_access_service_client_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RegisterResponse) GetUuid() string {
@@ -378,7 +463,7 @@ const file_access_service_client_proto_rawDesc = "" +
 	"\x04hash\x18\x04 \x01(\tR\x04hash\"^\n" +
 	"\x12GetClientsResponse\x124\n" +
 	"\aclients\x18\x01 \x03(\v2\x1a.access.service.ClientInfoR\aclients\x12\x12\n" +
-	"\x04hash\x18\x04 \x01(\tR\x04hash\"\xdd\x01\n" +
+	"\x04hash\x18\x04 \x01(\tR\x04hash\"\x8c\x02\n" +
 	"\n" +
 	"ClientInfo\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12!\n" +
@@ -386,7 +471,14 @@ const file_access_service_client_proto_rawDesc = "" +
 	"\x04uuid\x18\x03 \x01(\tR\x04uuid\x12\x16\n" +
 	"\x06active\x18\x04 \x01(\bR\x06active\x127\n" +
 	"\tlast_used\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\blastUsed\x123\n" +
-	"\aidle_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06idleAt\"~\n" +
+	"\aidle_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06idleAt\x12-\n" +
+	"\x04pool\x18\a \x01(\v2\x19.access.service.PoolStatsR\x04pool\"\x9b\x01\n" +
+	"\tPoolStats\x12\x14\n" +
+	"\x05conns\x18\x01 \x01(\x05R\x05conns\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\x05R\tconnected\x12\x1c\n" +
+	"\tendpoints\x18\x03 \x01(\x05R\tendpoints\x12\x18\n" +
+	"\aevicted\x18\x04 \x01(\x04R\aevicted\x12\"\n" +
+	"\fdisconnected\x18\x05 \x01(\x04R\fdisconnected\"~\n" +
 	"\x0fRegisterRequest\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12!\n" +
 	"\fidle_timeout\x18\x02 \x01(\x05R\vidleTimeout\x12\x17\n" +
@@ -415,32 +507,34 @@ func file_access_service_client_proto_rawDescGZIP() []byte {
 	return file_access_service_client_proto_rawDescData
 }
 
-var file_access_service_client_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_access_service_client_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_access_service_client_proto_goTypes = []any{
 	(*ResetClientsRequest)(nil),   // 0: access.service.ResetClientsRequest
 	(*GetClientsRequest)(nil),     // 1: access.service.GetClientsRequest
 	(*GetClientsResponse)(nil),    // 2: access.service.GetClientsResponse
 	(*ClientInfo)(nil),            // 3: access.service.ClientInfo
-	(*RegisterRequest)(nil),       // 4: access.service.RegisterRequest
-	(*RegisterResponse)(nil),      // 5: access.service.RegisterResponse
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+	(*PoolStats)(nil),             // 4: access.service.PoolStats
+	(*RegisterRequest)(nil),       // 5: access.service.RegisterRequest
+	(*RegisterResponse)(nil),      // 6: access.service.RegisterResponse
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
 }
 var file_access_service_client_proto_depIdxs = []int32{
 	3, // 0: access.service.GetClientsResponse.clients:type_name -> access.service.ClientInfo
-	6, // 1: access.service.ClientInfo.last_used:type_name -> google.protobuf.Timestamp
-	6, // 2: access.service.ClientInfo.idle_at:type_name -> google.protobuf.Timestamp
-	4, // 3: access.service.ClientService.Register:input_type -> access.service.RegisterRequest
-	1, // 4: access.service.ClientService.GetClients:input_type -> access.service.GetClientsRequest
-	0, // 5: access.service.ClientService.ResetClients:input_type -> access.service.ResetClientsRequest
-	5, // 6: access.service.ClientService.Register:output_type -> access.service.RegisterResponse
-	2, // 7: access.service.ClientService.GetClients:output_type -> access.service.GetClientsResponse
-	7, // 8: access.service.ClientService.ResetClients:output_type -> google.protobuf.Empty
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7, // 1: access.service.ClientInfo.last_used:type_name -> google.protobuf.Timestamp
+	7, // 2: access.service.ClientInfo.idle_at:type_name -> google.protobuf.Timestamp
+	4, // 3: access.service.ClientInfo.pool:type_name -> access.service.PoolStats
+	5, // 4: access.service.ClientService.Register:input_type -> access.service.RegisterRequest
+	1, // 5: access.service.ClientService.GetClients:input_type -> access.service.GetClientsRequest
+	0, // 6: access.service.ClientService.ResetClients:input_type -> access.service.ResetClientsRequest
+	6, // 7: access.service.ClientService.Register:output_type -> access.service.RegisterResponse
+	2, // 8: access.service.ClientService.GetClients:output_type -> access.service.GetClientsResponse
+	8, // 9: access.service.ClientService.ResetClients:output_type -> google.protobuf.Empty
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_access_service_client_proto_init() }
@@ -448,14 +542,14 @@ func file_access_service_client_proto_init() {
 	if File_access_service_client_proto != nil {
 		return
 	}
-	file_access_service_client_proto_msgTypes[4].OneofWrappers = []any{}
+	file_access_service_client_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_access_service_client_proto_rawDesc), len(file_access_service_client_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: access/service/credentials.proto
+
+package service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SetCredentialsRequest сохраняет учетные данные:
+//   - infobase_id пустой: администратор кластера
+//   - infobase_id = "*": пользователь по умолчанию для всех баз кластера
+//   - иначе: пользователь информационной базы
+type SetCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`          // UUID кластера 1С
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`       // UUID информационной базы
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`                                     // Пользователь
+	UserPassword  string                 `protobuf:"bytes,4,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"` // Пароль (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCredentialsRequest) Reset() {
+	*x = SetCredentialsRequest{}
+	mi := &file_access_service_credentials_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCredentialsRequest) ProtoMessage() {}
+
+func (x *SetCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_credentials_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*SetCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_access_service_credentials_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetCredentialsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *SetCredentialsRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *SetCredentialsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SetCredentialsRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+// RotateCredentialsRequest меняет пароль сохраненных учетных данных
+type RotateCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	UserPassword  string                 `protobuf:"bytes,3,opt,name=user_password,json=userPassword,proto3" json:"user_password,omitempty"` // Новый пароль
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateCredentialsRequest) Reset() {
+	*x = RotateCredentialsRequest{}
+	mi := &file_access_service_credentials_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateCredentialsRequest) ProtoMessage() {}
+
+func (x *RotateCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_credentials_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RotateCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_access_service_credentials_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RotateCredentialsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *RotateCredentialsRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *RotateCredentialsRequest) GetUserPassword() string {
+	if x != nil {
+		return x.UserPassword
+	}
+	return ""
+}
+
+// DeleteCredentialsRequest удаляет сохраненные учетные данные.
+// Данные, с которыми шлюз установит или снимет запланированную
+// блокировку базы, не удаляются (FailedPrecondition), пока блокировка не отменена.
+type DeleteCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCredentialsRequest) Reset() {
+	*x = DeleteCredentialsRequest{}
+	mi := &file_access_service_credentials_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCredentialsRequest) ProtoMessage() {}
+
+func (x *DeleteCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_credentials_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_access_service_credentials_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteCredentialsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DeleteCredentialsRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+type ListCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"` // Отбор по кластеру, пустой - все кластеры
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCredentialsRequest) Reset() {
+	*x = ListCredentialsRequest{}
+	mi := &file_access_service_credentials_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCredentialsRequest) ProtoMessage() {}
+
+func (x *ListCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_credentials_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ListCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_access_service_credentials_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListCredentialsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type ListCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credentials   []*StoredCredentials   `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCredentialsResponse) Reset() {
+	*x = ListCredentialsResponse{}
+	mi := &file_access_service_credentials_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCredentialsResponse) ProtoMessage() {}
+
+func (x *ListCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_credentials_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_access_service_credentials_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListCredentialsResponse) GetCredentials() []*StoredCredentials {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// StoredCredentials описывает сохраненные учетные данные без пароля
+type StoredCredentials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoredCredentials) Reset() {
+	*x = StoredCredentials{}
+	mi := &file_access_service_credentials_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredCredentials) ProtoMessage() {}
+
+func (x *StoredCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_access_service_credentials_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredCredentials.ProtoReflect.Descriptor instead.
+func (*StoredCredentials) Descriptor() ([]byte, []int) {
+	return file_access_service_credentials_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StoredCredentials) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *StoredCredentials) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *StoredCredentials) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *StoredCredentials) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_access_service_credentials_proto protoreflect.FileDescriptor
+
+const file_access_service_credentials_proto_rawDesc = "" +
+	"\n" +
+	" access/service/credentials.proto\x12\x0eaccess.service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\x90\x01\n" +
+	"\x15SetCredentialsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x12#\n" +
+	"\ruser_password\x18\x04 \x01(\tR\fuserPassword\"\x7f\n" +
+	"\x18RotateCredentialsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12#\n" +
+	"\ruser_password\x18\x03 \x01(\tR\fuserPassword\"Z\n" +
+	"\x18DeleteCredentialsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\"7\n" +
+	"\x16ListCredentialsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\"^\n" +
+	"\x17ListCredentialsResponse\x12C\n" +
+	"\vcredentials\x18\x01 \x03(\v2!.access.service.StoredCredentialsR\vcredentials\"\xa2\x01\n" +
+	"\x11StoredCredentials\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\tR\x04user\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt2\xfe\x02\n" +
+	"\x11CredentialService\x12Q\n" +
+	"\x0eSetCredentials\x12%.access.service.SetCredentialsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
+	"\x11RotateCredentials\x12(.access.service.RotateCredentialsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
+	"\x11DeleteCredentials\x12(.access.service.DeleteCredentialsRequest\x1a\x16.google.protobuf.Empty\"\x00\x12d\n" +
+	"\x0fListCredentials\x12&.access.service.ListCredentialsRequest\x1a'.access.service.ListCredentialsResponse\"\x00B\xb9\x01\n" +
+	"\x12com.access.serviceB\x10CredentialsProtoP\x01Z8github.com/v8platform/ras-grpc-gq/pkg/gen/access/service\xa2\x02\x03ASX\xaa\x02\x0eAccess.Service\xca\x02\x0eAccess\\Service\xe2\x02\x1aAccess\\Service\\GPBMetadata\xea\x02\x0fAccess::Serviceb\x06proto3"
+
+var (
+	file_access_service_credentials_proto_rawDescOnce sync.Once
+	file_access_service_credentials_proto_rawDescData []byte
+)
+
+func file_access_service_credentials_proto_rawDescGZIP() []byte {
+	file_access_service_credentials_proto_rawDescOnce.Do(func() {
+		file_access_service_credentials_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_access_service_credentials_proto_rawDesc), len(file_access_service_credentials_proto_rawDesc)))
+	})
+	return file_access_service_credentials_proto_rawDescData
+}
+
+var file_access_service_credentials_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_access_service_credentials_proto_goTypes = []any{
+	(*SetCredentialsRequest)(nil),    // 0: access.service.SetCredentialsRequest
+	(*RotateCredentialsRequest)(nil), // 1: access.service.RotateCredentialsRequest
+	(*DeleteCredentialsRequest)(nil), // 2: access.service.DeleteCredentialsRequest
+	(*ListCredentialsRequest)(nil),   // 3: access.service.ListCredentialsRequest
+	(*ListCredentialsResponse)(nil),  // 4: access.service.ListCredentialsResponse
+	(*StoredCredentials)(nil),        // 5: access.service.StoredCredentials
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 7: google.protobuf.Empty
+}
+var file_access_service_credentials_proto_depIdxs = []int32{
+	5, // 0: access.service.ListCredentialsResponse.credentials:type_name -> access.service.StoredCredentials
+	6, // 1: access.service.StoredCredentials.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: access.service.CredentialService.SetCredentials:input_type -> access.service.SetCredentialsRequest
+	1, // 3: access.service.CredentialService.RotateCredentials:input_type -> access.service.RotateCredentialsRequest
+	2, // 4: access.service.CredentialService.DeleteCredentials:input_type -> access.service.DeleteCredentialsRequest
+	3, // 5: access.service.CredentialService.ListCredentials:input_type -> access.service.ListCredentialsRequest
+	7, // 6: access.service.CredentialService.SetCredentials:output_type -> google.protobuf.Empty
+	7, // 7: access.service.CredentialService.RotateCredentials:output_type -> google.protobuf.Empty
+	7, // 8: access.service.CredentialService.DeleteCredentials:output_type -> google.protobuf.Empty
+	4, // 9: access.service.CredentialService.ListCredentials:output_type -> access.service.ListCredentialsResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_access_service_credentials_proto_init() }
+func file_access_service_credentials_proto_init() {
+	if File_access_service_credentials_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_access_service_credentials_proto_rawDesc), len(file_access_service_credentials_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_access_service_credentials_proto_goTypes,
+		DependencyIndexes: file_access_service_credentials_proto_depIdxs,
+		MessageInfos:      file_access_service_credentials_proto_msgTypes,
+	}.Build()
+	File_access_service_credentials_proto = out.File
+	file_access_service_credentials_proto_goTypes = nil
+	file_access_service_credentials_proto_depIdxs = nil
+}
@@ -0,0 +1,824 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/admins.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdminInfo администратор агента или кластера
+type AdminInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	// Пароль, в ответах RAS не возвращается
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Разрешена аутентификация по паролю
+	PasswordAuthAllowed bool `protobuf:"varint,4,opt,name=password_auth_allowed,json=passwordAuthAllowed,proto3" json:"password_auth_allowed,omitempty"`
+	// Разрешена аутентификация операционной системы
+	OsAuthAllowed bool `protobuf:"varint,5,opt,name=os_auth_allowed,json=osAuthAllowed,proto3" json:"os_auth_allowed,omitempty"`
+	// Пользователь операционной системы (DOMAIN\user)
+	OsUser        string `protobuf:"bytes,6,opt,name=os_user,json=osUser,proto3" json:"os_user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminInfo) Reset() {
+	*x = AdminInfo{}
+	mi := &file_cluster_service_admins_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminInfo) ProtoMessage() {}
+
+func (x *AdminInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminInfo.ProtoReflect.Descriptor instead.
+func (*AdminInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetPasswordAuthAllowed() bool {
+	if x != nil {
+		return x.PasswordAuthAllowed
+	}
+	return false
+}
+
+func (x *AdminInfo) GetOsAuthAllowed() bool {
+	if x != nil {
+		return x.OsAuthAllowed
+	}
+	return false
+}
+
+func (x *AdminInfo) GetOsUser() string {
+	if x != nil {
+		return x.OsUser
+	}
+	return ""
+}
+
+type GetAgentAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentAdminsRequest) Reset() {
+	*x = GetAgentAdminsRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentAdminsRequest) ProtoMessage() {}
+
+func (x *GetAgentAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{1}
+}
+
+type GetAgentAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*AdminInfo           `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentAdminsResponse) Reset() {
+	*x = GetAgentAdminsResponse{}
+	mi := &file_cluster_service_admins_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentAdminsResponse) ProtoMessage() {}
+
+func (x *GetAgentAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAgentAdminsResponse) GetAdmins() []*AdminInfo {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type GetClusterAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterAdminsRequest) Reset() {
+	*x = GetClusterAdminsRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterAdminsRequest) ProtoMessage() {}
+
+func (x *GetClusterAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetClusterAdminsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetClusterAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*AdminInfo           `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterAdminsResponse) Reset() {
+	*x = GetClusterAdminsResponse{}
+	mi := &file_cluster_service_admins_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterAdminsResponse) ProtoMessage() {}
+
+func (x *GetClusterAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetClusterAdminsResponse) GetAdmins() []*AdminInfo {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type RegAgentAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *AdminInfo             `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegAgentAdminRequest) Reset() {
+	*x = RegAgentAdminRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegAgentAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegAgentAdminRequest) ProtoMessage() {}
+
+func (x *RegAgentAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegAgentAdminRequest.ProtoReflect.Descriptor instead.
+func (*RegAgentAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RegAgentAdminRequest) GetInfo() *AdminInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type RegClusterAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Info          *AdminInfo             `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegClusterAdminRequest) Reset() {
+	*x = RegClusterAdminRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegClusterAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegClusterAdminRequest) ProtoMessage() {}
+
+func (x *RegClusterAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegClusterAdminRequest.ProtoReflect.Descriptor instead.
+func (*RegClusterAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RegClusterAdminRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *RegClusterAdminRequest) GetInfo() *AdminInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type UnregAgentAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregAgentAdminRequest) Reset() {
+	*x = UnregAgentAdminRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregAgentAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregAgentAdminRequest) ProtoMessage() {}
+
+func (x *UnregAgentAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregAgentAdminRequest.ProtoReflect.Descriptor instead.
+func (*UnregAgentAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnregAgentAdminRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnregClusterAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregClusterAdminRequest) Reset() {
+	*x = UnregClusterAdminRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregClusterAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregClusterAdminRequest) ProtoMessage() {}
+
+func (x *UnregClusterAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregClusterAdminRequest.ProtoReflect.Descriptor instead.
+func (*UnregClusterAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnregClusterAdminRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UnregClusterAdminRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ListAgentAdminsRequest список администраторов агента
+type ListAgentAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AgentUser     string                 `protobuf:"bytes,1,opt,name=agent_user,json=agentUser,proto3" json:"agent_user,omitempty"`
+	AgentPassword string                 `protobuf:"bytes,2,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAgentAdminsRequest) Reset() {
+	*x = ListAgentAdminsRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAgentAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAgentAdminsRequest) ProtoMessage() {}
+
+func (x *ListAgentAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAgentAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAgentAdminsRequest) GetAgentUser() string {
+	if x != nil {
+		return x.AgentUser
+	}
+	return ""
+}
+
+func (x *ListAgentAdminsRequest) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+// AddAdminRequest добавление администратора агента (пустой cluster_id)
+// или кластера. Нужен пароль или пользователь ОС.
+type AddAdminRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Descr     string                 `protobuf:"bytes,3,opt,name=descr,proto3" json:"descr,omitempty"`
+	// Пароль нового администратора, включает аутентификацию по паролю
+	AdminPassword string `protobuf:"bytes,4,opt,name=admin_password,json=adminPassword,proto3" json:"admin_password,omitempty"`
+	// Пользователь ОС, включает аутентификацию операционной системы
+	OsUser string `protobuf:"bytes,5,opt,name=os_user,json=osUser,proto3" json:"os_user,omitempty"`
+	// Администратор агента для операций с администраторами агента
+	AgentUser     string `protobuf:"bytes,6,opt,name=agent_user,json=agentUser,proto3" json:"agent_user,omitempty"`
+	AgentPassword string `protobuf:"bytes,7,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAdminRequest) Reset() {
+	*x = AddAdminRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAdminRequest) ProtoMessage() {}
+
+func (x *AddAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAdminRequest.ProtoReflect.Descriptor instead.
+func (*AddAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddAdminRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetAdminPassword() string {
+	if x != nil {
+		return x.AdminPassword
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetOsUser() string {
+	if x != nil {
+		return x.OsUser
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetAgentUser() string {
+	if x != nil {
+		return x.AgentUser
+	}
+	return ""
+}
+
+func (x *AddAdminRequest) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+// RemoveAgentAdminRequest удаление администратора агента
+type RemoveAgentAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AgentUser     string                 `protobuf:"bytes,2,opt,name=agent_user,json=agentUser,proto3" json:"agent_user,omitempty"`
+	AgentPassword string                 `protobuf:"bytes,3,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveAgentAdminRequest) Reset() {
+	*x = RemoveAgentAdminRequest{}
+	mi := &file_cluster_service_admins_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveAgentAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAgentAdminRequest) ProtoMessage() {}
+
+func (x *RemoveAgentAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_admins_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAgentAdminRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAgentAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_admins_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveAgentAdminRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveAgentAdminRequest) GetAgentUser() string {
+	if x != nil {
+		return x.AgentUser
+	}
+	return ""
+}
+
+func (x *RemoveAgentAdminRequest) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+var File_cluster_service_admins_proto protoreflect.FileDescriptor
+
+const file_cluster_service_admins_proto_rawDesc = "" +
+	"\n" +
+	"\x1ccluster/service/admins.proto\x12\x0fcluster.service\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"\x82\x02\n" +
+	"\tAdminInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12$\n" +
+	"\bpassword\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\bpassword\x12<\n" +
+	"\x15password_auth_allowed\x18\x04 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\x13passwordAuthAllowed\x120\n" +
+	"\x0fos_auth_allowed\x18\x05 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\rosAuthAllowed\x12!\n" +
+	"\aos_user\x18\x06 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x06osUser\";\n" +
+	"\x15GetAgentAdminsRequest:\"\x8a\xf5\xea\x94\x0e\x1c\b\x01:\x18GET_AGENT_ADMINS_REQUEST\"y\n" +
+	"\x16GetAgentAdminsResponse\x12<\n" +
+	"\x06admins\x18\x01 \x03(\v2\x1a.cluster.service.AdminInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06admins:!\x8a\xf5\xea\x94\x0e\x1b:\x19GET_AGENT_ADMINS_RESPONSE\"l\n" +
+	"\x17GetClusterAdminsRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aGET_CLUSTER_ADMINS_REQUEST\"}\n" +
+	"\x18GetClusterAdminsResponse\x12<\n" +
+	"\x06admins\x18\x01 \x03(\v2\x1a.cluster.service.AdminInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06admins:#\x8a\xf5\xea\x94\x0e\x1d:\x1bGET_CLUSTER_ADMINS_RESPONSE\"q\n" +
+	"\x14RegAgentAdminRequest\x128\n" +
+	"\x04info\x18\x01 \x01(\v2\x1a.cluster.service.AdminInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:\x1f\x8a\xf5\xea\x94\x0e\x19:\x17REG_AGENT_ADMIN_REQUEST\"\xa4\x01\n" +
+	"\x16RegClusterAdminRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x128\n" +
+	"\x04info\x18\x02 \x01(\v2\x1a.cluster.service.AdminInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04info:!\x8a\xf5\xea\x94\x0e\x1b:\x19REG_CLUSTER_ADMIN_REQUEST\"Y\n" +
+	"\x16UnregAgentAdminRequest\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name:!\x8a\xf5\xea\x94\x0e\x1b:\x19UNREG_AGENT_ADMIN_REQUEST\"\x8c\x01\n" +
+	"\x18UnregClusterAdminRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04name:#\x8a\xf5\xea\x94\x0e\x1d:\x1bUNREG_CLUSTER_ADMIN_REQUEST\"^\n" +
+	"\x16ListAgentAdminsRequest\x12\x1d\n" +
+	"\n" +
+	"agent_user\x18\x01 \x01(\tR\tagentUser\x12%\n" +
+	"\x0eagent_password\x18\x02 \x01(\tR\ragentPassword\"\xe0\x01\n" +
+	"\x0fAddAdminRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05descr\x18\x03 \x01(\tR\x05descr\x12%\n" +
+	"\x0eadmin_password\x18\x04 \x01(\tR\radminPassword\x12\x17\n" +
+	"\aos_user\x18\x05 \x01(\tR\x06osUser\x12\x1d\n" +
+	"\n" +
+	"agent_user\x18\x06 \x01(\tR\tagentUser\x12%\n" +
+	"\x0eagent_password\x18\a \x01(\tR\ragentPassword\"s\n" +
+	"\x17RemoveAgentAdminRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"agent_user\x18\x02 \x01(\tR\tagentUser\x12%\n" +
+	"\x0eagent_password\x18\x03 \x01(\tR\ragentPassword2\xa1\x04\n" +
+	"\rAdminsService\x12b\n" +
+	"\x0eGetAgentAdmins\x12'.cluster.service.ListAgentAdminsRequest\x1a'.cluster.service.GetAgentAdminsResponse\x12g\n" +
+	"\x10GetClusterAdmins\x12(.cluster.service.GetClusterAdminsRequest\x1a).cluster.service.GetClusterAdminsResponse\x12I\n" +
+	"\rRegAgentAdmin\x12 .cluster.service.AddAdminRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0fRegClusterAdmin\x12 .cluster.service.AddAdminRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
+	"\x0fUnregAgentAdmin\x12(.cluster.service.RemoveAgentAdminRequest\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x11UnregClusterAdmin\x12).cluster.service.UnregClusterAdminRequest\x1a\x16.google.protobuf.EmptyB\xba\x01\n" +
+	"\x13com.cluster.serviceB\vAdminsProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_admins_proto_rawDescOnce sync.Once
+	file_cluster_service_admins_proto_rawDescData []byte
+)
+
+func file_cluster_service_admins_proto_rawDescGZIP() []byte {
+	file_cluster_service_admins_proto_rawDescOnce.Do(func() {
+		file_cluster_service_admins_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_admins_proto_rawDesc), len(file_cluster_service_admins_proto_rawDesc)))
+	})
+	return file_cluster_service_admins_proto_rawDescData
+}
+
+var file_cluster_service_admins_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_cluster_service_admins_proto_goTypes = []any{
+	(*AdminInfo)(nil),                // 0: cluster.service.AdminInfo
+	(*GetAgentAdminsRequest)(nil),    // 1: cluster.service.GetAgentAdminsRequest
+	(*GetAgentAdminsResponse)(nil),   // 2: cluster.service.GetAgentAdminsResponse
+	(*GetClusterAdminsRequest)(nil),  // 3: cluster.service.GetClusterAdminsRequest
+	(*GetClusterAdminsResponse)(nil), // 4: cluster.service.GetClusterAdminsResponse
+	(*RegAgentAdminRequest)(nil),     // 5: cluster.service.RegAgentAdminRequest
+	(*RegClusterAdminRequest)(nil),   // 6: cluster.service.RegClusterAdminRequest
+	(*UnregAgentAdminRequest)(nil),   // 7: cluster.service.UnregAgentAdminRequest
+	(*UnregClusterAdminRequest)(nil), // 8: cluster.service.UnregClusterAdminRequest
+	(*ListAgentAdminsRequest)(nil),   // 9: cluster.service.ListAgentAdminsRequest
+	(*AddAdminRequest)(nil),          // 10: cluster.service.AddAdminRequest
+	(*RemoveAgentAdminRequest)(nil),  // 11: cluster.service.RemoveAgentAdminRequest
+	(*emptypb.Empty)(nil),            // 12: google.protobuf.Empty
+}
+var file_cluster_service_admins_proto_depIdxs = []int32{
+	0,  // 0: cluster.service.GetAgentAdminsResponse.admins:type_name -> cluster.service.AdminInfo
+	0,  // 1: cluster.service.GetClusterAdminsResponse.admins:type_name -> cluster.service.AdminInfo
+	0,  // 2: cluster.service.RegAgentAdminRequest.info:type_name -> cluster.service.AdminInfo
+	0,  // 3: cluster.service.RegClusterAdminRequest.info:type_name -> cluster.service.AdminInfo
+	9,  // 4: cluster.service.AdminsService.GetAgentAdmins:input_type -> cluster.service.ListAgentAdminsRequest
+	3,  // 5: cluster.service.AdminsService.GetClusterAdmins:input_type -> cluster.service.GetClusterAdminsRequest
+	10, // 6: cluster.service.AdminsService.RegAgentAdmin:input_type -> cluster.service.AddAdminRequest
+	10, // 7: cluster.service.AdminsService.RegClusterAdmin:input_type -> cluster.service.AddAdminRequest
+	11, // 8: cluster.service.AdminsService.UnregAgentAdmin:input_type -> cluster.service.RemoveAgentAdminRequest
+	8,  // 9: cluster.service.AdminsService.UnregClusterAdmin:input_type -> cluster.service.UnregClusterAdminRequest
+	2,  // 10: cluster.service.AdminsService.GetAgentAdmins:output_type -> cluster.service.GetAgentAdminsResponse
+	4,  // 11: cluster.service.AdminsService.GetClusterAdmins:output_type -> cluster.service.GetClusterAdminsResponse
+	12, // 12: cluster.service.AdminsService.RegAgentAdmin:output_type -> google.protobuf.Empty
+	12, // 13: cluster.service.AdminsService.RegClusterAdmin:output_type -> google.protobuf.Empty
+	12, // 14: cluster.service.AdminsService.UnregAgentAdmin:output_type -> google.protobuf.Empty
+	12, // 15: cluster.service.AdminsService.UnregClusterAdmin:output_type -> google.protobuf.Empty
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_admins_proto_init() }
+func file_cluster_service_admins_proto_init() {
+	if File_cluster_service_admins_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_admins_proto_rawDesc), len(file_cluster_service_admins_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_admins_proto_goTypes,
+		DependencyIndexes: file_cluster_service_admins_proto_depIdxs,
+		MessageInfos:      file_cluster_service_admins_proto_msgTypes,
+	}.Build()
+	File_cluster_service_admins_proto = out.File
+	file_cluster_service_admins_proto_goTypes = nil
+	file_cluster_service_admins_proto_depIdxs = nil
+}
@@ -0,0 +1,328 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/admins.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminsService_GetAgentAdmins_FullMethodName    = "/cluster.service.AdminsService/GetAgentAdmins"
+	AdminsService_GetClusterAdmins_FullMethodName  = "/cluster.service.AdminsService/GetClusterAdmins"
+	AdminsService_RegAgentAdmin_FullMethodName     = "/cluster.service.AdminsService/RegAgentAdmin"
+	AdminsService_RegClusterAdmin_FullMethodName   = "/cluster.service.AdminsService/RegClusterAdmin"
+	AdminsService_UnregAgentAdmin_FullMethodName   = "/cluster.service.AdminsService/UnregAgentAdmin"
+	AdminsService_UnregClusterAdmin_FullMethodName = "/cluster.service.AdminsService/UnregClusterAdmin"
+)
+
+// AdminsServiceClient is the client API for AdminsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminsService администраторы центрального сервера (агента) и кластеров
+type AdminsServiceClient interface {
+	// GetAgentAdmins список администраторов агента
+	GetAgentAdmins(ctx context.Context, in *ListAgentAdminsRequest, opts ...grpc.CallOption) (*GetAgentAdminsResponse, error)
+	// GetClusterAdmins список администраторов кластера
+	GetClusterAdmins(ctx context.Context, in *GetClusterAdminsRequest, opts ...grpc.CallOption) (*GetClusterAdminsResponse, error)
+	// RegAgentAdmin добавление администратора агента
+	RegAgentAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RegClusterAdmin добавление администратора кластера
+	RegClusterAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UnregAgentAdmin удаление администратора агента
+	UnregAgentAdmin(ctx context.Context, in *RemoveAgentAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UnregClusterAdmin удаление администратора кластера
+	UnregClusterAdmin(ctx context.Context, in *UnregClusterAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type adminsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminsServiceClient(cc grpc.ClientConnInterface) AdminsServiceClient {
+	return &adminsServiceClient{cc}
+}
+
+func (c *adminsServiceClient) GetAgentAdmins(ctx context.Context, in *ListAgentAdminsRequest, opts ...grpc.CallOption) (*GetAgentAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAgentAdminsResponse)
+	err := c.cc.Invoke(ctx, AdminsService_GetAgentAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminsServiceClient) GetClusterAdmins(ctx context.Context, in *GetClusterAdminsRequest, opts ...grpc.CallOption) (*GetClusterAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterAdminsResponse)
+	err := c.cc.Invoke(ctx, AdminsService_GetClusterAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminsServiceClient) RegAgentAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminsService_RegAgentAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminsServiceClient) RegClusterAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminsService_RegClusterAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminsServiceClient) UnregAgentAdmin(ctx context.Context, in *RemoveAgentAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminsService_UnregAgentAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminsServiceClient) UnregClusterAdmin(ctx context.Context, in *UnregClusterAdminRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AdminsService_UnregClusterAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminsServiceServer is the server API for AdminsService service.
+// All implementations must embed UnimplementedAdminsServiceServer
+// for forward compatibility.
+//
+// AdminsService администраторы центрального сервера (агента) и кластеров
+type AdminsServiceServer interface {
+	// GetAgentAdmins список администраторов агента
+	GetAgentAdmins(context.Context, *ListAgentAdminsRequest) (*GetAgentAdminsResponse, error)
+	// GetClusterAdmins список администраторов кластера
+	GetClusterAdmins(context.Context, *GetClusterAdminsRequest) (*GetClusterAdminsResponse, error)
+	// RegAgentAdmin добавление администратора агента
+	RegAgentAdmin(context.Context, *AddAdminRequest) (*emptypb.Empty, error)
+	// RegClusterAdmin добавление администратора кластера
+	RegClusterAdmin(context.Context, *AddAdminRequest) (*emptypb.Empty, error)
+	// UnregAgentAdmin удаление администратора агента
+	UnregAgentAdmin(context.Context, *RemoveAgentAdminRequest) (*emptypb.Empty, error)
+	// UnregClusterAdmin удаление администратора кластера
+	UnregClusterAdmin(context.Context, *UnregClusterAdminRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAdminsServiceServer()
+}
+
+// UnimplementedAdminsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminsServiceServer struct{}
+
+func (UnimplementedAdminsServiceServer) GetAgentAdmins(context.Context, *ListAgentAdminsRequest) (*GetAgentAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentAdmins not implemented")
+}
+func (UnimplementedAdminsServiceServer) GetClusterAdmins(context.Context, *GetClusterAdminsRequest) (*GetClusterAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterAdmins not implemented")
+}
+func (UnimplementedAdminsServiceServer) RegAgentAdmin(context.Context, *AddAdminRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegAgentAdmin not implemented")
+}
+func (UnimplementedAdminsServiceServer) RegClusterAdmin(context.Context, *AddAdminRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegClusterAdmin not implemented")
+}
+func (UnimplementedAdminsServiceServer) UnregAgentAdmin(context.Context, *RemoveAgentAdminRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregAgentAdmin not implemented")
+}
+func (UnimplementedAdminsServiceServer) UnregClusterAdmin(context.Context, *UnregClusterAdminRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregClusterAdmin not implemented")
+}
+func (UnimplementedAdminsServiceServer) mustEmbedUnimplementedAdminsServiceServer() {}
+func (UnimplementedAdminsServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeAdminsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminsServiceServer will
+// result in compilation errors.
+type UnsafeAdminsServiceServer interface {
+	mustEmbedUnimplementedAdminsServiceServer()
+}
+
+func RegisterAdminsServiceServer(s grpc.ServiceRegistrar, srv AdminsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminsService_ServiceDesc, srv)
+}
+
+func _AdminsService_GetAgentAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminsServiceServer).GetAgentAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminsService_GetAgentAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminsServiceServer).GetAgentAdmins(ctx, req.(*ListAgentAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminsService_GetClusterAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminsServiceServer).GetClusterAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminsService_GetClusterAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminsServiceServer).GetClusterAdmins(ctx, req.(*GetClusterAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminsService_RegAgentAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminsServiceServer).RegAgentAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminsService_RegAgentAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminsServiceServer).RegAgentAdmin(ctx, req.(*AddAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminsService_RegClusterAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminsServiceServer).RegClusterAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminsService_RegClusterAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminsServiceServer).RegClusterAdmin(ctx, req.(*AddAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminsService_UnregAgentAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAgentAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminsServiceServer).UnregAgentAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminsService_UnregAgentAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminsServiceServer).UnregAgentAdmin(ctx, req.(*RemoveAgentAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminsService_UnregClusterAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregClusterAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminsServiceServer).UnregClusterAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminsService_UnregClusterAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminsServiceServer).UnregClusterAdmin(ctx, req.(*UnregClusterAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminsService_ServiceDesc is the grpc.ServiceDesc for AdminsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.AdminsService",
+	HandlerType: (*AdminsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAgentAdmins",
+			Handler:    _AdminsService_GetAgentAdmins_Handler,
+		},
+		{
+			MethodName: "GetClusterAdmins",
+			Handler:    _AdminsService_GetClusterAdmins_Handler,
+		},
+		{
+			MethodName: "RegAgentAdmin",
+			Handler:    _AdminsService_RegAgentAdmin_Handler,
+		},
+		{
+			MethodName: "RegClusterAdmin",
+			Handler:    _AdminsService_RegClusterAdmin_Handler,
+		},
+		{
+			MethodName: "UnregAgentAdmin",
+			Handler:    _AdminsService_UnregAgentAdmin_Handler,
+		},
+		{
+			MethodName: "UnregClusterAdmin",
+			Handler:    _AdminsService_UnregClusterAdmin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/admins.proto",
+}
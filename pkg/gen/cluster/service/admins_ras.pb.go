@@ -0,0 +1,299 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	io "io"
+)
+
+func (x *AdminInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.Password opts: order:3
+	if err := codec256.ParseString(reader, &x.Password); err != nil {
+		return err
+	}
+	// decode x.PasswordAuthAllowed opts: order:4
+	if err := codec256.ParseBool(reader, &x.PasswordAuthAllowed); err != nil {
+		return err
+	}
+	// decode x.OsAuthAllowed opts: order:5
+	if err := codec256.ParseBool(reader, &x.OsAuthAllowed); err != nil {
+		return err
+	}
+	// decode x.OsUser opts: order:6
+	if err := codec256.ParseString(reader, &x.OsUser); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *AdminInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.Password opts: order:3
+	if err := codec256.FormatString(writer, x.Password); err != nil {
+		return err
+	}
+	// decode x.PasswordAuthAllowed opts: order:4
+	if err := codec256.FormatBool(writer, x.PasswordAuthAllowed); err != nil {
+		return err
+	}
+	// decode x.OsAuthAllowed opts: order:5
+	if err := codec256.FormatBool(writer, x.OsAuthAllowed); err != nil {
+		return err
+	}
+	// decode x.OsUser opts: order:6
+	if err := codec256.FormatString(writer, x.OsUser); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAgentAdminsRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_AGENT_ADMINS_REQUEST
+}
+
+func (x *GetAgentAdminsRequest) Parse(reader io.Reader, version int32) error {
+	return nil
+}
+func (x *GetAgentAdminsRequest) Formatter(writer io.Writer, version int32) error {
+	return nil
+}
+func (x *GetAgentAdminsResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_AGENT_ADMINS_RESPONSE
+}
+
+func (x *GetAgentAdminsResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Admins opts: order:1
+	var size_Admins int
+	if err := codec256.ParseSize(reader, &size_Admins); err != nil {
+		return err
+	}
+	for i := 0; i < size_Admins; i++ {
+		val := &AdminInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Admins = append(x.Admins, val)
+	}
+	return nil
+}
+func (x *GetAgentAdminsResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Admins opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Admins)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Admins); i++ {
+		if err := x.Admins[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetClusterAdminsRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CLUSTER_ADMINS_REQUEST
+}
+
+func (x *GetClusterAdminsRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterAdminsRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterAdminsResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CLUSTER_ADMINS_RESPONSE
+}
+
+func (x *GetClusterAdminsResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Admins opts: order:1
+	var size_Admins int
+	if err := codec256.ParseSize(reader, &size_Admins); err != nil {
+		return err
+	}
+	for i := 0; i < size_Admins; i++ {
+		val := &AdminInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Admins = append(x.Admins, val)
+	}
+	return nil
+}
+func (x *GetClusterAdminsResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Admins opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Admins)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Admins); i++ {
+		if err := x.Admins[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *RegAgentAdminRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_REG_AGENT_ADMIN_REQUEST
+}
+
+func (x *RegAgentAdminRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &AdminInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *RegAgentAdminRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegClusterAdminRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_REG_CLUSTER_ADMIN_REQUEST
+}
+
+func (x *RegClusterAdminRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Info opts: order:2
+	x.Info = &AdminInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *RegClusterAdminRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Info opts: order:2
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregAgentAdminRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_UNREG_AGENT_ADMIN_REQUEST
+}
+
+func (x *UnregAgentAdminRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregAgentAdminRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregClusterAdminRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_UNREG_CLUSTER_ADMIN_REQUEST
+}
+
+func (x *UnregClusterAdminRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregClusterAdminRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
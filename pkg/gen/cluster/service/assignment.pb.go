@@ -0,0 +1,1109 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/assignment.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AssignmentRuleType тип требования назначения функциональности
+type AssignmentRuleType int32
+
+const (
+	AssignmentRuleType_ASSIGNMENT_RULE_TYPE_AUTO   AssignmentRuleType = 0
+	AssignmentRuleType_ASSIGNMENT_RULE_TYPE_ALWAYS AssignmentRuleType = 1
+	AssignmentRuleType_ASSIGNMENT_RULE_TYPE_NEVER  AssignmentRuleType = 2
+)
+
+// Enum value maps for AssignmentRuleType.
+var (
+	AssignmentRuleType_name = map[int32]string{
+		0: "ASSIGNMENT_RULE_TYPE_AUTO",
+		1: "ASSIGNMENT_RULE_TYPE_ALWAYS",
+		2: "ASSIGNMENT_RULE_TYPE_NEVER",
+	}
+	AssignmentRuleType_value = map[string]int32{
+		"ASSIGNMENT_RULE_TYPE_AUTO":   0,
+		"ASSIGNMENT_RULE_TYPE_ALWAYS": 1,
+		"ASSIGNMENT_RULE_TYPE_NEVER":  2,
+	}
+)
+
+func (x AssignmentRuleType) Enum() *AssignmentRuleType {
+	p := new(AssignmentRuleType)
+	*p = x
+	return p
+}
+
+func (x AssignmentRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_assignment_proto_enumTypes[0].Descriptor()
+}
+
+func (AssignmentRuleType) Type() protoreflect.EnumType {
+	return &file_cluster_service_assignment_proto_enumTypes[0]
+}
+
+func (x AssignmentRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentRuleType.Descriptor instead.
+func (AssignmentRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{0}
+}
+
+// ApplyAssignmentMode режим применения требований
+type ApplyAssignmentMode int32
+
+const (
+	// Частичное: переназначаются только нарушающие требования объекты
+	ApplyAssignmentMode_APPLY_ASSIGNMENT_MODE_PARTIAL ApplyAssignmentMode = 0
+	// Полное: все объекты распределяются заново
+	ApplyAssignmentMode_APPLY_ASSIGNMENT_MODE_FULL ApplyAssignmentMode = 1
+)
+
+// Enum value maps for ApplyAssignmentMode.
+var (
+	ApplyAssignmentMode_name = map[int32]string{
+		0: "APPLY_ASSIGNMENT_MODE_PARTIAL",
+		1: "APPLY_ASSIGNMENT_MODE_FULL",
+	}
+	ApplyAssignmentMode_value = map[string]int32{
+		"APPLY_ASSIGNMENT_MODE_PARTIAL": 0,
+		"APPLY_ASSIGNMENT_MODE_FULL":    1,
+	}
+)
+
+func (x ApplyAssignmentMode) Enum() *ApplyAssignmentMode {
+	p := new(ApplyAssignmentMode)
+	*p = x
+	return p
+}
+
+func (x ApplyAssignmentMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplyAssignmentMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_assignment_proto_enumTypes[1].Descriptor()
+}
+
+func (ApplyAssignmentMode) Type() protoreflect.EnumType {
+	return &file_cluster_service_assignment_proto_enumTypes[1]
+}
+
+func (x ApplyAssignmentMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplyAssignmentMode.Descriptor instead.
+func (ApplyAssignmentMode) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{1}
+}
+
+// ManagerInfo менеджер кластера (rmngr)
+type ManagerInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Descr string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	Host  string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	// 1 - главный менеджер кластера
+	MainManager int32  `protobuf:"varint,4,opt,name=main_manager,json=mainManager,proto3" json:"main_manager,omitempty"`
+	Port        int32  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	Pid         string `protobuf:"bytes,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Заполняется шлюзом
+	ClusterId     string `protobuf:"bytes,7,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManagerInfo) Reset() {
+	*x = ManagerInfo{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagerInfo) ProtoMessage() {}
+
+func (x *ManagerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagerInfo.ProtoReflect.Descriptor instead.
+func (*ManagerInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ManagerInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ManagerInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ManagerInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ManagerInfo) GetMainManager() int32 {
+	if x != nil {
+		return x.MainManager
+	}
+	return 0
+}
+
+func (x *ManagerInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ManagerInfo) GetPid() string {
+	if x != nil {
+		return x.Pid
+	}
+	return ""
+}
+
+func (x *ManagerInfo) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// AssignmentRuleInfo требование назначения функциональности рабочему серверу
+type AssignmentRuleInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Uuid  string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Тип объекта требования (соединения клиентов, фоновые задания, сервисы и т.п.)
+	ObjectType int32 `protobuf:"varint,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	// Имя информационной базы, пусто - все базы
+	InfobaseName string             `protobuf:"bytes,3,opt,name=infobase_name,json=infobaseName,proto3" json:"infobase_name,omitempty"`
+	RuleType     AssignmentRuleType `protobuf:"varint,4,opt,name=rule_type,json=ruleType,proto3,enum=cluster.service.AssignmentRuleType" json:"rule_type,omitempty"`
+	// Значение дополнительного параметра (расширение приложения)
+	ApplicationExt string `protobuf:"bytes,5,opt,name=application_ext,json=applicationExt,proto3" json:"application_ext,omitempty"`
+	Priority       int32  `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignmentRuleInfo) Reset() {
+	*x = AssignmentRuleInfo{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentRuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentRuleInfo) ProtoMessage() {}
+
+func (x *AssignmentRuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentRuleInfo.ProtoReflect.Descriptor instead.
+func (*AssignmentRuleInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AssignmentRuleInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AssignmentRuleInfo) GetObjectType() int32 {
+	if x != nil {
+		return x.ObjectType
+	}
+	return 0
+}
+
+func (x *AssignmentRuleInfo) GetInfobaseName() string {
+	if x != nil {
+		return x.InfobaseName
+	}
+	return ""
+}
+
+func (x *AssignmentRuleInfo) GetRuleType() AssignmentRuleType {
+	if x != nil {
+		return x.RuleType
+	}
+	return AssignmentRuleType_ASSIGNMENT_RULE_TYPE_AUTO
+}
+
+func (x *AssignmentRuleInfo) GetApplicationExt() string {
+	if x != nil {
+		return x.ApplicationExt
+	}
+	return ""
+}
+
+func (x *AssignmentRuleInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type GetClusterManagersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterManagersRequest) Reset() {
+	*x = GetClusterManagersRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterManagersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterManagersRequest) ProtoMessage() {}
+
+func (x *GetClusterManagersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterManagersRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterManagersRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetClusterManagersRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetClusterManagersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Managers      []*ManagerInfo         `protobuf:"bytes,1,rep,name=managers,proto3" json:"managers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterManagersResponse) Reset() {
+	*x = GetClusterManagersResponse{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterManagersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterManagersResponse) ProtoMessage() {}
+
+func (x *GetClusterManagersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterManagersResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterManagersResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetClusterManagersResponse) GetManagers() []*ManagerInfo {
+	if x != nil {
+		return x.Managers
+	}
+	return nil
+}
+
+type GetClusterManagerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ManagerId     string                 `protobuf:"bytes,2,opt,name=manager_id,json=managerId,proto3" json:"manager_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterManagerInfoRequest) Reset() {
+	*x = GetClusterManagerInfoRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterManagerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterManagerInfoRequest) ProtoMessage() {}
+
+func (x *GetClusterManagerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterManagerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterManagerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetClusterManagerInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetClusterManagerInfoRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+type GetClusterManagerInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *ManagerInfo           `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterManagerInfoResponse) Reset() {
+	*x = GetClusterManagerInfoResponse{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterManagerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterManagerInfoResponse) ProtoMessage() {}
+
+func (x *GetClusterManagerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterManagerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterManagerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetClusterManagerInfoResponse) GetInfo() *ManagerInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type GetAssignmentRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentRulesRequest) Reset() {
+	*x = GetAssignmentRulesRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentRulesRequest) ProtoMessage() {}
+
+func (x *GetAssignmentRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRulesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetAssignmentRulesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetAssignmentRulesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetAssignmentRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AssignmentRuleInfo  `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentRulesResponse) Reset() {
+	*x = GetAssignmentRulesResponse{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentRulesResponse) ProtoMessage() {}
+
+func (x *GetAssignmentRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRulesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetAssignmentRulesResponse) GetRules() []*AssignmentRuleInfo {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetAssignmentRuleInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentRuleInfoRequest) Reset() {
+	*x = GetAssignmentRuleInfoRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentRuleInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentRuleInfoRequest) ProtoMessage() {}
+
+func (x *GetAssignmentRuleInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentRuleInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRuleInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetAssignmentRuleInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetAssignmentRuleInfoRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *GetAssignmentRuleInfoRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type GetAssignmentRuleInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *AssignmentRuleInfo    `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentRuleInfoResponse) Reset() {
+	*x = GetAssignmentRuleInfoResponse{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentRuleInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentRuleInfoResponse) ProtoMessage() {}
+
+func (x *GetAssignmentRuleInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentRuleInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRuleInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAssignmentRuleInfoResponse) GetInfo() *AssignmentRuleInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// RegAssignmentRuleRequest добавляет требование (пустой uuid)
+// или изменяет существующее
+type RegAssignmentRuleRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId  string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Info      *AssignmentRuleInfo    `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	// Позиция требования в списке сервера
+	Position      int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegAssignmentRuleRequest) Reset() {
+	*x = RegAssignmentRuleRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegAssignmentRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegAssignmentRuleRequest) ProtoMessage() {}
+
+func (x *RegAssignmentRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegAssignmentRuleRequest.ProtoReflect.Descriptor instead.
+func (*RegAssignmentRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RegAssignmentRuleRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *RegAssignmentRuleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *RegAssignmentRuleRequest) GetInfo() *AssignmentRuleInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *RegAssignmentRuleRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type RegAssignmentRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegAssignmentRuleResponse) Reset() {
+	*x = RegAssignmentRuleResponse{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegAssignmentRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegAssignmentRuleResponse) ProtoMessage() {}
+
+func (x *RegAssignmentRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegAssignmentRuleResponse.ProtoReflect.Descriptor instead.
+func (*RegAssignmentRuleResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegAssignmentRuleResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type UnregAssignmentRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregAssignmentRuleRequest) Reset() {
+	*x = UnregAssignmentRuleRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregAssignmentRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregAssignmentRuleRequest) ProtoMessage() {}
+
+func (x *UnregAssignmentRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregAssignmentRuleRequest.ProtoReflect.Descriptor instead.
+func (*UnregAssignmentRuleRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UnregAssignmentRuleRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UnregAssignmentRuleRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *UnregAssignmentRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type ApplyAssignmentRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Mode          ApplyAssignmentMode    `protobuf:"varint,2,opt,name=mode,proto3,enum=cluster.service.ApplyAssignmentMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyAssignmentRulesRequest) Reset() {
+	*x = ApplyAssignmentRulesRequest{}
+	mi := &file_cluster_service_assignment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyAssignmentRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyAssignmentRulesRequest) ProtoMessage() {}
+
+func (x *ApplyAssignmentRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_assignment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyAssignmentRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyAssignmentRulesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_assignment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplyAssignmentRulesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ApplyAssignmentRulesRequest) GetMode() ApplyAssignmentMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ApplyAssignmentMode_APPLY_ASSIGNMENT_MODE_PARTIAL
+}
+
+var File_cluster_service_assignment_proto protoreflect.FileDescriptor
+
+const file_cluster_service_assignment_proto_rawDesc = "" +
+	"\n" +
+	" cluster/service/assignment.proto\x12\x0fcluster.service\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"\xfc\x01\n" +
+	"\vManagerInfo\x12\"\n" +
+	"\x04uuid\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\x04uuid\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12\x1c\n" +
+	"\x04host\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04host\x12+\n" +
+	"\fmain_manager\x18\x04 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\vmainManager\x12#\n" +
+	"\x04port\x18\x05 \x01(\x05B\x0f\x82\xf5\xea\x94\x0e\t\n" +
+	"\x05short\x10\x05R\x04port\x12\x1a\n" +
+	"\x03pid\x18\x06 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x03pid\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\a \x01(\tR\tclusterId\"\xbc\x02\n" +
+	"\x12AssignmentRuleInfo\x12\"\n" +
+	"\x04uuid\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\x04uuid\x12)\n" +
+	"\vobject_type\x18\x02 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\n" +
+	"objectType\x12-\n" +
+	"\rinfobase_name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\finfobaseName\x12O\n" +
+	"\trule_type\x18\x04 \x01(\x0e2#.cluster.service.AssignmentRuleTypeB\r\x82\xf5\xea\x94\x0e\a\n" +
+	"\x03int\x10\x04R\bruleType\x121\n" +
+	"\x0fapplication_ext\x18\x05 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x0eapplicationExt\x12$\n" +
+	"\bpriority\x18\x06 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\bpriority\"p\n" +
+	"\x19GetClusterManagersRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:$\x8a\xf5\xea\x94\x0e\x1e:\x1cGET_CLUSTER_MANAGERS_REQUEST\"\x87\x01\n" +
+	"\x1aGetClusterManagersResponse\x12B\n" +
+	"\bmanagers\x18\x01 \x03(\v2\x1c.cluster.service.ManagerInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\bmanagers:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_CLUSTER_MANAGERS_RESPONSE\"\xa6\x01\n" +
+	"\x1cGetClusterManagerInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12-\n" +
+	"\n" +
+	"manager_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\tmanagerId:(\x8a\xf5\xea\x94\x0e\": GET_CLUSTER_MANAGER_INFO_REQUEST\"\x86\x01\n" +
+	"\x1dGetClusterManagerInfoResponse\x12:\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.cluster.service.ManagerInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:)\x8a\xf5\xea\x94\x0e#:!GET_CLUSTER_MANAGER_INFO_RESPONSE\"\x9d\x01\n" +
+	"\x19GetAssignmentRulesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId:$\x8a\xf5\xea\x94\x0e\x1e:\x1cGET_ASSIGNMENT_RULES_REQUEST\"\x88\x01\n" +
+	"\x1aGetAssignmentRulesResponse\x12C\n" +
+	"\x05rules\x18\x01 \x03(\v2#.cluster.service.AssignmentRuleInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05rules:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_ASSIGNMENT_RULES_RESPONSE\"\xcd\x01\n" +
+	"\x1cGetAssignmentRuleInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId\x12'\n" +
+	"\arule_id\x18\x03 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x03R\x06ruleId:(\x8a\xf5\xea\x94\x0e\": GET_ASSIGNMENT_RULE_INFO_REQUEST\"\x8d\x01\n" +
+	"\x1dGetAssignmentRuleInfoResponse\x12A\n" +
+	"\x04info\x18\x01 \x01(\v2#.cluster.service.AssignmentRuleInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:)\x8a\xf5\xea\x94\x0e#:!GET_ASSIGNMENT_RULE_INFO_RESPONSE\"\x84\x02\n" +
+	"\x18RegAssignmentRuleRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId\x12A\n" +
+	"\x04info\x18\x03 \x01(\v2#.cluster.service.AssignmentRuleInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04info\x12$\n" +
+	"\bposition\x18\x04 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\bposition:#\x8a\xf5\xea\x94\x0e\x1d:\x1bREG_ASSIGNMENT_RULE_REQUEST\"j\n" +
+	"\x19RegAssignmentRuleResponse\x12'\n" +
+	"\arule_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\x06ruleId:$\x8a\xf5\xea\x94\x0e\x1e:\x1cREG_ASSIGNMENT_RULE_RESPONSE\"\xc8\x01\n" +
+	"\x1aUnregAssignmentRuleRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId\x12'\n" +
+	"\arule_id\x18\x03 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x03R\x06ruleId:%\x8a\xf5\xea\x94\x0e\x1f:\x1dUNREG_ASSIGNMENT_RULE_REQUEST\"\xbd\x01\n" +
+	"\x1bApplyAssignmentRulesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12G\n" +
+	"\x04mode\x18\x02 \x01(\x0e2$.cluster.service.ApplyAssignmentModeB\r\x82\xf5\xea\x94\x0e\a\n" +
+	"\x03int\x10\x02R\x04mode:&\x8a\xf5\xea\x94\x0e :\x1eAPPLY_ASSIGNMENT_RULES_REQUEST*t\n" +
+	"\x12AssignmentRuleType\x12\x1d\n" +
+	"\x19ASSIGNMENT_RULE_TYPE_AUTO\x10\x00\x12\x1f\n" +
+	"\x1bASSIGNMENT_RULE_TYPE_ALWAYS\x10\x01\x12\x1e\n" +
+	"\x1aASSIGNMENT_RULE_TYPE_NEVER\x10\x02*X\n" +
+	"\x13ApplyAssignmentMode\x12!\n" +
+	"\x1dAPPLY_ASSIGNMENT_MODE_PARTIAL\x10\x00\x12\x1e\n" +
+	"\x1aAPPLY_ASSIGNMENT_MODE_FULL\x10\x012\xfb\x06\n" +
+	"\x16AssignmentRulesService\x12m\n" +
+	"\x12GetClusterManagers\x12*.cluster.service.GetClusterManagersRequest\x1a+.cluster.service.GetClusterManagersResponse\x12v\n" +
+	"\x15GetClusterManagerInfo\x12-.cluster.service.GetClusterManagerInfoRequest\x1a..cluster.service.GetClusterManagerInfoResponse\x12m\n" +
+	"\x12GetAssignmentRules\x12*.cluster.service.GetAssignmentRulesRequest\x1a+.cluster.service.GetAssignmentRulesResponse\x12v\n" +
+	"\x15GetAssignmentRuleInfo\x12-.cluster.service.GetAssignmentRuleInfoRequest\x1a..cluster.service.GetAssignmentRuleInfoResponse\x12j\n" +
+	"\x11RegAssignmentRule\x12).cluster.service.RegAssignmentRuleRequest\x1a*.cluster.service.RegAssignmentRuleResponse\x12m\n" +
+	"\x14UpdateAssignmentRule\x12).cluster.service.RegAssignmentRuleRequest\x1a*.cluster.service.RegAssignmentRuleResponse\x12Z\n" +
+	"\x13UnregAssignmentRule\x12+.cluster.service.UnregAssignmentRuleRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x14ApplyAssignmentRules\x12,.cluster.service.ApplyAssignmentRulesRequest\x1a\x16.google.protobuf.EmptyB\xbe\x01\n" +
+	"\x13com.cluster.serviceB\x0fAssignmentProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_assignment_proto_rawDescOnce sync.Once
+	file_cluster_service_assignment_proto_rawDescData []byte
+)
+
+func file_cluster_service_assignment_proto_rawDescGZIP() []byte {
+	file_cluster_service_assignment_proto_rawDescOnce.Do(func() {
+		file_cluster_service_assignment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_assignment_proto_rawDesc), len(file_cluster_service_assignment_proto_rawDesc)))
+	})
+	return file_cluster_service_assignment_proto_rawDescData
+}
+
+var file_cluster_service_assignment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cluster_service_assignment_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_cluster_service_assignment_proto_goTypes = []any{
+	(AssignmentRuleType)(0),               // 0: cluster.service.AssignmentRuleType
+	(ApplyAssignmentMode)(0),              // 1: cluster.service.ApplyAssignmentMode
+	(*ManagerInfo)(nil),                   // 2: cluster.service.ManagerInfo
+	(*AssignmentRuleInfo)(nil),            // 3: cluster.service.AssignmentRuleInfo
+	(*GetClusterManagersRequest)(nil),     // 4: cluster.service.GetClusterManagersRequest
+	(*GetClusterManagersResponse)(nil),    // 5: cluster.service.GetClusterManagersResponse
+	(*GetClusterManagerInfoRequest)(nil),  // 6: cluster.service.GetClusterManagerInfoRequest
+	(*GetClusterManagerInfoResponse)(nil), // 7: cluster.service.GetClusterManagerInfoResponse
+	(*GetAssignmentRulesRequest)(nil),     // 8: cluster.service.GetAssignmentRulesRequest
+	(*GetAssignmentRulesResponse)(nil),    // 9: cluster.service.GetAssignmentRulesResponse
+	(*GetAssignmentRuleInfoRequest)(nil),  // 10: cluster.service.GetAssignmentRuleInfoRequest
+	(*GetAssignmentRuleInfoResponse)(nil), // 11: cluster.service.GetAssignmentRuleInfoResponse
+	(*RegAssignmentRuleRequest)(nil),      // 12: cluster.service.RegAssignmentRuleRequest
+	(*RegAssignmentRuleResponse)(nil),     // 13: cluster.service.RegAssignmentRuleResponse
+	(*UnregAssignmentRuleRequest)(nil),    // 14: cluster.service.UnregAssignmentRuleRequest
+	(*ApplyAssignmentRulesRequest)(nil),   // 15: cluster.service.ApplyAssignmentRulesRequest
+	(*emptypb.Empty)(nil),                 // 16: google.protobuf.Empty
+}
+var file_cluster_service_assignment_proto_depIdxs = []int32{
+	0,  // 0: cluster.service.AssignmentRuleInfo.rule_type:type_name -> cluster.service.AssignmentRuleType
+	2,  // 1: cluster.service.GetClusterManagersResponse.managers:type_name -> cluster.service.ManagerInfo
+	2,  // 2: cluster.service.GetClusterManagerInfoResponse.info:type_name -> cluster.service.ManagerInfo
+	3,  // 3: cluster.service.GetAssignmentRulesResponse.rules:type_name -> cluster.service.AssignmentRuleInfo
+	3,  // 4: cluster.service.GetAssignmentRuleInfoResponse.info:type_name -> cluster.service.AssignmentRuleInfo
+	3,  // 5: cluster.service.RegAssignmentRuleRequest.info:type_name -> cluster.service.AssignmentRuleInfo
+	1,  // 6: cluster.service.ApplyAssignmentRulesRequest.mode:type_name -> cluster.service.ApplyAssignmentMode
+	4,  // 7: cluster.service.AssignmentRulesService.GetClusterManagers:input_type -> cluster.service.GetClusterManagersRequest
+	6,  // 8: cluster.service.AssignmentRulesService.GetClusterManagerInfo:input_type -> cluster.service.GetClusterManagerInfoRequest
+	8,  // 9: cluster.service.AssignmentRulesService.GetAssignmentRules:input_type -> cluster.service.GetAssignmentRulesRequest
+	10, // 10: cluster.service.AssignmentRulesService.GetAssignmentRuleInfo:input_type -> cluster.service.GetAssignmentRuleInfoRequest
+	12, // 11: cluster.service.AssignmentRulesService.RegAssignmentRule:input_type -> cluster.service.RegAssignmentRuleRequest
+	12, // 12: cluster.service.AssignmentRulesService.UpdateAssignmentRule:input_type -> cluster.service.RegAssignmentRuleRequest
+	14, // 13: cluster.service.AssignmentRulesService.UnregAssignmentRule:input_type -> cluster.service.UnregAssignmentRuleRequest
+	15, // 14: cluster.service.AssignmentRulesService.ApplyAssignmentRules:input_type -> cluster.service.ApplyAssignmentRulesRequest
+	5,  // 15: cluster.service.AssignmentRulesService.GetClusterManagers:output_type -> cluster.service.GetClusterManagersResponse
+	7,  // 16: cluster.service.AssignmentRulesService.GetClusterManagerInfo:output_type -> cluster.service.GetClusterManagerInfoResponse
+	9,  // 17: cluster.service.AssignmentRulesService.GetAssignmentRules:output_type -> cluster.service.GetAssignmentRulesResponse
+	11, // 18: cluster.service.AssignmentRulesService.GetAssignmentRuleInfo:output_type -> cluster.service.GetAssignmentRuleInfoResponse
+	13, // 19: cluster.service.AssignmentRulesService.RegAssignmentRule:output_type -> cluster.service.RegAssignmentRuleResponse
+	13, // 20: cluster.service.AssignmentRulesService.UpdateAssignmentRule:output_type -> cluster.service.RegAssignmentRuleResponse
+	16, // 21: cluster.service.AssignmentRulesService.UnregAssignmentRule:output_type -> google.protobuf.Empty
+	16, // 22: cluster.service.AssignmentRulesService.ApplyAssignmentRules:output_type -> google.protobuf.Empty
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_assignment_proto_init() }
+func file_cluster_service_assignment_proto_init() {
+	if File_cluster_service_assignment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_assignment_proto_rawDesc), len(file_cluster_service_assignment_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_assignment_proto_goTypes,
+		DependencyIndexes: file_cluster_service_assignment_proto_depIdxs,
+		EnumInfos:         file_cluster_service_assignment_proto_enumTypes,
+		MessageInfos:      file_cluster_service_assignment_proto_msgTypes,
+	}.Build()
+	File_cluster_service_assignment_proto = out.File
+	file_cluster_service_assignment_proto_goTypes = nil
+	file_cluster_service_assignment_proto_depIdxs = nil
+}
@@ -0,0 +1,409 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/assignment.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AssignmentRulesService_GetClusterManagers_FullMethodName    = "/cluster.service.AssignmentRulesService/GetClusterManagers"
+	AssignmentRulesService_GetClusterManagerInfo_FullMethodName = "/cluster.service.AssignmentRulesService/GetClusterManagerInfo"
+	AssignmentRulesService_GetAssignmentRules_FullMethodName    = "/cluster.service.AssignmentRulesService/GetAssignmentRules"
+	AssignmentRulesService_GetAssignmentRuleInfo_FullMethodName = "/cluster.service.AssignmentRulesService/GetAssignmentRuleInfo"
+	AssignmentRulesService_RegAssignmentRule_FullMethodName     = "/cluster.service.AssignmentRulesService/RegAssignmentRule"
+	AssignmentRulesService_UpdateAssignmentRule_FullMethodName  = "/cluster.service.AssignmentRulesService/UpdateAssignmentRule"
+	AssignmentRulesService_UnregAssignmentRule_FullMethodName   = "/cluster.service.AssignmentRulesService/UnregAssignmentRule"
+	AssignmentRulesService_ApplyAssignmentRules_FullMethodName  = "/cluster.service.AssignmentRulesService/ApplyAssignmentRules"
+)
+
+// AssignmentRulesServiceClient is the client API for AssignmentRulesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AssignmentRulesService менеджеры кластера и требования назначения функциональности
+type AssignmentRulesServiceClient interface {
+	// GetClusterManagers список менеджеров кластера
+	GetClusterManagers(ctx context.Context, in *GetClusterManagersRequest, opts ...grpc.CallOption) (*GetClusterManagersResponse, error)
+	// GetClusterManagerInfo информация о менеджере кластера
+	GetClusterManagerInfo(ctx context.Context, in *GetClusterManagerInfoRequest, opts ...grpc.CallOption) (*GetClusterManagerInfoResponse, error)
+	// GetAssignmentRules список требований рабочего сервера
+	GetAssignmentRules(ctx context.Context, in *GetAssignmentRulesRequest, opts ...grpc.CallOption) (*GetAssignmentRulesResponse, error)
+	// GetAssignmentRuleInfo информация о требовании
+	GetAssignmentRuleInfo(ctx context.Context, in *GetAssignmentRuleInfoRequest, opts ...grpc.CallOption) (*GetAssignmentRuleInfoResponse, error)
+	// RegAssignmentRule добавление требования
+	RegAssignmentRule(ctx context.Context, in *RegAssignmentRuleRequest, opts ...grpc.CallOption) (*RegAssignmentRuleResponse, error)
+	// UpdateAssignmentRule изменение требования
+	UpdateAssignmentRule(ctx context.Context, in *RegAssignmentRuleRequest, opts ...grpc.CallOption) (*RegAssignmentRuleResponse, error)
+	// UnregAssignmentRule удаление требования
+	UnregAssignmentRule(ctx context.Context, in *UnregAssignmentRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ApplyAssignmentRules применение требований кластера
+	ApplyAssignmentRules(ctx context.Context, in *ApplyAssignmentRulesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type assignmentRulesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentRulesServiceClient(cc grpc.ClientConnInterface) AssignmentRulesServiceClient {
+	return &assignmentRulesServiceClient{cc}
+}
+
+func (c *assignmentRulesServiceClient) GetClusterManagers(ctx context.Context, in *GetClusterManagersRequest, opts ...grpc.CallOption) (*GetClusterManagersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterManagersResponse)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_GetClusterManagers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) GetClusterManagerInfo(ctx context.Context, in *GetClusterManagerInfoRequest, opts ...grpc.CallOption) (*GetClusterManagerInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClusterManagerInfoResponse)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_GetClusterManagerInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) GetAssignmentRules(ctx context.Context, in *GetAssignmentRulesRequest, opts ...grpc.CallOption) (*GetAssignmentRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssignmentRulesResponse)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_GetAssignmentRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) GetAssignmentRuleInfo(ctx context.Context, in *GetAssignmentRuleInfoRequest, opts ...grpc.CallOption) (*GetAssignmentRuleInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssignmentRuleInfoResponse)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_GetAssignmentRuleInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) RegAssignmentRule(ctx context.Context, in *RegAssignmentRuleRequest, opts ...grpc.CallOption) (*RegAssignmentRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegAssignmentRuleResponse)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_RegAssignmentRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) UpdateAssignmentRule(ctx context.Context, in *RegAssignmentRuleRequest, opts ...grpc.CallOption) (*RegAssignmentRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegAssignmentRuleResponse)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_UpdateAssignmentRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) UnregAssignmentRule(ctx context.Context, in *UnregAssignmentRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_UnregAssignmentRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentRulesServiceClient) ApplyAssignmentRules(ctx context.Context, in *ApplyAssignmentRulesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentRulesService_ApplyAssignmentRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentRulesServiceServer is the server API for AssignmentRulesService service.
+// All implementations must embed UnimplementedAssignmentRulesServiceServer
+// for forward compatibility.
+//
+// AssignmentRulesService менеджеры кластера и требования назначения функциональности
+type AssignmentRulesServiceServer interface {
+	// GetClusterManagers список менеджеров кластера
+	GetClusterManagers(context.Context, *GetClusterManagersRequest) (*GetClusterManagersResponse, error)
+	// GetClusterManagerInfo информация о менеджере кластера
+	GetClusterManagerInfo(context.Context, *GetClusterManagerInfoRequest) (*GetClusterManagerInfoResponse, error)
+	// GetAssignmentRules список требований рабочего сервера
+	GetAssignmentRules(context.Context, *GetAssignmentRulesRequest) (*GetAssignmentRulesResponse, error)
+	// GetAssignmentRuleInfo информация о требовании
+	GetAssignmentRuleInfo(context.Context, *GetAssignmentRuleInfoRequest) (*GetAssignmentRuleInfoResponse, error)
+	// RegAssignmentRule добавление требования
+	RegAssignmentRule(context.Context, *RegAssignmentRuleRequest) (*RegAssignmentRuleResponse, error)
+	// UpdateAssignmentRule изменение требования
+	UpdateAssignmentRule(context.Context, *RegAssignmentRuleRequest) (*RegAssignmentRuleResponse, error)
+	// UnregAssignmentRule удаление требования
+	UnregAssignmentRule(context.Context, *UnregAssignmentRuleRequest) (*emptypb.Empty, error)
+	// ApplyAssignmentRules применение требований кластера
+	ApplyAssignmentRules(context.Context, *ApplyAssignmentRulesRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAssignmentRulesServiceServer()
+}
+
+// UnimplementedAssignmentRulesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentRulesServiceServer struct{}
+
+func (UnimplementedAssignmentRulesServiceServer) GetClusterManagers(context.Context, *GetClusterManagersRequest) (*GetClusterManagersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterManagers not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) GetClusterManagerInfo(context.Context, *GetClusterManagerInfoRequest) (*GetClusterManagerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterManagerInfo not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) GetAssignmentRules(context.Context, *GetAssignmentRulesRequest) (*GetAssignmentRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentRules not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) GetAssignmentRuleInfo(context.Context, *GetAssignmentRuleInfoRequest) (*GetAssignmentRuleInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentRuleInfo not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) RegAssignmentRule(context.Context, *RegAssignmentRuleRequest) (*RegAssignmentRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegAssignmentRule not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) UpdateAssignmentRule(context.Context, *RegAssignmentRuleRequest) (*RegAssignmentRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssignmentRule not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) UnregAssignmentRule(context.Context, *UnregAssignmentRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregAssignmentRule not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) ApplyAssignmentRules(context.Context, *ApplyAssignmentRulesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyAssignmentRules not implemented")
+}
+func (UnimplementedAssignmentRulesServiceServer) mustEmbedUnimplementedAssignmentRulesServiceServer() {
+}
+func (UnimplementedAssignmentRulesServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAssignmentRulesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentRulesServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentRulesServiceServer interface {
+	mustEmbedUnimplementedAssignmentRulesServiceServer()
+}
+
+func RegisterAssignmentRulesServiceServer(s grpc.ServiceRegistrar, srv AssignmentRulesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssignmentRulesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentRulesService_ServiceDesc, srv)
+}
+
+func _AssignmentRulesService_GetClusterManagers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterManagersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).GetClusterManagers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_GetClusterManagers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).GetClusterManagers(ctx, req.(*GetClusterManagersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_GetClusterManagerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterManagerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).GetClusterManagerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_GetClusterManagerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).GetClusterManagerInfo(ctx, req.(*GetClusterManagerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_GetAssignmentRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).GetAssignmentRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_GetAssignmentRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).GetAssignmentRules(ctx, req.(*GetAssignmentRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_GetAssignmentRuleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentRuleInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).GetAssignmentRuleInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_GetAssignmentRuleInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).GetAssignmentRuleInfo(ctx, req.(*GetAssignmentRuleInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_RegAssignmentRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegAssignmentRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).RegAssignmentRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_RegAssignmentRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).RegAssignmentRule(ctx, req.(*RegAssignmentRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_UpdateAssignmentRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegAssignmentRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).UpdateAssignmentRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_UpdateAssignmentRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).UpdateAssignmentRule(ctx, req.(*RegAssignmentRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_UnregAssignmentRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregAssignmentRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).UnregAssignmentRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_UnregAssignmentRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).UnregAssignmentRule(ctx, req.(*UnregAssignmentRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentRulesService_ApplyAssignmentRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyAssignmentRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentRulesServiceServer).ApplyAssignmentRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentRulesService_ApplyAssignmentRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentRulesServiceServer).ApplyAssignmentRules(ctx, req.(*ApplyAssignmentRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentRulesService_ServiceDesc is the grpc.ServiceDesc for AssignmentRulesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentRulesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.AssignmentRulesService",
+	HandlerType: (*AssignmentRulesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClusterManagers",
+			Handler:    _AssignmentRulesService_GetClusterManagers_Handler,
+		},
+		{
+			MethodName: "GetClusterManagerInfo",
+			Handler:    _AssignmentRulesService_GetClusterManagerInfo_Handler,
+		},
+		{
+			MethodName: "GetAssignmentRules",
+			Handler:    _AssignmentRulesService_GetAssignmentRules_Handler,
+		},
+		{
+			MethodName: "GetAssignmentRuleInfo",
+			Handler:    _AssignmentRulesService_GetAssignmentRuleInfo_Handler,
+		},
+		{
+			MethodName: "RegAssignmentRule",
+			Handler:    _AssignmentRulesService_RegAssignmentRule_Handler,
+		},
+		{
+			MethodName: "UpdateAssignmentRule",
+			Handler:    _AssignmentRulesService_UpdateAssignmentRule_Handler,
+		},
+		{
+			MethodName: "UnregAssignmentRule",
+			Handler:    _AssignmentRulesService_UnregAssignmentRule_Handler,
+		},
+		{
+			MethodName: "ApplyAssignmentRules",
+			Handler:    _AssignmentRulesService_ApplyAssignmentRules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/assignment.proto",
+}
@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	io "io"
+)
+
+func (x *ManagerInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.Uuid); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.Host opts: order:3
+	if err := codec256.ParseString(reader, &x.Host); err != nil {
+		return err
+	}
+	// decode x.MainManager opts: order:4
+	if err := codec256.ParseInt(reader, &x.MainManager); err != nil {
+		return err
+	}
+	// decode x.Port opts: encoder:"short" order:5
+	if err := codec256.ParseShort(reader, &x.Port); err != nil {
+		return err
+	}
+	// decode x.Pid opts: order:6
+	if err := codec256.ParseString(reader, &x.Pid); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ManagerInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.Uuid); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.Host opts: order:3
+	if err := codec256.FormatString(writer, x.Host); err != nil {
+		return err
+	}
+	// decode x.MainManager opts: order:4
+	if err := codec256.FormatInt(writer, x.MainManager); err != nil {
+		return err
+	}
+	// decode x.Port opts: encoder:"short" order:5
+	if err := codec256.FormatShort(writer, x.Port); err != nil {
+		return err
+	}
+	// decode x.Pid opts: order:6
+	if err := codec256.FormatString(writer, x.Pid); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *AssignmentRuleInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.Uuid); err != nil {
+		return err
+	}
+	// decode x.ObjectType opts: order:2
+	if err := codec256.ParseInt(reader, &x.ObjectType); err != nil {
+		return err
+	}
+	// decode x.InfobaseName opts: order:3
+	if err := codec256.ParseString(reader, &x.InfobaseName); err != nil {
+		return err
+	}
+	// decode x.RuleType opts: encoder:"int" order:4
+	var val_RuleType int32
+	if err := codec256.ParseInt(reader, &val_RuleType); err != nil {
+		return err
+	}
+	x.RuleType = AssignmentRuleType(val_RuleType)
+	// decode x.ApplicationExt opts: order:5
+	if err := codec256.ParseString(reader, &x.ApplicationExt); err != nil {
+		return err
+	}
+	// decode x.Priority opts: order:6
+	if err := codec256.ParseInt(reader, &x.Priority); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *AssignmentRuleInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.Uuid); err != nil {
+		return err
+	}
+	// decode x.ObjectType opts: order:2
+	if err := codec256.FormatInt(writer, x.ObjectType); err != nil {
+		return err
+	}
+	// decode x.InfobaseName opts: order:3
+	if err := codec256.FormatString(writer, x.InfobaseName); err != nil {
+		return err
+	}
+	// decode x.RuleType opts: encoder:"int" order:4
+	if err := codec256.FormatInt(writer, int32(x.RuleType)); err != nil {
+		return err
+	}
+	// decode x.ApplicationExt opts: order:5
+	if err := codec256.FormatString(writer, x.ApplicationExt); err != nil {
+		return err
+	}
+	// decode x.Priority opts: order:6
+	if err := codec256.FormatInt(writer, x.Priority); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterManagersRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CLUSTER_MANAGERS_REQUEST
+}
+
+func (x *GetClusterManagersRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterManagersRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterManagersResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CLUSTER_MANAGERS_RESPONSE
+}
+
+func (x *GetClusterManagersResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Managers opts: order:1
+	var size_Managers int
+	if err := codec256.ParseSize(reader, &size_Managers); err != nil {
+		return err
+	}
+	for i := 0; i < size_Managers; i++ {
+		val := &ManagerInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Managers = append(x.Managers, val)
+	}
+	return nil
+}
+func (x *GetClusterManagersResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Managers opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Managers)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Managers); i++ {
+		if err := x.Managers[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetClusterManagerInfoRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CLUSTER_MANAGER_INFO_REQUEST
+}
+
+func (x *GetClusterManagerInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ManagerId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ManagerId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterManagerInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ManagerId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ManagerId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetClusterManagerInfoResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CLUSTER_MANAGER_INFO_RESPONSE
+}
+
+func (x *GetClusterManagerInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &ManagerInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetClusterManagerInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAssignmentRulesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ASSIGNMENT_RULES_REQUEST
+}
+
+func (x *GetAssignmentRulesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ServerId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAssignmentRulesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ServerId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAssignmentRulesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ASSIGNMENT_RULES_RESPONSE
+}
+
+func (x *GetAssignmentRulesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Rules opts: order:1
+	var size_Rules int
+	if err := codec256.ParseSize(reader, &size_Rules); err != nil {
+		return err
+	}
+	for i := 0; i < size_Rules; i++ {
+		val := &AssignmentRuleInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Rules = append(x.Rules, val)
+	}
+	return nil
+}
+func (x *GetAssignmentRulesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Rules opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Rules)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Rules); i++ {
+		if err := x.Rules[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetAssignmentRuleInfoRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ASSIGNMENT_RULE_INFO_REQUEST
+}
+
+func (x *GetAssignmentRuleInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ServerId); err != nil {
+		return err
+	}
+	// decode x.RuleId opts: encoder:"uuid" order:3
+	if err := codec256.ParseUUID(reader, &x.RuleId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAssignmentRuleInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ServerId); err != nil {
+		return err
+	}
+	// decode x.RuleId opts: encoder:"uuid" order:3
+	if err := codec256.FormatUuid(writer, x.RuleId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAssignmentRuleInfoResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ASSIGNMENT_RULE_INFO_RESPONSE
+}
+
+func (x *GetAssignmentRuleInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &AssignmentRuleInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetAssignmentRuleInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegAssignmentRuleRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_REG_ASSIGNMENT_RULE_REQUEST
+}
+
+func (x *RegAssignmentRuleRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ServerId); err != nil {
+		return err
+	}
+	// decode x.Info opts: order:3
+	x.Info = &AssignmentRuleInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	// decode x.Position opts: order:4
+	if err := codec256.ParseInt(reader, &x.Position); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegAssignmentRuleRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ServerId); err != nil {
+		return err
+	}
+	// decode x.Info opts: order:3
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	// decode x.Position opts: order:4
+	if err := codec256.FormatInt(writer, x.Position); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegAssignmentRuleResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_REG_ASSIGNMENT_RULE_RESPONSE
+}
+
+func (x *RegAssignmentRuleResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.RuleId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.RuleId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegAssignmentRuleResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.RuleId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.RuleId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregAssignmentRuleRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_UNREG_ASSIGNMENT_RULE_REQUEST
+}
+
+func (x *UnregAssignmentRuleRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ServerId); err != nil {
+		return err
+	}
+	// decode x.RuleId opts: encoder:"uuid" order:3
+	if err := codec256.ParseUUID(reader, &x.RuleId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregAssignmentRuleRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ServerId); err != nil {
+		return err
+	}
+	// decode x.RuleId opts: encoder:"uuid" order:3
+	if err := codec256.FormatUuid(writer, x.RuleId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ApplyAssignmentRulesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_APPLY_ASSIGNMENT_RULES_REQUEST
+}
+
+func (x *ApplyAssignmentRulesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Mode opts: encoder:"int" order:2
+	var val_Mode int32
+	if err := codec256.ParseInt(reader, &val_Mode); err != nil {
+		return err
+	}
+	x.Mode = ApplyAssignmentMode(val_Mode)
+	return nil
+}
+func (x *ApplyAssignmentRulesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Mode opts: encoder:"int" order:2
+	if err := codec256.FormatInt(writer, int32(x.Mode)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,780 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/connections.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConnectionShortInfo соединение с информационной базой
+// (конфигуратор, COM-соединение, фоновое задание и т.п.)
+type ConnectionShortInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Application   string                 `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	BlockedByLs   int32                  `protobuf:"varint,3,opt,name=blocked_by_ls,json=blockedByLs,proto3" json:"blocked_by_ls,omitempty"`
+	ConnectedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=connected_at,json=connectedAt,proto3" json:"connected_at,omitempty"`
+	ConnId        int32                  `protobuf:"varint,5,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
+	Host          string                 `protobuf:"bytes,6,opt,name=host,proto3" json:"host,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,7,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	ProcessId     string                 `protobuf:"bytes,8,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	SessionNumber int32                  `protobuf:"varint,9,opt,name=session_number,json=sessionNumber,proto3" json:"session_number,omitempty"`
+	// Заполняется шлюзом
+	ClusterId     string `protobuf:"bytes,10,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectionShortInfo) Reset() {
+	*x = ConnectionShortInfo{}
+	mi := &file_cluster_service_connections_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionShortInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionShortInfo) ProtoMessage() {}
+
+func (x *ConnectionShortInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionShortInfo.ProtoReflect.Descriptor instead.
+func (*ConnectionShortInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConnectionShortInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ConnectionShortInfo) GetApplication() string {
+	if x != nil {
+		return x.Application
+	}
+	return ""
+}
+
+func (x *ConnectionShortInfo) GetBlockedByLs() int32 {
+	if x != nil {
+		return x.BlockedByLs
+	}
+	return 0
+}
+
+func (x *ConnectionShortInfo) GetConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConnectedAt
+	}
+	return nil
+}
+
+func (x *ConnectionShortInfo) GetConnId() int32 {
+	if x != nil {
+		return x.ConnId
+	}
+	return 0
+}
+
+func (x *ConnectionShortInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ConnectionShortInfo) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *ConnectionShortInfo) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *ConnectionShortInfo) GetSessionNumber() int32 {
+	if x != nil {
+		return x.SessionNumber
+	}
+	return 0
+}
+
+func (x *ConnectionShortInfo) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetConnectionsShortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionsShortRequest) Reset() {
+	*x = GetConnectionsShortRequest{}
+	mi := &file_cluster_service_connections_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionsShortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionsShortRequest) ProtoMessage() {}
+
+func (x *GetConnectionsShortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionsShortRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionsShortRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetConnectionsShortRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetConnectionsShortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*ConnectionShortInfo `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionsShortResponse) Reset() {
+	*x = GetConnectionsShortResponse{}
+	mi := &file_cluster_service_connections_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionsShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionsShortResponse) ProtoMessage() {}
+
+func (x *GetConnectionsShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionsShortResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectionsShortResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetConnectionsShortResponse) GetConnections() []*ConnectionShortInfo {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type GetInfobaseConnectionsShortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseConnectionsShortRequest) Reset() {
+	*x = GetInfobaseConnectionsShortRequest{}
+	mi := &file_cluster_service_connections_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseConnectionsShortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseConnectionsShortRequest) ProtoMessage() {}
+
+func (x *GetInfobaseConnectionsShortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseConnectionsShortRequest.ProtoReflect.Descriptor instead.
+func (*GetInfobaseConnectionsShortRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInfobaseConnectionsShortRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetInfobaseConnectionsShortRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+type GetInfobaseConnectionsShortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*ConnectionShortInfo `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseConnectionsShortResponse) Reset() {
+	*x = GetInfobaseConnectionsShortResponse{}
+	mi := &file_cluster_service_connections_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseConnectionsShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseConnectionsShortResponse) ProtoMessage() {}
+
+func (x *GetInfobaseConnectionsShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseConnectionsShortResponse.ProtoReflect.Descriptor instead.
+func (*GetInfobaseConnectionsShortResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInfobaseConnectionsShortResponse) GetConnections() []*ConnectionShortInfo {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type GetConnectionInfoShortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ConnectionId  string                 `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionInfoShortRequest) Reset() {
+	*x = GetConnectionInfoShortRequest{}
+	mi := &file_cluster_service_connections_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionInfoShortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionInfoShortRequest) ProtoMessage() {}
+
+func (x *GetConnectionInfoShortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionInfoShortRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionInfoShortRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetConnectionInfoShortRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetConnectionInfoShortRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type GetConnectionInfoShortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *ConnectionShortInfo   `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionInfoShortResponse) Reset() {
+	*x = GetConnectionInfoShortResponse{}
+	mi := &file_cluster_service_connections_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionInfoShortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionInfoShortResponse) ProtoMessage() {}
+
+func (x *GetConnectionInfoShortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionInfoShortResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectionInfoShortResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetConnectionInfoShortResponse) GetInfo() *ConnectionShortInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// DisconnectRequest разрыв соединения, требует аутентификации в информационной базе
+type DisconnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProcessId     string                 `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	ConnectionId  string                 `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	mi := &file_cluster_service_connections_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DisconnectRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DisconnectRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *DisconnectRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+// GetProcessConnectionsRequest соединения рабочего процесса
+type GetProcessConnectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProcessId     string                 `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProcessConnectionsRequest) Reset() {
+	*x = GetProcessConnectionsRequest{}
+	mi := &file_cluster_service_connections_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProcessConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProcessConnectionsRequest) ProtoMessage() {}
+
+func (x *GetProcessConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProcessConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetProcessConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetProcessConnectionsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetProcessConnectionsRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+// DisconnectConnectionRequest разрыв соединения
+type DisconnectConnectionRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId    string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ConnectionId string                 `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Рабочий процесс соединения, определяется по соединению, если не указан
+	ProcessId string `protobuf:"bytes,3,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// Информационная база соединения, определяется по соединению, если не указана
+	InfobaseId string `protobuf:"bytes,4,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	// Пользователь информационной базы, если не указан - из хранилища учетных данных
+	InfobaseUser     string `protobuf:"bytes,5,opt,name=infobase_user,json=infobaseUser,proto3" json:"infobase_user,omitempty"`
+	InfobasePassword string `protobuf:"bytes,6,opt,name=infobase_password,json=infobasePassword,proto3" json:"infobase_password,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DisconnectConnectionRequest) Reset() {
+	*x = DisconnectConnectionRequest{}
+	mi := &file_cluster_service_connections_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectConnectionRequest) ProtoMessage() {}
+
+func (x *DisconnectConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_connections_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectConnectionRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_connections_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DisconnectConnectionRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DisconnectConnectionRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *DisconnectConnectionRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+func (x *DisconnectConnectionRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *DisconnectConnectionRequest) GetInfobaseUser() string {
+	if x != nil {
+		return x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *DisconnectConnectionRequest) GetInfobasePassword() string {
+	if x != nil {
+		return x.InfobasePassword
+	}
+	return ""
+}
+
+var File_cluster_service_connections_proto protoreflect.FileDescriptor
+
+const file_cluster_service_connections_proto_rawDesc = "" +
+	"\n" +
+	"!cluster/service/connections.proto\x12\x0fcluster.service\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"\xd3\x03\n" +
+	"\x13ConnectionShortInfo\x12\"\n" +
+	"\x04uuid\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\x04uuid\x12*\n" +
+	"\vapplication\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vapplication\x12,\n" +
+	"\rblocked_by_ls\x18\x03 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\vblockedByLs\x12M\n" +
+	"\fconnected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04time\x10\x04R\vconnectedAt\x12!\n" +
+	"\aconn_id\x18\x05 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x06connId\x12\x1c\n" +
+	"\x04host\x18\x06 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x04host\x12/\n" +
+	"\vinfobase_id\x18\a \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\aR\n" +
+	"infobaseId\x12-\n" +
+	"\n" +
+	"process_id\x18\b \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\bR\tprocessId\x12/\n" +
+	"\x0esession_number\x18\t \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\tR\rsessionNumber\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\n" +
+	" \x01(\tR\tclusterId\"r\n" +
+	"\x1aGetConnectionsShortRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_CONNECTIONS_SHORT_REQUEST\"\x97\x01\n" +
+	"\x1bGetConnectionsShortResponse\x12P\n" +
+	"\vconnections\x18\x01 \x03(\v2$.cluster.service.ConnectionShortInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\vconnections:&\x8a\xf5\xea\x94\x0e :\x1eGET_CONNECTIONS_SHORT_RESPONSE\"\xb4\x01\n" +
+	"\"GetInfobaseConnectionsShortRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12/\n" +
+	"\vinfobase_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\n" +
+	"infobaseId:.\x8a\xf5\xea\x94\x0e(:&GET_INFOBASE_CONNECTIONS_SHORT_REQUEST\"\xa8\x01\n" +
+	"#GetInfobaseConnectionsShortResponse\x12P\n" +
+	"\vconnections\x18\x01 \x03(\v2$.cluster.service.ConnectionShortInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\vconnections:/\x8a\xf5\xea\x94\x0e):'GET_INFOBASE_CONNECTIONS_SHORT_RESPONSE\"\xae\x01\n" +
+	"\x1dGetConnectionInfoShortRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x123\n" +
+	"\rconnection_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\fconnectionId:)\x8a\xf5\xea\x94\x0e#:!GET_CONNECTION_INFO_SHORT_REQUEST\"\x90\x01\n" +
+	"\x1eGetConnectionInfoShortResponse\x12B\n" +
+	"\x04info\x18\x01 \x01(\v2$.cluster.service.ConnectionShortInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:*\x8a\xf5\xea\x94\x0e$:\"GET_CONNECTION_INFO_SHORT_RESPONSE\"\xc2\x01\n" +
+	"\x11DisconnectRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12-\n" +
+	"\n" +
+	"process_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\tprocessId\x123\n" +
+	"\rconnection_id\x18\x03 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x03R\fconnectionId:\x1a\x8a\xf5\xea\x94\x0e\x14:\x12DISCONNECT_REQUEST\"\\\n" +
+	"\x1cGetProcessConnectionsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1d\n" +
+	"\n" +
+	"process_id\x18\x02 \x01(\tR\tprocessId\"\xf3\x01\n" +
+	"\x1bDisconnectConnectionRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12#\n" +
+	"\rconnection_id\x18\x02 \x01(\tR\fconnectionId\x12\x1d\n" +
+	"\n" +
+	"process_id\x18\x03 \x01(\tR\tprocessId\x12\x1f\n" +
+	"\vinfobase_id\x18\x04 \x01(\tR\n" +
+	"infobaseId\x12#\n" +
+	"\rinfobase_user\x18\x05 \x01(\tR\finfobaseUser\x12+\n" +
+	"\x11infobase_password\x18\x06 \x01(\tR\x10infobasePassword2\xc7\x04\n" +
+	"\x12ConnectionsService\x12k\n" +
+	"\x0eGetConnections\x12+.cluster.service.GetConnectionsShortRequest\x1a,.cluster.service.GetConnectionsShortResponse\x12t\n" +
+	"\x15GetProcessConnections\x12-.cluster.service.GetProcessConnectionsRequest\x1a,.cluster.service.GetConnectionsShortResponse\x12\x83\x01\n" +
+	"\x16GetInfobaseConnections\x123.cluster.service.GetInfobaseConnectionsShortRequest\x1a4.cluster.service.GetInfobaseConnectionsShortResponse\x12t\n" +
+	"\x11GetConnectionInfo\x12..cluster.service.GetConnectionInfoShortRequest\x1a/.cluster.service.GetConnectionInfoShortResponse\x12R\n" +
+	"\n" +
+	"Disconnect\x12,.cluster.service.DisconnectConnectionRequest\x1a\x16.google.protobuf.EmptyB\xbf\x01\n" +
+	"\x13com.cluster.serviceB\x10ConnectionsProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_connections_proto_rawDescOnce sync.Once
+	file_cluster_service_connections_proto_rawDescData []byte
+)
+
+func file_cluster_service_connections_proto_rawDescGZIP() []byte {
+	file_cluster_service_connections_proto_rawDescOnce.Do(func() {
+		file_cluster_service_connections_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_connections_proto_rawDesc), len(file_cluster_service_connections_proto_rawDesc)))
+	})
+	return file_cluster_service_connections_proto_rawDescData
+}
+
+var file_cluster_service_connections_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cluster_service_connections_proto_goTypes = []any{
+	(*ConnectionShortInfo)(nil),                 // 0: cluster.service.ConnectionShortInfo
+	(*GetConnectionsShortRequest)(nil),          // 1: cluster.service.GetConnectionsShortRequest
+	(*GetConnectionsShortResponse)(nil),         // 2: cluster.service.GetConnectionsShortResponse
+	(*GetInfobaseConnectionsShortRequest)(nil),  // 3: cluster.service.GetInfobaseConnectionsShortRequest
+	(*GetInfobaseConnectionsShortResponse)(nil), // 4: cluster.service.GetInfobaseConnectionsShortResponse
+	(*GetConnectionInfoShortRequest)(nil),       // 5: cluster.service.GetConnectionInfoShortRequest
+	(*GetConnectionInfoShortResponse)(nil),      // 6: cluster.service.GetConnectionInfoShortResponse
+	(*DisconnectRequest)(nil),                   // 7: cluster.service.DisconnectRequest
+	(*GetProcessConnectionsRequest)(nil),        // 8: cluster.service.GetProcessConnectionsRequest
+	(*DisconnectConnectionRequest)(nil),         // 9: cluster.service.DisconnectConnectionRequest
+	(*timestamppb.Timestamp)(nil),               // 10: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 11: google.protobuf.Empty
+}
+var file_cluster_service_connections_proto_depIdxs = []int32{
+	10, // 0: cluster.service.ConnectionShortInfo.connected_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: cluster.service.GetConnectionsShortResponse.connections:type_name -> cluster.service.ConnectionShortInfo
+	0,  // 2: cluster.service.GetInfobaseConnectionsShortResponse.connections:type_name -> cluster.service.ConnectionShortInfo
+	0,  // 3: cluster.service.GetConnectionInfoShortResponse.info:type_name -> cluster.service.ConnectionShortInfo
+	1,  // 4: cluster.service.ConnectionsService.GetConnections:input_type -> cluster.service.GetConnectionsShortRequest
+	8,  // 5: cluster.service.ConnectionsService.GetProcessConnections:input_type -> cluster.service.GetProcessConnectionsRequest
+	3,  // 6: cluster.service.ConnectionsService.GetInfobaseConnections:input_type -> cluster.service.GetInfobaseConnectionsShortRequest
+	5,  // 7: cluster.service.ConnectionsService.GetConnectionInfo:input_type -> cluster.service.GetConnectionInfoShortRequest
+	9,  // 8: cluster.service.ConnectionsService.Disconnect:input_type -> cluster.service.DisconnectConnectionRequest
+	2,  // 9: cluster.service.ConnectionsService.GetConnections:output_type -> cluster.service.GetConnectionsShortResponse
+	2,  // 10: cluster.service.ConnectionsService.GetProcessConnections:output_type -> cluster.service.GetConnectionsShortResponse
+	4,  // 11: cluster.service.ConnectionsService.GetInfobaseConnections:output_type -> cluster.service.GetInfobaseConnectionsShortResponse
+	6,  // 12: cluster.service.ConnectionsService.GetConnectionInfo:output_type -> cluster.service.GetConnectionInfoShortResponse
+	11, // 13: cluster.service.ConnectionsService.Disconnect:output_type -> google.protobuf.Empty
+	9,  // [9:14] is the sub-list for method output_type
+	4,  // [4:9] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_connections_proto_init() }
+func file_cluster_service_connections_proto_init() {
+	if File_cluster_service_connections_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_connections_proto_rawDesc), len(file_cluster_service_connections_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_connections_proto_goTypes,
+		DependencyIndexes: file_cluster_service_connections_proto_depIdxs,
+		MessageInfos:      file_cluster_service_connections_proto_msgTypes,
+	}.Build()
+	File_cluster_service_connections_proto = out.File
+	file_cluster_service_connections_proto_goTypes = nil
+	file_cluster_service_connections_proto_depIdxs = nil
+}
@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/connections.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ConnectionsService_GetConnections_FullMethodName         = "/cluster.service.ConnectionsService/GetConnections"
+	ConnectionsService_GetProcessConnections_FullMethodName  = "/cluster.service.ConnectionsService/GetProcessConnections"
+	ConnectionsService_GetInfobaseConnections_FullMethodName = "/cluster.service.ConnectionsService/GetInfobaseConnections"
+	ConnectionsService_GetConnectionInfo_FullMethodName      = "/cluster.service.ConnectionsService/GetConnectionInfo"
+	ConnectionsService_Disconnect_FullMethodName             = "/cluster.service.ConnectionsService/Disconnect"
+)
+
+// ConnectionsServiceClient is the client API for ConnectionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ConnectionsService соединения с информационными базами
+type ConnectionsServiceClient interface {
+	// GetConnections список соединений кластера
+	GetConnections(ctx context.Context, in *GetConnectionsShortRequest, opts ...grpc.CallOption) (*GetConnectionsShortResponse, error)
+	// GetProcessConnections список соединений рабочего процесса
+	GetProcessConnections(ctx context.Context, in *GetProcessConnectionsRequest, opts ...grpc.CallOption) (*GetConnectionsShortResponse, error)
+	// GetInfobaseConnections список соединений информационной базы
+	GetInfobaseConnections(ctx context.Context, in *GetInfobaseConnectionsShortRequest, opts ...grpc.CallOption) (*GetInfobaseConnectionsShortResponse, error)
+	// GetConnectionInfo информация о соединении
+	GetConnectionInfo(ctx context.Context, in *GetConnectionInfoShortRequest, opts ...grpc.CallOption) (*GetConnectionInfoShortResponse, error)
+	// Disconnect разрыв соединения
+	Disconnect(ctx context.Context, in *DisconnectConnectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type connectionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewConnectionsServiceClient(cc grpc.ClientConnInterface) ConnectionsServiceClient {
+	return &connectionsServiceClient{cc}
+}
+
+func (c *connectionsServiceClient) GetConnections(ctx context.Context, in *GetConnectionsShortRequest, opts ...grpc.CallOption) (*GetConnectionsShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectionsShortResponse)
+	err := c.cc.Invoke(ctx, ConnectionsService_GetConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectionsServiceClient) GetProcessConnections(ctx context.Context, in *GetProcessConnectionsRequest, opts ...grpc.CallOption) (*GetConnectionsShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectionsShortResponse)
+	err := c.cc.Invoke(ctx, ConnectionsService_GetProcessConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectionsServiceClient) GetInfobaseConnections(ctx context.Context, in *GetInfobaseConnectionsShortRequest, opts ...grpc.CallOption) (*GetInfobaseConnectionsShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInfobaseConnectionsShortResponse)
+	err := c.cc.Invoke(ctx, ConnectionsService_GetInfobaseConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectionsServiceClient) GetConnectionInfo(ctx context.Context, in *GetConnectionInfoShortRequest, opts ...grpc.CallOption) (*GetConnectionInfoShortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectionInfoShortResponse)
+	err := c.cc.Invoke(ctx, ConnectionsService_GetConnectionInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *connectionsServiceClient) Disconnect(ctx context.Context, in *DisconnectConnectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ConnectionsService_Disconnect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConnectionsServiceServer is the server API for ConnectionsService service.
+// All implementations must embed UnimplementedConnectionsServiceServer
+// for forward compatibility.
+//
+// ConnectionsService соединения с информационными базами
+type ConnectionsServiceServer interface {
+	// GetConnections список соединений кластера
+	GetConnections(context.Context, *GetConnectionsShortRequest) (*GetConnectionsShortResponse, error)
+	// GetProcessConnections список соединений рабочего процесса
+	GetProcessConnections(context.Context, *GetProcessConnectionsRequest) (*GetConnectionsShortResponse, error)
+	// GetInfobaseConnections список соединений информационной базы
+	GetInfobaseConnections(context.Context, *GetInfobaseConnectionsShortRequest) (*GetInfobaseConnectionsShortResponse, error)
+	// GetConnectionInfo информация о соединении
+	GetConnectionInfo(context.Context, *GetConnectionInfoShortRequest) (*GetConnectionInfoShortResponse, error)
+	// Disconnect разрыв соединения
+	Disconnect(context.Context, *DisconnectConnectionRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedConnectionsServiceServer()
+}
+
+// UnimplementedConnectionsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedConnectionsServiceServer struct{}
+
+func (UnimplementedConnectionsServiceServer) GetConnections(context.Context, *GetConnectionsShortRequest) (*GetConnectionsShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnections not implemented")
+}
+func (UnimplementedConnectionsServiceServer) GetProcessConnections(context.Context, *GetProcessConnectionsRequest) (*GetConnectionsShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessConnections not implemented")
+}
+func (UnimplementedConnectionsServiceServer) GetInfobaseConnections(context.Context, *GetInfobaseConnectionsShortRequest) (*GetInfobaseConnectionsShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfobaseConnections not implemented")
+}
+func (UnimplementedConnectionsServiceServer) GetConnectionInfo(context.Context, *GetConnectionInfoShortRequest) (*GetConnectionInfoShortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionInfo not implemented")
+}
+func (UnimplementedConnectionsServiceServer) Disconnect(context.Context, *DisconnectConnectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedConnectionsServiceServer) mustEmbedUnimplementedConnectionsServiceServer() {}
+func (UnimplementedConnectionsServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeConnectionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConnectionsServiceServer will
+// result in compilation errors.
+type UnsafeConnectionsServiceServer interface {
+	mustEmbedUnimplementedConnectionsServiceServer()
+}
+
+func RegisterConnectionsServiceServer(s grpc.ServiceRegistrar, srv ConnectionsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedConnectionsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ConnectionsService_ServiceDesc, srv)
+}
+
+func _ConnectionsService_GetConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionsShortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).GetConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectionsService_GetConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).GetConnections(ctx, req.(*GetConnectionsShortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectionsService_GetProcessConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).GetProcessConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectionsService_GetProcessConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).GetProcessConnections(ctx, req.(*GetProcessConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectionsService_GetInfobaseConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfobaseConnectionsShortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).GetInfobaseConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectionsService_GetInfobaseConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).GetInfobaseConnections(ctx, req.(*GetInfobaseConnectionsShortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectionsService_GetConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionInfoShortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).GetConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectionsService_GetConnectionInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).GetConnectionInfo(ctx, req.(*GetConnectionInfoShortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConnectionsService_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectConnectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConnectionsServiceServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ConnectionsService_Disconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConnectionsServiceServer).Disconnect(ctx, req.(*DisconnectConnectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ConnectionsService_ServiceDesc is the grpc.ServiceDesc for ConnectionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ConnectionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.ConnectionsService",
+	HandlerType: (*ConnectionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetConnections",
+			Handler:    _ConnectionsService_GetConnections_Handler,
+		},
+		{
+			MethodName: "GetProcessConnections",
+			Handler:    _ConnectionsService_GetProcessConnections_Handler,
+		},
+		{
+			MethodName: "GetInfobaseConnections",
+			Handler:    _ConnectionsService_GetInfobaseConnections_Handler,
+		},
+		{
+			MethodName: "GetConnectionInfo",
+			Handler:    _ConnectionsService_GetConnectionInfo_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _ConnectionsService_Disconnect_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/connections.proto",
+}
@@ -0,0 +1,330 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+)
+
+func (x *ConnectionShortInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.Uuid); err != nil {
+		return err
+	}
+	// decode x.Application opts: order:2
+	if err := codec256.ParseString(reader, &x.Application); err != nil {
+		return err
+	}
+	// decode x.BlockedByLs opts: order:3
+	if err := codec256.ParseInt(reader, &x.BlockedByLs); err != nil {
+		return err
+	}
+	// decode x.ConnectedAt opts: encoder:"time" order:4
+	x.ConnectedAt = &timestamppb.Timestamp{}
+	if err := codec256.ParseTime(reader, x.ConnectedAt); err != nil {
+		return err
+	}
+	// decode x.ConnId opts: order:5
+	if err := codec256.ParseInt(reader, &x.ConnId); err != nil {
+		return err
+	}
+	// decode x.Host opts: order:6
+	if err := codec256.ParseString(reader, &x.Host); err != nil {
+		return err
+	}
+	// decode x.InfobaseId opts: encoder:"uuid" order:7
+	if err := codec256.ParseUUID(reader, &x.InfobaseId); err != nil {
+		return err
+	}
+	// decode x.ProcessId opts: encoder:"uuid" order:8
+	if err := codec256.ParseUUID(reader, &x.ProcessId); err != nil {
+		return err
+	}
+	// decode x.SessionNumber opts: order:9
+	if err := codec256.ParseInt(reader, &x.SessionNumber); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ConnectionShortInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.Uuid); err != nil {
+		return err
+	}
+	// decode x.Application opts: order:2
+	if err := codec256.FormatString(writer, x.Application); err != nil {
+		return err
+	}
+	// decode x.BlockedByLs opts: order:3
+	if err := codec256.FormatInt(writer, x.BlockedByLs); err != nil {
+		return err
+	}
+	// decode x.ConnectedAt opts: encoder:"time" order:4
+	// TODO check nil
+	if err := codec256.FormatTime(writer, x.GetConnectedAt().AsTime()); err != nil {
+		return err
+	}
+	// decode x.ConnId opts: order:5
+	if err := codec256.FormatInt(writer, x.ConnId); err != nil {
+		return err
+	}
+	// decode x.Host opts: order:6
+	if err := codec256.FormatString(writer, x.Host); err != nil {
+		return err
+	}
+	// decode x.InfobaseId opts: encoder:"uuid" order:7
+	if err := codec256.FormatUuid(writer, x.InfobaseId); err != nil {
+		return err
+	}
+	// decode x.ProcessId opts: encoder:"uuid" order:8
+	if err := codec256.FormatUuid(writer, x.ProcessId); err != nil {
+		return err
+	}
+	// decode x.SessionNumber opts: order:9
+	if err := codec256.FormatInt(writer, x.SessionNumber); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetConnectionsShortRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CONNECTIONS_SHORT_REQUEST
+}
+
+func (x *GetConnectionsShortRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetConnectionsShortRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetConnectionsShortResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CONNECTIONS_SHORT_RESPONSE
+}
+
+func (x *GetConnectionsShortResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Connections opts: order:1
+	var size_Connections int
+	if err := codec256.ParseSize(reader, &size_Connections); err != nil {
+		return err
+	}
+	for i := 0; i < size_Connections; i++ {
+		val := &ConnectionShortInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Connections = append(x.Connections, val)
+	}
+	return nil
+}
+func (x *GetConnectionsShortResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Connections opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Connections)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Connections); i++ {
+		if err := x.Connections[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetInfobaseConnectionsShortRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INFOBASE_CONNECTIONS_SHORT_REQUEST
+}
+
+func (x *GetInfobaseConnectionsShortRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.InfobaseId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.InfobaseId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInfobaseConnectionsShortRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.InfobaseId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.InfobaseId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInfobaseConnectionsShortResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INFOBASE_CONNECTIONS_SHORT_RESPONSE
+}
+
+func (x *GetInfobaseConnectionsShortResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Connections opts: order:1
+	var size_Connections int
+	if err := codec256.ParseSize(reader, &size_Connections); err != nil {
+		return err
+	}
+	for i := 0; i < size_Connections; i++ {
+		val := &ConnectionShortInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Connections = append(x.Connections, val)
+	}
+	return nil
+}
+func (x *GetInfobaseConnectionsShortResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Connections opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Connections)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Connections); i++ {
+		if err := x.Connections[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetConnectionInfoShortRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CONNECTION_INFO_SHORT_REQUEST
+}
+
+func (x *GetConnectionInfoShortRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ConnectionId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ConnectionId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetConnectionInfoShortRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ConnectionId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ConnectionId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetConnectionInfoShortResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_CONNECTION_INFO_SHORT_RESPONSE
+}
+
+func (x *GetConnectionInfoShortResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &ConnectionShortInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetConnectionInfoShortResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DisconnectRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DISCONNECT_REQUEST
+}
+
+func (x *DisconnectRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProcessId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ProcessId); err != nil {
+		return err
+	}
+	// decode x.ConnectionId opts: encoder:"uuid" order:3
+	if err := codec256.ParseUUID(reader, &x.ConnectionId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DisconnectRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProcessId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ProcessId); err != nil {
+		return err
+	}
+	// decode x.ConnectionId opts: encoder:"uuid" order:3
+	if err := codec256.FormatUuid(writer, x.ConnectionId); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/infobases.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	v1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetInfobasesRequest полные сведения об информационных базах кластера,
+// требует аутентификации администратора кластера
+type GetInfobasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobasesRequest) Reset() {
+	*x = GetInfobasesRequest{}
+	mi := &file_cluster_service_infobases_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobasesRequest) ProtoMessage() {}
+
+func (x *GetInfobasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_infobases_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobasesRequest.ProtoReflect.Descriptor instead.
+func (*GetInfobasesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_infobases_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetInfobasesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetInfobasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Infobases     []*v1.InfobaseInfo     `protobuf:"bytes,1,rep,name=infobases,proto3" json:"infobases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobasesResponse) Reset() {
+	*x = GetInfobasesResponse{}
+	mi := &file_cluster_service_infobases_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobasesResponse) ProtoMessage() {}
+
+func (x *GetInfobasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_infobases_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobasesResponse.ProtoReflect.Descriptor instead.
+func (*GetInfobasesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_infobases_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInfobasesResponse) GetInfobases() []*v1.InfobaseInfo {
+	if x != nil {
+		return x.Infobases
+	}
+	return nil
+}
+
+// GetInfobaseInfoRequest полные сведения об информационной базе,
+// требует аутентификации в информационной базе
+type GetInfobaseInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseInfoRequest) Reset() {
+	*x = GetInfobaseInfoRequest{}
+	mi := &file_cluster_service_infobases_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseInfoRequest) ProtoMessage() {}
+
+func (x *GetInfobaseInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_infobases_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfobaseInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_infobases_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetInfobaseInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetInfobaseInfoRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+type GetInfobaseInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *v1.InfobaseInfo       `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseInfoResponse) Reset() {
+	*x = GetInfobaseInfoResponse{}
+	mi := &file_cluster_service_infobases_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseInfoResponse) ProtoMessage() {}
+
+func (x *GetInfobaseInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_infobases_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfobaseInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_infobases_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInfobaseInfoResponse) GetInfo() *v1.InfobaseInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+var File_cluster_service_infobases_proto protoreflect.FileDescriptor
+
+const file_cluster_service_infobases_proto_rawDesc = "" +
+	"\n" +
+	"\x1fcluster/service/infobases.proto\x12\x0fcluster.service\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\x1a'v8platform/serialize/v1/infobases.proto\"c\n" +
+	"\x13GetInfobasesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:\x1d\x8a\xf5\xea\x94\x0e\x17:\x15GET_INFOBASES_REQUEST\"\x85\x01\n" +
+	"\x14GetInfobasesResponse\x12M\n" +
+	"\tinfobases\x18\x01 \x03(\v2%.v8platform.serialize.v1.InfobaseInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\tinfobases:\x1e\x8a\xf5\xea\x94\x0e\x18:\x16GET_INFOBASES_RESPONSE\"\x9b\x01\n" +
+	"\x16GetInfobaseInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12/\n" +
+	"\vinfobase_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\n" +
+	"infobaseId:!\x8a\xf5\xea\x94\x0e\x1b:\x19GET_INFOBASE_INFO_REQUEST\"\x82\x01\n" +
+	"\x17GetInfobaseInfoResponse\x12C\n" +
+	"\x04info\x18\x01 \x01(\v2%.v8platform.serialize.v1.InfobaseInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aGET_INFOBASE_INFO_RESPONSEB\xbd\x01\n" +
+	"\x13com.cluster.serviceB\x0eInfobasesProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_infobases_proto_rawDescOnce sync.Once
+	file_cluster_service_infobases_proto_rawDescData []byte
+)
+
+func file_cluster_service_infobases_proto_rawDescGZIP() []byte {
+	file_cluster_service_infobases_proto_rawDescOnce.Do(func() {
+		file_cluster_service_infobases_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_infobases_proto_rawDesc), len(file_cluster_service_infobases_proto_rawDesc)))
+	})
+	return file_cluster_service_infobases_proto_rawDescData
+}
+
+var file_cluster_service_infobases_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cluster_service_infobases_proto_goTypes = []any{
+	(*GetInfobasesRequest)(nil),     // 0: cluster.service.GetInfobasesRequest
+	(*GetInfobasesResponse)(nil),    // 1: cluster.service.GetInfobasesResponse
+	(*GetInfobaseInfoRequest)(nil),  // 2: cluster.service.GetInfobaseInfoRequest
+	(*GetInfobaseInfoResponse)(nil), // 3: cluster.service.GetInfobaseInfoResponse
+	(*v1.InfobaseInfo)(nil),         // 4: v8platform.serialize.v1.InfobaseInfo
+}
+var file_cluster_service_infobases_proto_depIdxs = []int32{
+	4, // 0: cluster.service.GetInfobasesResponse.infobases:type_name -> v8platform.serialize.v1.InfobaseInfo
+	4, // 1: cluster.service.GetInfobaseInfoResponse.info:type_name -> v8platform.serialize.v1.InfobaseInfo
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_infobases_proto_init() }
+func file_cluster_service_infobases_proto_init() {
+	if File_cluster_service_infobases_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_infobases_proto_rawDesc), len(file_cluster_service_infobases_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cluster_service_infobases_proto_goTypes,
+		DependencyIndexes: file_cluster_service_infobases_proto_depIdxs,
+		MessageInfos:      file_cluster_service_infobases_proto_msgTypes,
+	}.Build()
+	File_cluster_service_infobases_proto = out.File
+	file_cluster_service_infobases_proto_goTypes = nil
+	file_cluster_service_infobases_proto_depIdxs = nil
+}
@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	v11 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	io "io"
+)
+
+func (x *GetInfobasesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INFOBASES_REQUEST
+}
+
+func (x *GetInfobasesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInfobasesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInfobasesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INFOBASES_RESPONSE
+}
+
+func (x *GetInfobasesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Infobases opts: order:1
+	var size_Infobases int
+	if err := codec256.ParseSize(reader, &size_Infobases); err != nil {
+		return err
+	}
+	for i := 0; i < size_Infobases; i++ {
+		val := &v11.InfobaseInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Infobases = append(x.Infobases, val)
+	}
+	return nil
+}
+func (x *GetInfobasesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Infobases opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Infobases)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Infobases); i++ {
+		if err := x.Infobases[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetInfobaseInfoRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INFOBASE_INFO_REQUEST
+}
+
+func (x *GetInfobaseInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.InfobaseId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.InfobaseId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInfobaseInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.InfobaseId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.InfobaseId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInfobaseInfoResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INFOBASE_INFO_RESPONSE
+}
+
+func (x *GetInfobaseInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &v11.InfobaseInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetInfobaseInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,630 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/locks.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LockInfo управляемая блокировка
+type LockInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Соединение, установившее блокировку
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Описание блокировки
+	Descr    string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	LockedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	// Заблокированный объект
+	Object string `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`
+	// Сессия, установившая блокировку (uuid из GetSessions)
+	SessionId string `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Заполняется шлюзом
+	ClusterId     string `protobuf:"bytes,6,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockInfo) Reset() {
+	*x = LockInfo{}
+	mi := &file_cluster_service_locks_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockInfo) ProtoMessage() {}
+
+func (x *LockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockInfo.ProtoReflect.Descriptor instead.
+func (*LockInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LockInfo) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *LockInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *LockInfo) GetLockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedAt
+	}
+	return nil
+}
+
+func (x *LockInfo) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *LockInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LockInfo) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetLocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocksRequest) Reset() {
+	*x = GetLocksRequest{}
+	mi := &file_cluster_service_locks_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocksRequest) ProtoMessage() {}
+
+func (x *GetLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocksRequest.ProtoReflect.Descriptor instead.
+func (*GetLocksRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLocksRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetLocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*LockInfo            `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocksResponse) Reset() {
+	*x = GetLocksResponse{}
+	mi := &file_cluster_service_locks_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocksResponse) ProtoMessage() {}
+
+func (x *GetLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocksResponse.ProtoReflect.Descriptor instead.
+func (*GetLocksResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLocksResponse) GetLocks() []*LockInfo {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type GetInfobaseLocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseLocksRequest) Reset() {
+	*x = GetInfobaseLocksRequest{}
+	mi := &file_cluster_service_locks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseLocksRequest) ProtoMessage() {}
+
+func (x *GetInfobaseLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseLocksRequest.ProtoReflect.Descriptor instead.
+func (*GetInfobaseLocksRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetInfobaseLocksRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetInfobaseLocksRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+type GetInfobaseLocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*LockInfo            `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseLocksResponse) Reset() {
+	*x = GetInfobaseLocksResponse{}
+	mi := &file_cluster_service_locks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseLocksResponse) ProtoMessage() {}
+
+func (x *GetInfobaseLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseLocksResponse.ProtoReflect.Descriptor instead.
+func (*GetInfobaseLocksResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetInfobaseLocksResponse) GetLocks() []*LockInfo {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type GetSessionLocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionLocksRequest) Reset() {
+	*x = GetSessionLocksRequest{}
+	mi := &file_cluster_service_locks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionLocksRequest) ProtoMessage() {}
+
+func (x *GetSessionLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionLocksRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionLocksRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSessionLocksRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetSessionLocksRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *GetSessionLocksRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetSessionLocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*LockInfo            `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionLocksResponse) Reset() {
+	*x = GetSessionLocksResponse{}
+	mi := &file_cluster_service_locks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionLocksResponse) ProtoMessage() {}
+
+func (x *GetSessionLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionLocksResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionLocksResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSessionLocksResponse) GetLocks() []*LockInfo {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type GetConnectionLocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ConnectionId  string                 `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionLocksRequest) Reset() {
+	*x = GetConnectionLocksRequest{}
+	mi := &file_cluster_service_locks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionLocksRequest) ProtoMessage() {}
+
+func (x *GetConnectionLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionLocksRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionLocksRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetConnectionLocksRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetConnectionLocksRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type GetConnectionLocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*LockInfo            `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionLocksResponse) Reset() {
+	*x = GetConnectionLocksResponse{}
+	mi := &file_cluster_service_locks_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionLocksResponse) ProtoMessage() {}
+
+func (x *GetConnectionLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_locks_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionLocksResponse.ProtoReflect.Descriptor instead.
+func (*GetConnectionLocksResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_locks_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetConnectionLocksResponse) GetLocks() []*LockInfo {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+var File_cluster_service_locks_proto protoreflect.FileDescriptor
+
+const file_cluster_service_locks_proto_rawDesc = "" +
+	"\n" +
+	"\x1bcluster/service/locks.proto\x12\x0fcluster.service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"\x9e\x02\n" +
+	"\bLockInfo\x123\n" +
+	"\rconnection_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\fconnectionId\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12G\n" +
+	"\tlocked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04time\x10\x03R\blockedAt\x12&\n" +
+	"\x06object\x18\x04 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x04R\x06object\x12-\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x05R\tsessionId\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x06 \x01(\tR\tclusterId\"[\n" +
+	"\x0fGetLocksRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:\x19\x8a\xf5\xea\x94\x0e\x13:\x11GET_LOCKS_REQUEST\"i\n" +
+	"\x10GetLocksResponse\x129\n" +
+	"\x05locks\x18\x01 \x03(\v2\x19.cluster.service.LockInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05locks:\x1a\x8a\xf5\xea\x94\x0e\x14:\x12GET_LOCKS_RESPONSE\"\x9d\x01\n" +
+	"\x17GetInfobaseLocksRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12/\n" +
+	"\vinfobase_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\n" +
+	"infobaseId:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aGET_INFOBASE_LOCKS_REQUEST\"z\n" +
+	"\x18GetInfobaseLocksResponse\x129\n" +
+	"\x05locks\x18\x01 \x03(\v2\x19.cluster.service.LockInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05locks:#\x8a\xf5\xea\x94\x0e\x1d:\x1bGET_INFOBASE_LOCKS_RESPONSE\"\xca\x01\n" +
+	"\x16GetSessionLocksRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12/\n" +
+	"\vinfobase_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\n" +
+	"infobaseId\x12-\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x03R\tsessionId:!\x8a\xf5\xea\x94\x0e\x1b:\x19GET_SESSION_LOCKS_REQUEST\"x\n" +
+	"\x17GetSessionLocksResponse\x129\n" +
+	"\x05locks\x18\x01 \x03(\v2\x19.cluster.service.LockInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05locks:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aGET_SESSION_LOCKS_RESPONSE\"\xa5\x01\n" +
+	"\x19GetConnectionLocksRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x123\n" +
+	"\rconnection_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\fconnectionId:$\x8a\xf5\xea\x94\x0e\x1e:\x1cGET_CONNECTION_LOCKS_REQUEST\"~\n" +
+	"\x1aGetConnectionLocksResponse\x129\n" +
+	"\x05locks\x18\x01 \x03(\v2\x19.cluster.service.LockInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05locks:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_CONNECTION_LOCKS_RESPONSE2\x9d\x03\n" +
+	"\fLocksService\x12O\n" +
+	"\bGetLocks\x12 .cluster.service.GetLocksRequest\x1a!.cluster.service.GetLocksResponse\x12g\n" +
+	"\x10GetInfobaseLocks\x12(.cluster.service.GetInfobaseLocksRequest\x1a).cluster.service.GetInfobaseLocksResponse\x12d\n" +
+	"\x0fGetSessionLocks\x12'.cluster.service.GetSessionLocksRequest\x1a(.cluster.service.GetSessionLocksResponse\x12m\n" +
+	"\x12GetConnectionLocks\x12*.cluster.service.GetConnectionLocksRequest\x1a+.cluster.service.GetConnectionLocksResponseB\xb9\x01\n" +
+	"\x13com.cluster.serviceB\n" +
+	"LocksProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_locks_proto_rawDescOnce sync.Once
+	file_cluster_service_locks_proto_rawDescData []byte
+)
+
+func file_cluster_service_locks_proto_rawDescGZIP() []byte {
+	file_cluster_service_locks_proto_rawDescOnce.Do(func() {
+		file_cluster_service_locks_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_locks_proto_rawDesc), len(file_cluster_service_locks_proto_rawDesc)))
+	})
+	return file_cluster_service_locks_proto_rawDescData
+}
+
+var file_cluster_service_locks_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_cluster_service_locks_proto_goTypes = []any{
+	(*LockInfo)(nil),                   // 0: cluster.service.LockInfo
+	(*GetLocksRequest)(nil),            // 1: cluster.service.GetLocksRequest
+	(*GetLocksResponse)(nil),           // 2: cluster.service.GetLocksResponse
+	(*GetInfobaseLocksRequest)(nil),    // 3: cluster.service.GetInfobaseLocksRequest
+	(*GetInfobaseLocksResponse)(nil),   // 4: cluster.service.GetInfobaseLocksResponse
+	(*GetSessionLocksRequest)(nil),     // 5: cluster.service.GetSessionLocksRequest
+	(*GetSessionLocksResponse)(nil),    // 6: cluster.service.GetSessionLocksResponse
+	(*GetConnectionLocksRequest)(nil),  // 7: cluster.service.GetConnectionLocksRequest
+	(*GetConnectionLocksResponse)(nil), // 8: cluster.service.GetConnectionLocksResponse
+	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
+}
+var file_cluster_service_locks_proto_depIdxs = []int32{
+	9, // 0: cluster.service.LockInfo.locked_at:type_name -> google.protobuf.Timestamp
+	0, // 1: cluster.service.GetLocksResponse.locks:type_name -> cluster.service.LockInfo
+	0, // 2: cluster.service.GetInfobaseLocksResponse.locks:type_name -> cluster.service.LockInfo
+	0, // 3: cluster.service.GetSessionLocksResponse.locks:type_name -> cluster.service.LockInfo
+	0, // 4: cluster.service.GetConnectionLocksResponse.locks:type_name -> cluster.service.LockInfo
+	1, // 5: cluster.service.LocksService.GetLocks:input_type -> cluster.service.GetLocksRequest
+	3, // 6: cluster.service.LocksService.GetInfobaseLocks:input_type -> cluster.service.GetInfobaseLocksRequest
+	5, // 7: cluster.service.LocksService.GetSessionLocks:input_type -> cluster.service.GetSessionLocksRequest
+	7, // 8: cluster.service.LocksService.GetConnectionLocks:input_type -> cluster.service.GetConnectionLocksRequest
+	2, // 9: cluster.service.LocksService.GetLocks:output_type -> cluster.service.GetLocksResponse
+	4, // 10: cluster.service.LocksService.GetInfobaseLocks:output_type -> cluster.service.GetInfobaseLocksResponse
+	6, // 11: cluster.service.LocksService.GetSessionLocks:output_type -> cluster.service.GetSessionLocksResponse
+	8, // 12: cluster.service.LocksService.GetConnectionLocks:output_type -> cluster.service.GetConnectionLocksResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_locks_proto_init() }
+func file_cluster_service_locks_proto_init() {
+	if File_cluster_service_locks_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_locks_proto_rawDesc), len(file_cluster_service_locks_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_locks_proto_goTypes,
+		DependencyIndexes: file_cluster_service_locks_proto_depIdxs,
+		MessageInfos:      file_cluster_service_locks_proto_msgTypes,
+	}.Build()
+	File_cluster_service_locks_proto = out.File
+	file_cluster_service_locks_proto_goTypes = nil
+	file_cluster_service_locks_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/locks.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LocksService_GetLocks_FullMethodName           = "/cluster.service.LocksService/GetLocks"
+	LocksService_GetInfobaseLocks_FullMethodName   = "/cluster.service.LocksService/GetInfobaseLocks"
+	LocksService_GetSessionLocks_FullMethodName    = "/cluster.service.LocksService/GetSessionLocks"
+	LocksService_GetConnectionLocks_FullMethodName = "/cluster.service.LocksService/GetConnectionLocks"
+)
+
+// LocksServiceClient is the client API for LocksService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// LocksService управляемые блокировки кластера
+type LocksServiceClient interface {
+	// GetLocks список блокировок кластера
+	GetLocks(ctx context.Context, in *GetLocksRequest, opts ...grpc.CallOption) (*GetLocksResponse, error)
+	// GetInfobaseLocks список блокировок информационной базы
+	GetInfobaseLocks(ctx context.Context, in *GetInfobaseLocksRequest, opts ...grpc.CallOption) (*GetInfobaseLocksResponse, error)
+	// GetSessionLocks список блокировок сессии
+	GetSessionLocks(ctx context.Context, in *GetSessionLocksRequest, opts ...grpc.CallOption) (*GetSessionLocksResponse, error)
+	// GetConnectionLocks список блокировок соединения
+	GetConnectionLocks(ctx context.Context, in *GetConnectionLocksRequest, opts ...grpc.CallOption) (*GetConnectionLocksResponse, error)
+}
+
+type locksServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLocksServiceClient(cc grpc.ClientConnInterface) LocksServiceClient {
+	return &locksServiceClient{cc}
+}
+
+func (c *locksServiceClient) GetLocks(ctx context.Context, in *GetLocksRequest, opts ...grpc.CallOption) (*GetLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocksResponse)
+	err := c.cc.Invoke(ctx, LocksService_GetLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceClient) GetInfobaseLocks(ctx context.Context, in *GetInfobaseLocksRequest, opts ...grpc.CallOption) (*GetInfobaseLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInfobaseLocksResponse)
+	err := c.cc.Invoke(ctx, LocksService_GetInfobaseLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceClient) GetSessionLocks(ctx context.Context, in *GetSessionLocksRequest, opts ...grpc.CallOption) (*GetSessionLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionLocksResponse)
+	err := c.cc.Invoke(ctx, LocksService_GetSessionLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locksServiceClient) GetConnectionLocks(ctx context.Context, in *GetConnectionLocksRequest, opts ...grpc.CallOption) (*GetConnectionLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConnectionLocksResponse)
+	err := c.cc.Invoke(ctx, LocksService_GetConnectionLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LocksServiceServer is the server API for LocksService service.
+// All implementations must embed UnimplementedLocksServiceServer
+// for forward compatibility.
+//
+// LocksService управляемые блокировки кластера
+type LocksServiceServer interface {
+	// GetLocks список блокировок кластера
+	GetLocks(context.Context, *GetLocksRequest) (*GetLocksResponse, error)
+	// GetInfobaseLocks список блокировок информационной базы
+	GetInfobaseLocks(context.Context, *GetInfobaseLocksRequest) (*GetInfobaseLocksResponse, error)
+	// GetSessionLocks список блокировок сессии
+	GetSessionLocks(context.Context, *GetSessionLocksRequest) (*GetSessionLocksResponse, error)
+	// GetConnectionLocks список блокировок соединения
+	GetConnectionLocks(context.Context, *GetConnectionLocksRequest) (*GetConnectionLocksResponse, error)
+	mustEmbedUnimplementedLocksServiceServer()
+}
+
+// UnimplementedLocksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLocksServiceServer struct{}
+
+func (UnimplementedLocksServiceServer) GetLocks(context.Context, *GetLocksRequest) (*GetLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocks not implemented")
+}
+func (UnimplementedLocksServiceServer) GetInfobaseLocks(context.Context, *GetInfobaseLocksRequest) (*GetInfobaseLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfobaseLocks not implemented")
+}
+func (UnimplementedLocksServiceServer) GetSessionLocks(context.Context, *GetSessionLocksRequest) (*GetSessionLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionLocks not implemented")
+}
+func (UnimplementedLocksServiceServer) GetConnectionLocks(context.Context, *GetConnectionLocksRequest) (*GetConnectionLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConnectionLocks not implemented")
+}
+func (UnimplementedLocksServiceServer) mustEmbedUnimplementedLocksServiceServer() {}
+func (UnimplementedLocksServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeLocksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LocksServiceServer will
+// result in compilation errors.
+type UnsafeLocksServiceServer interface {
+	mustEmbedUnimplementedLocksServiceServer()
+}
+
+func RegisterLocksServiceServer(s grpc.ServiceRegistrar, srv LocksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLocksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LocksService_ServiceDesc, srv)
+}
+
+func _LocksService_GetLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceServer).GetLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksService_GetLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceServer).GetLocks(ctx, req.(*GetLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksService_GetInfobaseLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfobaseLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceServer).GetInfobaseLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksService_GetInfobaseLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceServer).GetInfobaseLocks(ctx, req.(*GetInfobaseLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksService_GetSessionLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceServer).GetSessionLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksService_GetSessionLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceServer).GetSessionLocks(ctx, req.(*GetSessionLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocksService_GetConnectionLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConnectionLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocksServiceServer).GetConnectionLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocksService_GetConnectionLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocksServiceServer).GetConnectionLocks(ctx, req.(*GetConnectionLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LocksService_ServiceDesc is the grpc.ServiceDesc for LocksService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LocksService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.LocksService",
+	HandlerType: (*LocksServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLocks",
+			Handler:    _LocksService_GetLocks_Handler,
+		},
+		{
+			MethodName: "GetInfobaseLocks",
+			Handler:    _LocksService_GetInfobaseLocks_Handler,
+		},
+		{
+			MethodName: "GetSessionLocks",
+			Handler:    _LocksService_GetSessionLocks_Handler,
+		},
+		{
+			MethodName: "GetConnectionLocks",
+			Handler:    _LocksService_GetConnectionLocks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/locks.proto",
+}
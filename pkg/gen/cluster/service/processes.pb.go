@@ -0,0 +1,665 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/processes.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	v1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProcessInfo рабочий процесс (rphost) кластера
+type ProcessInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Uuid              string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	AvgBackCallTime   float64                `protobuf:"fixed64,2,opt,name=avg_back_call_time,json=avgBackCallTime,proto3" json:"avg_back_call_time,omitempty"`
+	AvgCallTime       float64                `protobuf:"fixed64,3,opt,name=avg_call_time,json=avgCallTime,proto3" json:"avg_call_time,omitempty"`
+	AvgDbCallTime     float64                `protobuf:"fixed64,4,opt,name=avg_db_call_time,json=avgDbCallTime,proto3" json:"avg_db_call_time,omitempty"`
+	AvgLockCallTime   float64                `protobuf:"fixed64,5,opt,name=avg_lock_call_time,json=avgLockCallTime,proto3" json:"avg_lock_call_time,omitempty"`
+	AvgServerCallTime float64                `protobuf:"fixed64,6,opt,name=avg_server_call_time,json=avgServerCallTime,proto3" json:"avg_server_call_time,omitempty"`
+	AvgThreads        float64                `protobuf:"fixed64,7,opt,name=avg_threads,json=avgThreads,proto3" json:"avg_threads,omitempty"`
+	Capacity          int32                  `protobuf:"varint,8,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Connections       int32                  `protobuf:"varint,9,opt,name=connections,proto3" json:"connections,omitempty"`
+	Host              string                 `protobuf:"bytes,10,opt,name=host,proto3" json:"host,omitempty"`
+	Enable            bool                   `protobuf:"varint,11,opt,name=enable,proto3" json:"enable,omitempty"`
+	Licenses          []*v1.LicenseInfo      `protobuf:"bytes,12,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	Port              int32                  `protobuf:"varint,13,opt,name=port,proto3" json:"port,omitempty"`
+	MemoryExcessTime  int32                  `protobuf:"varint,14,opt,name=memory_excess_time,json=memoryExcessTime,proto3" json:"memory_excess_time,omitempty"`
+	MemorySize        int32                  `protobuf:"varint,15,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	Pid               string                 `protobuf:"bytes,16,opt,name=pid,proto3" json:"pid,omitempty"`
+	// 1 - процесс запущен
+	Running       int32                  `protobuf:"varint,17,opt,name=running,proto3" json:"running,omitempty"`
+	SelectionSize int32                  `protobuf:"varint,18,opt,name=selection_size,json=selectionSize,proto3" json:"selection_size,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// 0 - не использовать, 1 - использовать, 2 - использовать как резервный
+	Use                  int32 `protobuf:"varint,20,opt,name=use,proto3" json:"use,omitempty"`
+	AvailablePerformance int32 `protobuf:"varint,21,opt,name=available_performance,json=availablePerformance,proto3" json:"available_performance,omitempty"`
+	Reserve              bool  `protobuf:"varint,22,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	// Заполняется шлюзом
+	ClusterId     string `protobuf:"bytes,23,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	mi := &file_cluster_service_processes_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProcessInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetAvgBackCallTime() float64 {
+	if x != nil {
+		return x.AvgBackCallTime
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAvgCallTime() float64 {
+	if x != nil {
+		return x.AvgCallTime
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAvgDbCallTime() float64 {
+	if x != nil {
+		return x.AvgDbCallTime
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAvgLockCallTime() float64 {
+	if x != nil {
+		return x.AvgLockCallTime
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAvgServerCallTime() float64 {
+	if x != nil {
+		return x.AvgServerCallTime
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAvgThreads() float64 {
+	if x != nil {
+		return x.AvgThreads
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetConnections() int32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetLicenses() []*v1.LicenseInfo {
+	if x != nil {
+		return x.Licenses
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetMemoryExcessTime() int32 {
+	if x != nil {
+		return x.MemoryExcessTime
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetMemorySize() int32 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetPid() string {
+	if x != nil {
+		return x.Pid
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetSelectionSize() int32 {
+	if x != nil {
+		return x.SelectionSize
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetUse() int32 {
+	if x != nil {
+		return x.Use
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetAvailablePerformance() int32 {
+	if x != nil {
+		return x.AvailablePerformance
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetReserve() bool {
+	if x != nil {
+		return x.Reserve
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetWorkingProcessesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingProcessesRequest) Reset() {
+	*x = GetWorkingProcessesRequest{}
+	mi := &file_cluster_service_processes_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingProcessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingProcessesRequest) ProtoMessage() {}
+
+func (x *GetWorkingProcessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingProcessesRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingProcessesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetWorkingProcessesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetWorkingProcessesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Processes     []*ProcessInfo         `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingProcessesResponse) Reset() {
+	*x = GetWorkingProcessesResponse{}
+	mi := &file_cluster_service_processes_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingProcessesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingProcessesResponse) ProtoMessage() {}
+
+func (x *GetWorkingProcessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingProcessesResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkingProcessesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetWorkingProcessesResponse) GetProcesses() []*ProcessInfo {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type GetServerWorkingProcessesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerWorkingProcessesRequest) Reset() {
+	*x = GetServerWorkingProcessesRequest{}
+	mi := &file_cluster_service_processes_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerWorkingProcessesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerWorkingProcessesRequest) ProtoMessage() {}
+
+func (x *GetServerWorkingProcessesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerWorkingProcessesRequest.ProtoReflect.Descriptor instead.
+func (*GetServerWorkingProcessesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetServerWorkingProcessesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetServerWorkingProcessesRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetServerWorkingProcessesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Processes     []*ProcessInfo         `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerWorkingProcessesResponse) Reset() {
+	*x = GetServerWorkingProcessesResponse{}
+	mi := &file_cluster_service_processes_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerWorkingProcessesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerWorkingProcessesResponse) ProtoMessage() {}
+
+func (x *GetServerWorkingProcessesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerWorkingProcessesResponse.ProtoReflect.Descriptor instead.
+func (*GetServerWorkingProcessesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetServerWorkingProcessesResponse) GetProcesses() []*ProcessInfo {
+	if x != nil {
+		return x.Processes
+	}
+	return nil
+}
+
+type GetWorkingProcessInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProcessId     string                 `protobuf:"bytes,2,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingProcessInfoRequest) Reset() {
+	*x = GetWorkingProcessInfoRequest{}
+	mi := &file_cluster_service_processes_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingProcessInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingProcessInfoRequest) ProtoMessage() {}
+
+func (x *GetWorkingProcessInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingProcessInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingProcessInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWorkingProcessInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetWorkingProcessInfoRequest) GetProcessId() string {
+	if x != nil {
+		return x.ProcessId
+	}
+	return ""
+}
+
+type GetWorkingProcessInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *ProcessInfo           `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingProcessInfoResponse) Reset() {
+	*x = GetWorkingProcessInfoResponse{}
+	mi := &file_cluster_service_processes_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingProcessInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingProcessInfoResponse) ProtoMessage() {}
+
+func (x *GetWorkingProcessInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_processes_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingProcessInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkingProcessInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_processes_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWorkingProcessInfoResponse) GetInfo() *ProcessInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+var File_cluster_service_processes_proto protoreflect.FileDescriptor
+
+const file_cluster_service_processes_proto_rawDesc = "" +
+	"\n" +
+	"\x1fcluster/service/processes.proto\x12\x0fcluster.service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\x1a&v8platform/serialize/v1/licanses.proto\"\xa8\b\n" +
+	"\vProcessInfo\x12\"\n" +
+	"\x04uuid\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\x04uuid\x125\n" +
+	"\x12avg_back_call_time\x18\x02 \x01(\x01B\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x0favgBackCallTime\x12,\n" +
+	"\ravg_call_time\x18\x03 \x01(\x01B\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\vavgCallTime\x121\n" +
+	"\x10avg_db_call_time\x18\x04 \x01(\x01B\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\ravgDbCallTime\x125\n" +
+	"\x12avg_lock_call_time\x18\x05 \x01(\x01B\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x0favgLockCallTime\x129\n" +
+	"\x14avg_server_call_time\x18\x06 \x01(\x01B\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x11avgServerCallTime\x12)\n" +
+	"\vavg_threads\x18\a \x01(\x01B\b\x82\xf5\xea\x94\x0e\x02\x10\aR\n" +
+	"avgThreads\x12$\n" +
+	"\bcapacity\x18\b \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\bR\bcapacity\x12*\n" +
+	"\vconnections\x18\t \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\tR\vconnections\x12\x1c\n" +
+	"\x04host\x18\n" +
+	" \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\n" +
+	"R\x04host\x12 \n" +
+	"\x06enable\x18\v \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\vR\x06enable\x12J\n" +
+	"\blicenses\x18\f \x03(\v2$.v8platform.serialize.v1.LicenseInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\fR\blicenses\x12#\n" +
+	"\x04port\x18\r \x01(\x05B\x0f\x82\xf5\xea\x94\x0e\t\n" +
+	"\x05short\x10\rR\x04port\x126\n" +
+	"\x12memory_excess_time\x18\x0e \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x0eR\x10memoryExcessTime\x12)\n" +
+	"\vmemory_size\x18\x0f \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x0fR\n" +
+	"memorySize\x12\x1a\n" +
+	"\x03pid\x18\x10 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x10R\x03pid\x12\"\n" +
+	"\arunning\x18\x11 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x11R\arunning\x12/\n" +
+	"\x0eselection_size\x18\x12 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x12R\rselectionSize\x12I\n" +
+	"\n" +
+	"started_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04time\x10\x13R\tstartedAt\x12\x1a\n" +
+	"\x03use\x18\x14 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x14R\x03use\x12=\n" +
+	"\x15available_performance\x18\x15 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x15R\x14availablePerformance\x12$\n" +
+	"\areserve\x18\x16 \x01(\bB\n" +
+	"\x82\xf5\xea\x94\x0e\x04\x10\x16\x18\tR\areserve\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x17 \x01(\tR\tclusterId\"r\n" +
+	"\x1aGetWorkingProcessesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_WORKING_PROCESSES_REQUEST\"\x8b\x01\n" +
+	"\x1bGetWorkingProcessesResponse\x12D\n" +
+	"\tprocesses\x18\x01 \x03(\v2\x1c.cluster.service.ProcessInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\tprocesses:&\x8a\xf5\xea\x94\x0e :\x1eGET_WORKING_PROCESSES_RESPONSE\"\xac\x01\n" +
+	" GetServerWorkingProcessesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId:,\x8a\xf5\xea\x94\x0e&:$GET_SERVER_WORKING_PROCESSES_REQUEST\"\x98\x01\n" +
+	"!GetServerWorkingProcessesResponse\x12D\n" +
+	"\tprocesses\x18\x01 \x03(\v2\x1c.cluster.service.ProcessInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\tprocesses:-\x8a\xf5\xea\x94\x0e':%GET_SERVER_WORKING_PROCESSES_RESPONSE\"\xa6\x01\n" +
+	"\x1cGetWorkingProcessInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12-\n" +
+	"\n" +
+	"process_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\tprocessId:(\x8a\xf5\xea\x94\x0e\": GET_WORKING_PROCESS_INFO_REQUEST\"\x86\x01\n" +
+	"\x1dGetWorkingProcessInfoResponse\x12:\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.cluster.service.ProcessInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:)\x8a\xf5\xea\x94\x0e#:!GET_WORKING_PROCESS_INFO_RESPONSE2\x88\x03\n" +
+	"\x17WorkingProcessesService\x12p\n" +
+	"\x13GetWorkingProcesses\x12+.cluster.service.GetWorkingProcessesRequest\x1a,.cluster.service.GetWorkingProcessesResponse\x12\x82\x01\n" +
+	"\x19GetServerWorkingProcesses\x121.cluster.service.GetServerWorkingProcessesRequest\x1a2.cluster.service.GetServerWorkingProcessesResponse\x12v\n" +
+	"\x15GetWorkingProcessInfo\x12-.cluster.service.GetWorkingProcessInfoRequest\x1a..cluster.service.GetWorkingProcessInfoResponseB\xbd\x01\n" +
+	"\x13com.cluster.serviceB\x0eProcessesProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_processes_proto_rawDescOnce sync.Once
+	file_cluster_service_processes_proto_rawDescData []byte
+)
+
+func file_cluster_service_processes_proto_rawDescGZIP() []byte {
+	file_cluster_service_processes_proto_rawDescOnce.Do(func() {
+		file_cluster_service_processes_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_processes_proto_rawDesc), len(file_cluster_service_processes_proto_rawDesc)))
+	})
+	return file_cluster_service_processes_proto_rawDescData
+}
+
+var file_cluster_service_processes_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cluster_service_processes_proto_goTypes = []any{
+	(*ProcessInfo)(nil),                       // 0: cluster.service.ProcessInfo
+	(*GetWorkingProcessesRequest)(nil),        // 1: cluster.service.GetWorkingProcessesRequest
+	(*GetWorkingProcessesResponse)(nil),       // 2: cluster.service.GetWorkingProcessesResponse
+	(*GetServerWorkingProcessesRequest)(nil),  // 3: cluster.service.GetServerWorkingProcessesRequest
+	(*GetServerWorkingProcessesResponse)(nil), // 4: cluster.service.GetServerWorkingProcessesResponse
+	(*GetWorkingProcessInfoRequest)(nil),      // 5: cluster.service.GetWorkingProcessInfoRequest
+	(*GetWorkingProcessInfoResponse)(nil),     // 6: cluster.service.GetWorkingProcessInfoResponse
+	(*v1.LicenseInfo)(nil),                    // 7: v8platform.serialize.v1.LicenseInfo
+	(*timestamppb.Timestamp)(nil),             // 8: google.protobuf.Timestamp
+}
+var file_cluster_service_processes_proto_depIdxs = []int32{
+	7, // 0: cluster.service.ProcessInfo.licenses:type_name -> v8platform.serialize.v1.LicenseInfo
+	8, // 1: cluster.service.ProcessInfo.started_at:type_name -> google.protobuf.Timestamp
+	0, // 2: cluster.service.GetWorkingProcessesResponse.processes:type_name -> cluster.service.ProcessInfo
+	0, // 3: cluster.service.GetServerWorkingProcessesResponse.processes:type_name -> cluster.service.ProcessInfo
+	0, // 4: cluster.service.GetWorkingProcessInfoResponse.info:type_name -> cluster.service.ProcessInfo
+	1, // 5: cluster.service.WorkingProcessesService.GetWorkingProcesses:input_type -> cluster.service.GetWorkingProcessesRequest
+	3, // 6: cluster.service.WorkingProcessesService.GetServerWorkingProcesses:input_type -> cluster.service.GetServerWorkingProcessesRequest
+	5, // 7: cluster.service.WorkingProcessesService.GetWorkingProcessInfo:input_type -> cluster.service.GetWorkingProcessInfoRequest
+	2, // 8: cluster.service.WorkingProcessesService.GetWorkingProcesses:output_type -> cluster.service.GetWorkingProcessesResponse
+	4, // 9: cluster.service.WorkingProcessesService.GetServerWorkingProcesses:output_type -> cluster.service.GetServerWorkingProcessesResponse
+	6, // 10: cluster.service.WorkingProcessesService.GetWorkingProcessInfo:output_type -> cluster.service.GetWorkingProcessInfoResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_processes_proto_init() }
+func file_cluster_service_processes_proto_init() {
+	if File_cluster_service_processes_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_processes_proto_rawDesc), len(file_cluster_service_processes_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_processes_proto_goTypes,
+		DependencyIndexes: file_cluster_service_processes_proto_depIdxs,
+		MessageInfos:      file_cluster_service_processes_proto_msgTypes,
+	}.Build()
+	File_cluster_service_processes_proto = out.File
+	file_cluster_service_processes_proto_goTypes = nil
+	file_cluster_service_processes_proto_depIdxs = nil
+}
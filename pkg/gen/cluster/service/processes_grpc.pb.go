@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/processes.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WorkingProcessesService_GetWorkingProcesses_FullMethodName       = "/cluster.service.WorkingProcessesService/GetWorkingProcesses"
+	WorkingProcessesService_GetServerWorkingProcesses_FullMethodName = "/cluster.service.WorkingProcessesService/GetServerWorkingProcesses"
+	WorkingProcessesService_GetWorkingProcessInfo_FullMethodName     = "/cluster.service.WorkingProcessesService/GetWorkingProcessInfo"
+)
+
+// WorkingProcessesServiceClient is the client API for WorkingProcessesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WorkingProcessesService рабочие процессы кластеров 1С
+type WorkingProcessesServiceClient interface {
+	// GetWorkingProcesses список рабочих процессов кластера
+	GetWorkingProcesses(ctx context.Context, in *GetWorkingProcessesRequest, opts ...grpc.CallOption) (*GetWorkingProcessesResponse, error)
+	// GetServerWorkingProcesses список рабочих процессов рабочего сервера
+	GetServerWorkingProcesses(ctx context.Context, in *GetServerWorkingProcessesRequest, opts ...grpc.CallOption) (*GetServerWorkingProcessesResponse, error)
+	// GetWorkingProcessInfo полная информация о рабочем процессе
+	GetWorkingProcessInfo(ctx context.Context, in *GetWorkingProcessInfoRequest, opts ...grpc.CallOption) (*GetWorkingProcessInfoResponse, error)
+}
+
+type workingProcessesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkingProcessesServiceClient(cc grpc.ClientConnInterface) WorkingProcessesServiceClient {
+	return &workingProcessesServiceClient{cc}
+}
+
+func (c *workingProcessesServiceClient) GetWorkingProcesses(ctx context.Context, in *GetWorkingProcessesRequest, opts ...grpc.CallOption) (*GetWorkingProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkingProcessesResponse)
+	err := c.cc.Invoke(ctx, WorkingProcessesService_GetWorkingProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingProcessesServiceClient) GetServerWorkingProcesses(ctx context.Context, in *GetServerWorkingProcessesRequest, opts ...grpc.CallOption) (*GetServerWorkingProcessesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServerWorkingProcessesResponse)
+	err := c.cc.Invoke(ctx, WorkingProcessesService_GetServerWorkingProcesses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingProcessesServiceClient) GetWorkingProcessInfo(ctx context.Context, in *GetWorkingProcessInfoRequest, opts ...grpc.CallOption) (*GetWorkingProcessInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkingProcessInfoResponse)
+	err := c.cc.Invoke(ctx, WorkingProcessesService_GetWorkingProcessInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkingProcessesServiceServer is the server API for WorkingProcessesService service.
+// All implementations must embed UnimplementedWorkingProcessesServiceServer
+// for forward compatibility.
+//
+// WorkingProcessesService рабочие процессы кластеров 1С
+type WorkingProcessesServiceServer interface {
+	// GetWorkingProcesses список рабочих процессов кластера
+	GetWorkingProcesses(context.Context, *GetWorkingProcessesRequest) (*GetWorkingProcessesResponse, error)
+	// GetServerWorkingProcesses список рабочих процессов рабочего сервера
+	GetServerWorkingProcesses(context.Context, *GetServerWorkingProcessesRequest) (*GetServerWorkingProcessesResponse, error)
+	// GetWorkingProcessInfo полная информация о рабочем процессе
+	GetWorkingProcessInfo(context.Context, *GetWorkingProcessInfoRequest) (*GetWorkingProcessInfoResponse, error)
+	mustEmbedUnimplementedWorkingProcessesServiceServer()
+}
+
+// UnimplementedWorkingProcessesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkingProcessesServiceServer struct{}
+
+func (UnimplementedWorkingProcessesServiceServer) GetWorkingProcesses(context.Context, *GetWorkingProcessesRequest) (*GetWorkingProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingProcesses not implemented")
+}
+func (UnimplementedWorkingProcessesServiceServer) GetServerWorkingProcesses(context.Context, *GetServerWorkingProcessesRequest) (*GetServerWorkingProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerWorkingProcesses not implemented")
+}
+func (UnimplementedWorkingProcessesServiceServer) GetWorkingProcessInfo(context.Context, *GetWorkingProcessInfoRequest) (*GetWorkingProcessInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingProcessInfo not implemented")
+}
+func (UnimplementedWorkingProcessesServiceServer) mustEmbedUnimplementedWorkingProcessesServiceServer() {
+}
+func (UnimplementedWorkingProcessesServiceServer) testEmbeddedByValue() {}
+
+// UnsafeWorkingProcessesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkingProcessesServiceServer will
+// result in compilation errors.
+type UnsafeWorkingProcessesServiceServer interface {
+	mustEmbedUnimplementedWorkingProcessesServiceServer()
+}
+
+func RegisterWorkingProcessesServiceServer(s grpc.ServiceRegistrar, srv WorkingProcessesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkingProcessesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkingProcessesService_ServiceDesc, srv)
+}
+
+func _WorkingProcessesService_GetWorkingProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingProcessesServiceServer).GetWorkingProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingProcessesService_GetWorkingProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingProcessesServiceServer).GetWorkingProcesses(ctx, req.(*GetWorkingProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingProcessesService_GetServerWorkingProcesses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerWorkingProcessesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingProcessesServiceServer).GetServerWorkingProcesses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingProcessesService_GetServerWorkingProcesses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingProcessesServiceServer).GetServerWorkingProcesses(ctx, req.(*GetServerWorkingProcessesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingProcessesService_GetWorkingProcessInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingProcessInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingProcessesServiceServer).GetWorkingProcessInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingProcessesService_GetWorkingProcessInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingProcessesServiceServer).GetWorkingProcessInfo(ctx, req.(*GetWorkingProcessInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkingProcessesService_ServiceDesc is the grpc.ServiceDesc for WorkingProcessesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkingProcessesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.WorkingProcessesService",
+	HandlerType: (*WorkingProcessesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkingProcesses",
+			Handler:    _WorkingProcessesService_GetWorkingProcesses_Handler,
+		},
+		{
+			MethodName: "GetServerWorkingProcesses",
+			Handler:    _WorkingProcessesService_GetServerWorkingProcesses_Handler,
+		},
+		{
+			MethodName: "GetWorkingProcessInfo",
+			Handler:    _WorkingProcessesService_GetWorkingProcessInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/processes.proto",
+}
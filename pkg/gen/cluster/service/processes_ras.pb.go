@@ -0,0 +1,413 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v11 "github.com/v8platform/protos/gen/ras/messages/v1"
+	v1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+)
+
+func (x *ProcessInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.Uuid); err != nil {
+		return err
+	}
+	// decode x.AvgBackCallTime opts: order:2
+	if err := codec256.ParseDouble(reader, &x.AvgBackCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgCallTime opts: order:3
+	if err := codec256.ParseDouble(reader, &x.AvgCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgDbCallTime opts: order:4
+	if err := codec256.ParseDouble(reader, &x.AvgDbCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgLockCallTime opts: order:5
+	if err := codec256.ParseDouble(reader, &x.AvgLockCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgServerCallTime opts: order:6
+	if err := codec256.ParseDouble(reader, &x.AvgServerCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgThreads opts: order:7
+	if err := codec256.ParseDouble(reader, &x.AvgThreads); err != nil {
+		return err
+	}
+	// decode x.Capacity opts: order:8
+	if err := codec256.ParseInt(reader, &x.Capacity); err != nil {
+		return err
+	}
+	// decode x.Connections opts: order:9
+	if err := codec256.ParseInt(reader, &x.Connections); err != nil {
+		return err
+	}
+	// decode x.Host opts: order:10
+	if err := codec256.ParseString(reader, &x.Host); err != nil {
+		return err
+	}
+	// decode x.Enable opts: order:11
+	if err := codec256.ParseBool(reader, &x.Enable); err != nil {
+		return err
+	}
+	// decode x.Licenses opts: order:12
+	var size_Licenses int
+	if err := codec256.ParseSize(reader, &size_Licenses); err != nil {
+		return err
+	}
+	for i := 0; i < size_Licenses; i++ {
+		val := &v1.LicenseInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Licenses = append(x.Licenses, val)
+	}
+	// decode x.Port opts: encoder:"short" order:13
+	if err := codec256.ParseShort(reader, &x.Port); err != nil {
+		return err
+	}
+	// decode x.MemoryExcessTime opts: order:14
+	if err := codec256.ParseInt(reader, &x.MemoryExcessTime); err != nil {
+		return err
+	}
+	// decode x.MemorySize opts: order:15
+	if err := codec256.ParseInt(reader, &x.MemorySize); err != nil {
+		return err
+	}
+	// decode x.Pid opts: order:16
+	if err := codec256.ParseString(reader, &x.Pid); err != nil {
+		return err
+	}
+	// decode x.Running opts: order:17
+	if err := codec256.ParseInt(reader, &x.Running); err != nil {
+		return err
+	}
+	// decode x.SelectionSize opts: order:18
+	if err := codec256.ParseInt(reader, &x.SelectionSize); err != nil {
+		return err
+	}
+	// decode x.StartedAt opts: encoder:"time" order:19
+	x.StartedAt = &timestamppb.Timestamp{}
+	if err := codec256.ParseTime(reader, x.StartedAt); err != nil {
+		return err
+	}
+	// decode x.Use opts: order:20
+	if err := codec256.ParseInt(reader, &x.Use); err != nil {
+		return err
+	}
+	// decode x.AvailablePerformance opts: order:21
+	if err := codec256.ParseInt(reader, &x.AvailablePerformance); err != nil {
+		return err
+	}
+	if version >= 9 {
+		// decode x.Reserve opts: order:22 version:9
+		if err := codec256.ParseBool(reader, &x.Reserve); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *ProcessInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Uuid opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.Uuid); err != nil {
+		return err
+	}
+	// decode x.AvgBackCallTime opts: order:2
+	if err := codec256.FormatDouble(writer, x.AvgBackCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgCallTime opts: order:3
+	if err := codec256.FormatDouble(writer, x.AvgCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgDbCallTime opts: order:4
+	if err := codec256.FormatDouble(writer, x.AvgDbCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgLockCallTime opts: order:5
+	if err := codec256.FormatDouble(writer, x.AvgLockCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgServerCallTime opts: order:6
+	if err := codec256.FormatDouble(writer, x.AvgServerCallTime); err != nil {
+		return err
+	}
+	// decode x.AvgThreads opts: order:7
+	if err := codec256.FormatDouble(writer, x.AvgThreads); err != nil {
+		return err
+	}
+	// decode x.Capacity opts: order:8
+	if err := codec256.FormatInt(writer, x.Capacity); err != nil {
+		return err
+	}
+	// decode x.Connections opts: order:9
+	if err := codec256.FormatInt(writer, x.Connections); err != nil {
+		return err
+	}
+	// decode x.Host opts: order:10
+	if err := codec256.FormatString(writer, x.Host); err != nil {
+		return err
+	}
+	// decode x.Enable opts: order:11
+	if err := codec256.FormatBool(writer, x.Enable); err != nil {
+		return err
+	}
+	// decode x.Licenses opts: order:12
+	if err := codec256.FormatSize(writer, len(x.Licenses)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Licenses); i++ {
+		if err := x.Licenses[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	// decode x.Port opts: encoder:"short" order:13
+	if err := codec256.FormatShort(writer, x.Port); err != nil {
+		return err
+	}
+	// decode x.MemoryExcessTime opts: order:14
+	if err := codec256.FormatInt(writer, x.MemoryExcessTime); err != nil {
+		return err
+	}
+	// decode x.MemorySize opts: order:15
+	if err := codec256.FormatInt(writer, x.MemorySize); err != nil {
+		return err
+	}
+	// decode x.Pid opts: order:16
+	if err := codec256.FormatString(writer, x.Pid); err != nil {
+		return err
+	}
+	// decode x.Running opts: order:17
+	if err := codec256.FormatInt(writer, x.Running); err != nil {
+		return err
+	}
+	// decode x.SelectionSize opts: order:18
+	if err := codec256.FormatInt(writer, x.SelectionSize); err != nil {
+		return err
+	}
+	// decode x.StartedAt opts: encoder:"time" order:19
+	// TODO check nil
+	if err := codec256.FormatTime(writer, x.GetStartedAt().AsTime()); err != nil {
+		return err
+	}
+	// decode x.Use opts: order:20
+	if err := codec256.FormatInt(writer, x.Use); err != nil {
+		return err
+	}
+	// decode x.AvailablePerformance opts: order:21
+	if err := codec256.FormatInt(writer, x.AvailablePerformance); err != nil {
+		return err
+	}
+	if version >= 9 {
+		// decode x.Reserve opts: order:22 version:9
+		if err := codec256.FormatBool(writer, x.Reserve); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetWorkingProcessesRequest) GetMessageType() v11.MessageType {
+	return v11.MessageType_GET_WORKING_PROCESSES_REQUEST
+}
+
+func (x *GetWorkingProcessesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetWorkingProcessesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetWorkingProcessesResponse) GetMessageType() v11.MessageType {
+	return v11.MessageType_GET_WORKING_PROCESSES_RESPONSE
+}
+
+func (x *GetWorkingProcessesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Processes opts: order:1
+	var size_Processes int
+	if err := codec256.ParseSize(reader, &size_Processes); err != nil {
+		return err
+	}
+	for i := 0; i < size_Processes; i++ {
+		val := &ProcessInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Processes = append(x.Processes, val)
+	}
+	return nil
+}
+func (x *GetWorkingProcessesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Processes opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Processes)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Processes); i++ {
+		if err := x.Processes[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetServerWorkingProcessesRequest) GetMessageType() v11.MessageType {
+	return v11.MessageType_GET_SERVER_WORKING_PROCESSES_REQUEST
+}
+
+func (x *GetServerWorkingProcessesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ServerId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetServerWorkingProcessesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ServerId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ServerId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetServerWorkingProcessesResponse) GetMessageType() v11.MessageType {
+	return v11.MessageType_GET_SERVER_WORKING_PROCESSES_RESPONSE
+}
+
+func (x *GetServerWorkingProcessesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Processes opts: order:1
+	var size_Processes int
+	if err := codec256.ParseSize(reader, &size_Processes); err != nil {
+		return err
+	}
+	for i := 0; i < size_Processes; i++ {
+		val := &ProcessInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Processes = append(x.Processes, val)
+	}
+	return nil
+}
+func (x *GetServerWorkingProcessesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Processes opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Processes)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Processes); i++ {
+		if err := x.Processes[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetWorkingProcessInfoRequest) GetMessageType() v11.MessageType {
+	return v11.MessageType_GET_WORKING_PROCESS_INFO_REQUEST
+}
+
+func (x *GetWorkingProcessInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProcessId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.ProcessId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetWorkingProcessInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProcessId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.ProcessId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetWorkingProcessInfoResponse) GetMessageType() v11.MessageType {
+	return v11.MessageType_GET_WORKING_PROCESS_INFO_RESPONSE
+}
+
+func (x *GetWorkingProcessInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &ProcessInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetWorkingProcessInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,1712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/resources.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ResourceCounterGroup группировка значений счетчика
+type ResourceCounterGroup int32
+
+const (
+	// По пользователям (сеансам)
+	ResourceCounterGroup_RESOURCE_COUNTER_GROUP_USERS ResourceCounterGroup = 0
+	// По значениям разделителей (информационным базам)
+	ResourceCounterGroup_RESOURCE_COUNTER_GROUP_DATA_SEPARATION ResourceCounterGroup = 1
+)
+
+// Enum value maps for ResourceCounterGroup.
+var (
+	ResourceCounterGroup_name = map[int32]string{
+		0: "RESOURCE_COUNTER_GROUP_USERS",
+		1: "RESOURCE_COUNTER_GROUP_DATA_SEPARATION",
+	}
+	ResourceCounterGroup_value = map[string]int32{
+		"RESOURCE_COUNTER_GROUP_USERS":           0,
+		"RESOURCE_COUNTER_GROUP_DATA_SEPARATION": 1,
+	}
+)
+
+func (x ResourceCounterGroup) Enum() *ResourceCounterGroup {
+	p := new(ResourceCounterGroup)
+	*p = x
+	return p
+}
+
+func (x ResourceCounterGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceCounterGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_resources_proto_enumTypes[0].Descriptor()
+}
+
+func (ResourceCounterGroup) Type() protoreflect.EnumType {
+	return &file_cluster_service_resources_proto_enumTypes[0]
+}
+
+func (x ResourceCounterGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceCounterGroup.Descriptor instead.
+func (ResourceCounterGroup) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{0}
+}
+
+// ResourceCounterFilterType применение фильтра счетчика
+type ResourceCounterFilterType int32
+
+const (
+	ResourceCounterFilterType_RESOURCE_COUNTER_FILTER_TYPE_ALL_SELECTED     ResourceCounterFilterType = 0
+	ResourceCounterFilterType_RESOURCE_COUNTER_FILTER_TYPE_ALL_BUT_SELECTED ResourceCounterFilterType = 1
+	ResourceCounterFilterType_RESOURCE_COUNTER_FILTER_TYPE_ALL              ResourceCounterFilterType = 2
+)
+
+// Enum value maps for ResourceCounterFilterType.
+var (
+	ResourceCounterFilterType_name = map[int32]string{
+		0: "RESOURCE_COUNTER_FILTER_TYPE_ALL_SELECTED",
+		1: "RESOURCE_COUNTER_FILTER_TYPE_ALL_BUT_SELECTED",
+		2: "RESOURCE_COUNTER_FILTER_TYPE_ALL",
+	}
+	ResourceCounterFilterType_value = map[string]int32{
+		"RESOURCE_COUNTER_FILTER_TYPE_ALL_SELECTED":     0,
+		"RESOURCE_COUNTER_FILTER_TYPE_ALL_BUT_SELECTED": 1,
+		"RESOURCE_COUNTER_FILTER_TYPE_ALL":              2,
+	}
+)
+
+func (x ResourceCounterFilterType) Enum() *ResourceCounterFilterType {
+	p := new(ResourceCounterFilterType)
+	*p = x
+	return p
+}
+
+func (x ResourceCounterFilterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceCounterFilterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_resources_proto_enumTypes[1].Descriptor()
+}
+
+func (ResourceCounterFilterType) Type() protoreflect.EnumType {
+	return &file_cluster_service_resources_proto_enumTypes[1]
+}
+
+func (x ResourceCounterFilterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceCounterFilterType.Descriptor instead.
+func (ResourceCounterFilterType) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{1}
+}
+
+// ResourceLimitAction действие при превышении ограничения
+type ResourceLimitAction int32
+
+const (
+	ResourceLimitAction_RESOURCE_LIMIT_ACTION_NONE                    ResourceLimitAction = 0
+	ResourceLimitAction_RESOURCE_LIMIT_ACTION_SET_LOW_PRIORITY_THREAD ResourceLimitAction = 1
+	ResourceLimitAction_RESOURCE_LIMIT_ACTION_INTERRUPT_CURRENT_CALL  ResourceLimitAction = 2
+	ResourceLimitAction_RESOURCE_LIMIT_ACTION_INTERRUPT_SESSION       ResourceLimitAction = 3
+)
+
+// Enum value maps for ResourceLimitAction.
+var (
+	ResourceLimitAction_name = map[int32]string{
+		0: "RESOURCE_LIMIT_ACTION_NONE",
+		1: "RESOURCE_LIMIT_ACTION_SET_LOW_PRIORITY_THREAD",
+		2: "RESOURCE_LIMIT_ACTION_INTERRUPT_CURRENT_CALL",
+		3: "RESOURCE_LIMIT_ACTION_INTERRUPT_SESSION",
+	}
+	ResourceLimitAction_value = map[string]int32{
+		"RESOURCE_LIMIT_ACTION_NONE":                    0,
+		"RESOURCE_LIMIT_ACTION_SET_LOW_PRIORITY_THREAD": 1,
+		"RESOURCE_LIMIT_ACTION_INTERRUPT_CURRENT_CALL":  2,
+		"RESOURCE_LIMIT_ACTION_INTERRUPT_SESSION":       3,
+	}
+)
+
+func (x ResourceLimitAction) Enum() *ResourceLimitAction {
+	p := new(ResourceLimitAction)
+	*p = x
+	return p
+}
+
+func (x ResourceLimitAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceLimitAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_resources_proto_enumTypes[2].Descriptor()
+}
+
+func (ResourceLimitAction) Type() protoreflect.EnumType {
+	return &file_cluster_service_resources_proto_enumTypes[2]
+}
+
+func (x ResourceLimitAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceLimitAction.Descriptor instead.
+func (ResourceLimitAction) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{2}
+}
+
+// ResourceCounterInfo счетчик потребления ресурсов.
+// Флаги определяют, какие показатели собирает счетчик.
+type ResourceCounterInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Интервал сбора, секунды
+	CollectionTime         int64                     `protobuf:"varint,2,opt,name=collection_time,json=collectionTime,proto3" json:"collection_time,omitempty"`
+	Group                  ResourceCounterGroup      `protobuf:"varint,3,opt,name=group,proto3,enum=cluster.service.ResourceCounterGroup" json:"group,omitempty"`
+	FilterType             ResourceCounterFilterType `protobuf:"varint,4,opt,name=filter_type,json=filterType,proto3,enum=cluster.service.ResourceCounterFilterType" json:"filter_type,omitempty"`
+	Filter                 string                    `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Duration               bool                      `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	CpuTime                bool                      `protobuf:"varint,7,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	Memory                 bool                      `protobuf:"varint,8,opt,name=memory,proto3" json:"memory,omitempty"`
+	Read                   bool                      `protobuf:"varint,9,opt,name=read,proto3" json:"read,omitempty"`
+	Write                  bool                      `protobuf:"varint,10,opt,name=write,proto3" json:"write,omitempty"`
+	DurationDbms           bool                      `protobuf:"varint,11,opt,name=duration_dbms,json=durationDbms,proto3" json:"duration_dbms,omitempty"`
+	DbmsBytes              bool                      `protobuf:"varint,12,opt,name=dbms_bytes,json=dbmsBytes,proto3" json:"dbms_bytes,omitempty"`
+	Service                bool                      `protobuf:"varint,13,opt,name=service,proto3" json:"service,omitempty"`
+	Call                   bool                      `protobuf:"varint,14,opt,name=call,proto3" json:"call,omitempty"`
+	NumberOfActiveSessions bool                      `protobuf:"varint,15,opt,name=number_of_active_sessions,json=numberOfActiveSessions,proto3" json:"number_of_active_sessions,omitempty"`
+	NumberOfSessions       bool                      `protobuf:"varint,16,opt,name=number_of_sessions,json=numberOfSessions,proto3" json:"number_of_sessions,omitempty"`
+	Descr                  string                    `protobuf:"bytes,17,opt,name=descr,proto3" json:"descr,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ResourceCounterInfo) Reset() {
+	*x = ResourceCounterInfo{}
+	mi := &file_cluster_service_resources_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceCounterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceCounterInfo) ProtoMessage() {}
+
+func (x *ResourceCounterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceCounterInfo.ProtoReflect.Descriptor instead.
+func (*ResourceCounterInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResourceCounterInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceCounterInfo) GetCollectionTime() int64 {
+	if x != nil {
+		return x.CollectionTime
+	}
+	return 0
+}
+
+func (x *ResourceCounterInfo) GetGroup() ResourceCounterGroup {
+	if x != nil {
+		return x.Group
+	}
+	return ResourceCounterGroup_RESOURCE_COUNTER_GROUP_USERS
+}
+
+func (x *ResourceCounterInfo) GetFilterType() ResourceCounterFilterType {
+	if x != nil {
+		return x.FilterType
+	}
+	return ResourceCounterFilterType_RESOURCE_COUNTER_FILTER_TYPE_ALL_SELECTED
+}
+
+func (x *ResourceCounterInfo) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ResourceCounterInfo) GetDuration() bool {
+	if x != nil {
+		return x.Duration
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetCpuTime() bool {
+	if x != nil {
+		return x.CpuTime
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetMemory() bool {
+	if x != nil {
+		return x.Memory
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetWrite() bool {
+	if x != nil {
+		return x.Write
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetDurationDbms() bool {
+	if x != nil {
+		return x.DurationDbms
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetDbmsBytes() bool {
+	if x != nil {
+		return x.DbmsBytes
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetService() bool {
+	if x != nil {
+		return x.Service
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetCall() bool {
+	if x != nil {
+		return x.Call
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetNumberOfActiveSessions() bool {
+	if x != nil {
+		return x.NumberOfActiveSessions
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetNumberOfSessions() bool {
+	if x != nil {
+		return x.NumberOfSessions
+	}
+	return false
+}
+
+func (x *ResourceCounterInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+// ResourceLimitInfo ограничение потребления ресурсов по счетчику.
+// Нулевое значение показателя - без ограничения.
+type ResourceLimitInfo struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Counter                string                 `protobuf:"bytes,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	Action                 ResourceLimitAction    `protobuf:"varint,3,opt,name=action,proto3,enum=cluster.service.ResourceLimitAction" json:"action,omitempty"`
+	Duration               int64                  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	CpuTime                int64                  `protobuf:"varint,5,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	Memory                 int64                  `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"`
+	Read                   int64                  `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	Write                  int64                  `protobuf:"varint,8,opt,name=write,proto3" json:"write,omitempty"`
+	DurationDbms           int64                  `protobuf:"varint,9,opt,name=duration_dbms,json=durationDbms,proto3" json:"duration_dbms,omitempty"`
+	DbmsBytes              int64                  `protobuf:"varint,10,opt,name=dbms_bytes,json=dbmsBytes,proto3" json:"dbms_bytes,omitempty"`
+	Service                int64                  `protobuf:"varint,11,opt,name=service,proto3" json:"service,omitempty"`
+	Call                   int64                  `protobuf:"varint,12,opt,name=call,proto3" json:"call,omitempty"`
+	NumberOfActiveSessions int64                  `protobuf:"varint,13,opt,name=number_of_active_sessions,json=numberOfActiveSessions,proto3" json:"number_of_active_sessions,omitempty"`
+	NumberOfSessions       int64                  `protobuf:"varint,14,opt,name=number_of_sessions,json=numberOfSessions,proto3" json:"number_of_sessions,omitempty"`
+	ErrorMessage           string                 `protobuf:"bytes,15,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Descr                  string                 `protobuf:"bytes,16,opt,name=descr,proto3" json:"descr,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ResourceLimitInfo) Reset() {
+	*x = ResourceLimitInfo{}
+	mi := &file_cluster_service_resources_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceLimitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimitInfo) ProtoMessage() {}
+
+func (x *ResourceLimitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimitInfo.ProtoReflect.Descriptor instead.
+func (*ResourceLimitInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResourceLimitInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResourceLimitInfo) GetCounter() string {
+	if x != nil {
+		return x.Counter
+	}
+	return ""
+}
+
+func (x *ResourceLimitInfo) GetAction() ResourceLimitAction {
+	if x != nil {
+		return x.Action
+	}
+	return ResourceLimitAction_RESOURCE_LIMIT_ACTION_NONE
+}
+
+func (x *ResourceLimitInfo) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetCpuTime() int64 {
+	if x != nil {
+		return x.CpuTime
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetRead() int64 {
+	if x != nil {
+		return x.Read
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetWrite() int64 {
+	if x != nil {
+		return x.Write
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetDurationDbms() int64 {
+	if x != nil {
+		return x.DurationDbms
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetDbmsBytes() int64 {
+	if x != nil {
+		return x.DbmsBytes
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetService() int64 {
+	if x != nil {
+		return x.Service
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetCall() int64 {
+	if x != nil {
+		return x.Call
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetNumberOfActiveSessions() int64 {
+	if x != nil {
+		return x.NumberOfActiveSessions
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetNumberOfSessions() int64 {
+	if x != nil {
+		return x.NumberOfSessions
+	}
+	return 0
+}
+
+func (x *ResourceLimitInfo) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ResourceLimitInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+// CounterValue значения счетчика для объекта группировки
+// (сеанса, пользователя или информационной базы)
+type CounterValue struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Object                 string                 `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	CollectionTime         int64                  `protobuf:"varint,2,opt,name=collection_time,json=collectionTime,proto3" json:"collection_time,omitempty"`
+	Duration               int64                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	CpuTime                int64                  `protobuf:"varint,4,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	Memory                 int64                  `protobuf:"varint,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	Read                   int64                  `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`
+	Write                  int64                  `protobuf:"varint,7,opt,name=write,proto3" json:"write,omitempty"`
+	DurationDbms           int64                  `protobuf:"varint,8,opt,name=duration_dbms,json=durationDbms,proto3" json:"duration_dbms,omitempty"`
+	DbmsBytes              int64                  `protobuf:"varint,9,opt,name=dbms_bytes,json=dbmsBytes,proto3" json:"dbms_bytes,omitempty"`
+	Service                int64                  `protobuf:"varint,10,opt,name=service,proto3" json:"service,omitempty"`
+	Call                   int64                  `protobuf:"varint,11,opt,name=call,proto3" json:"call,omitempty"`
+	NumberOfActiveSessions int64                  `protobuf:"varint,12,opt,name=number_of_active_sessions,json=numberOfActiveSessions,proto3" json:"number_of_active_sessions,omitempty"`
+	NumberOfSessions       int64                  `protobuf:"varint,13,opt,name=number_of_sessions,json=numberOfSessions,proto3" json:"number_of_sessions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CounterValue) Reset() {
+	*x = CounterValue{}
+	mi := &file_cluster_service_resources_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CounterValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CounterValue) ProtoMessage() {}
+
+func (x *CounterValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CounterValue.ProtoReflect.Descriptor instead.
+func (*CounterValue) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CounterValue) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+func (x *CounterValue) GetCollectionTime() int64 {
+	if x != nil {
+		return x.CollectionTime
+	}
+	return 0
+}
+
+func (x *CounterValue) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CounterValue) GetCpuTime() int64 {
+	if x != nil {
+		return x.CpuTime
+	}
+	return 0
+}
+
+func (x *CounterValue) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *CounterValue) GetRead() int64 {
+	if x != nil {
+		return x.Read
+	}
+	return 0
+}
+
+func (x *CounterValue) GetWrite() int64 {
+	if x != nil {
+		return x.Write
+	}
+	return 0
+}
+
+func (x *CounterValue) GetDurationDbms() int64 {
+	if x != nil {
+		return x.DurationDbms
+	}
+	return 0
+}
+
+func (x *CounterValue) GetDbmsBytes() int64 {
+	if x != nil {
+		return x.DbmsBytes
+	}
+	return 0
+}
+
+func (x *CounterValue) GetService() int64 {
+	if x != nil {
+		return x.Service
+	}
+	return 0
+}
+
+func (x *CounterValue) GetCall() int64 {
+	if x != nil {
+		return x.Call
+	}
+	return 0
+}
+
+func (x *CounterValue) GetNumberOfActiveSessions() int64 {
+	if x != nil {
+		return x.NumberOfActiveSessions
+	}
+	return 0
+}
+
+func (x *CounterValue) GetNumberOfSessions() int64 {
+	if x != nil {
+		return x.NumberOfSessions
+	}
+	return 0
+}
+
+type GetResourceCountersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceCountersRequest) Reset() {
+	*x = GetResourceCountersRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceCountersRequest) ProtoMessage() {}
+
+func (x *GetResourceCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceCountersRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceCountersRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetResourceCountersRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetResourceCountersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counters      []*ResourceCounterInfo `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceCountersResponse) Reset() {
+	*x = GetResourceCountersResponse{}
+	mi := &file_cluster_service_resources_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceCountersResponse) ProtoMessage() {}
+
+func (x *GetResourceCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceCountersResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceCountersResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetResourceCountersResponse) GetCounters() []*ResourceCounterInfo {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type GetResourceCounterInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceCounterInfoRequest) Reset() {
+	*x = GetResourceCounterInfoRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceCounterInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceCounterInfoRequest) ProtoMessage() {}
+
+func (x *GetResourceCounterInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceCounterInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceCounterInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetResourceCounterInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetResourceCounterInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetResourceCounterInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Counter       *ResourceCounterInfo   `protobuf:"bytes,1,opt,name=counter,proto3" json:"counter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceCounterInfoResponse) Reset() {
+	*x = GetResourceCounterInfoResponse{}
+	mi := &file_cluster_service_resources_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceCounterInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceCounterInfoResponse) ProtoMessage() {}
+
+func (x *GetResourceCounterInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceCounterInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceCounterInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetResourceCounterInfoResponse) GetCounter() *ResourceCounterInfo {
+	if x != nil {
+		return x.Counter
+	}
+	return nil
+}
+
+// RegResourceCounterRequest создает счетчик или заменяет счетчик с тем же именем
+type RegResourceCounterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Counter       *ResourceCounterInfo   `protobuf:"bytes,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegResourceCounterRequest) Reset() {
+	*x = RegResourceCounterRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegResourceCounterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegResourceCounterRequest) ProtoMessage() {}
+
+func (x *RegResourceCounterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegResourceCounterRequest.ProtoReflect.Descriptor instead.
+func (*RegResourceCounterRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegResourceCounterRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *RegResourceCounterRequest) GetCounter() *ResourceCounterInfo {
+	if x != nil {
+		return x.Counter
+	}
+	return nil
+}
+
+type UnregResourceCounterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregResourceCounterRequest) Reset() {
+	*x = UnregResourceCounterRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregResourceCounterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregResourceCounterRequest) ProtoMessage() {}
+
+func (x *UnregResourceCounterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregResourceCounterRequest.ProtoReflect.Descriptor instead.
+func (*UnregResourceCounterRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnregResourceCounterRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UnregResourceCounterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetResourceLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceLimitsRequest) Reset() {
+	*x = GetResourceLimitsRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceLimitsRequest) ProtoMessage() {}
+
+func (x *GetResourceLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetResourceLimitsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetResourceLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        []*ResourceLimitInfo   `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceLimitsResponse) Reset() {
+	*x = GetResourceLimitsResponse{}
+	mi := &file_cluster_service_resources_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceLimitsResponse) ProtoMessage() {}
+
+func (x *GetResourceLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetResourceLimitsResponse) GetLimits() []*ResourceLimitInfo {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type GetResourceLimitInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceLimitInfoRequest) Reset() {
+	*x = GetResourceLimitInfoRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceLimitInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceLimitInfoRequest) ProtoMessage() {}
+
+func (x *GetResourceLimitInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceLimitInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceLimitInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetResourceLimitInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetResourceLimitInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetResourceLimitInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *ResourceLimitInfo     `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceLimitInfoResponse) Reset() {
+	*x = GetResourceLimitInfoResponse{}
+	mi := &file_cluster_service_resources_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceLimitInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceLimitInfoResponse) ProtoMessage() {}
+
+func (x *GetResourceLimitInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResourceLimitInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceLimitInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetResourceLimitInfoResponse) GetLimit() *ResourceLimitInfo {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+// RegResourceLimitRequest создает ограничение или заменяет ограничение с тем же именем
+type RegResourceLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Limit         *ResourceLimitInfo     `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegResourceLimitRequest) Reset() {
+	*x = RegResourceLimitRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegResourceLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegResourceLimitRequest) ProtoMessage() {}
+
+func (x *RegResourceLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegResourceLimitRequest.ProtoReflect.Descriptor instead.
+func (*RegResourceLimitRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RegResourceLimitRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *RegResourceLimitRequest) GetLimit() *ResourceLimitInfo {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+type UnregResourceLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregResourceLimitRequest) Reset() {
+	*x = UnregResourceLimitRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregResourceLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregResourceLimitRequest) ProtoMessage() {}
+
+func (x *UnregResourceLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregResourceLimitRequest.ProtoReflect.Descriptor instead.
+func (*UnregResourceLimitRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnregResourceLimitRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UnregResourceLimitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetCounterValuesRequest текущие значения счетчика,
+// object отбирает один объект группировки (пусто - все)
+type GetCounterValuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Counter       string                 `protobuf:"bytes,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	Object        string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCounterValuesRequest) Reset() {
+	*x = GetCounterValuesRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCounterValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterValuesRequest) ProtoMessage() {}
+
+func (x *GetCounterValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterValuesRequest.ProtoReflect.Descriptor instead.
+func (*GetCounterValuesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCounterValuesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetCounterValuesRequest) GetCounter() string {
+	if x != nil {
+		return x.Counter
+	}
+	return ""
+}
+
+func (x *GetCounterValuesRequest) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type GetCounterValuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*CounterValue        `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCounterValuesResponse) Reset() {
+	*x = GetCounterValuesResponse{}
+	mi := &file_cluster_service_resources_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCounterValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterValuesResponse) ProtoMessage() {}
+
+func (x *GetCounterValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterValuesResponse.ProtoReflect.Descriptor instead.
+func (*GetCounterValuesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCounterValuesResponse) GetValues() []*CounterValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// GetCounterAccumulatedValuesRequest накопленные значения счетчика
+type GetCounterAccumulatedValuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Counter       string                 `protobuf:"bytes,2,opt,name=counter,proto3" json:"counter,omitempty"`
+	Object        string                 `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCounterAccumulatedValuesRequest) Reset() {
+	*x = GetCounterAccumulatedValuesRequest{}
+	mi := &file_cluster_service_resources_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCounterAccumulatedValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterAccumulatedValuesRequest) ProtoMessage() {}
+
+func (x *GetCounterAccumulatedValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterAccumulatedValuesRequest.ProtoReflect.Descriptor instead.
+func (*GetCounterAccumulatedValuesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCounterAccumulatedValuesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetCounterAccumulatedValuesRequest) GetCounter() string {
+	if x != nil {
+		return x.Counter
+	}
+	return ""
+}
+
+func (x *GetCounterAccumulatedValuesRequest) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type GetCounterAccumulatedValuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []*CounterValue        `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCounterAccumulatedValuesResponse) Reset() {
+	*x = GetCounterAccumulatedValuesResponse{}
+	mi := &file_cluster_service_resources_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCounterAccumulatedValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCounterAccumulatedValuesResponse) ProtoMessage() {}
+
+func (x *GetCounterAccumulatedValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_resources_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCounterAccumulatedValuesResponse.ProtoReflect.Descriptor instead.
+func (*GetCounterAccumulatedValuesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_resources_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCounterAccumulatedValuesResponse) GetValues() []*CounterValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_cluster_service_resources_proto protoreflect.FileDescriptor
+
+const file_cluster_service_resources_proto_rawDesc = "" +
+	"\n" +
+	"\x1fcluster/service/resources.proto\x12\x0fcluster.service\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"\x92\x06\n" +
+	"\x13ResourceCounterInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x121\n" +
+	"\x0fcollection_time\x18\x02 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x0ecollectionTime\x12J\n" +
+	"\x05group\x18\x03 \x01(\x0e2%.cluster.service.ResourceCounterGroupB\r\x82\xf5\xea\x94\x0e\a\n" +
+	"\x03int\x10\x03R\x05group\x12Z\n" +
+	"\vfilter_type\x18\x04 \x01(\x0e2*.cluster.service.ResourceCounterFilterTypeB\r\x82\xf5\xea\x94\x0e\a\n" +
+	"\x03int\x10\x04R\n" +
+	"filterType\x12 \n" +
+	"\x06filter\x18\x05 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x06filter\x12$\n" +
+	"\bduration\x18\x06 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\bduration\x12#\n" +
+	"\bcpu_time\x18\a \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\aR\acpuTime\x12 \n" +
+	"\x06memory\x18\b \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\bR\x06memory\x12\x1c\n" +
+	"\x04read\x18\t \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\tR\x04read\x12\x1e\n" +
+	"\x05write\x18\n" +
+	" \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\n" +
+	"R\x05write\x12-\n" +
+	"\rduration_dbms\x18\v \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\vR\fdurationDbms\x12'\n" +
+	"\n" +
+	"dbms_bytes\x18\f \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\fR\tdbmsBytes\x12\"\n" +
+	"\aservice\x18\r \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\rR\aservice\x12\x1c\n" +
+	"\x04call\x18\x0e \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x0eR\x04call\x12C\n" +
+	"\x19number_of_active_sessions\x18\x0f \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x0fR\x16numberOfActiveSessions\x126\n" +
+	"\x12number_of_sessions\x18\x10 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x10R\x10numberOfSessions\x12\x1e\n" +
+	"\x05descr\x18\x11 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x11R\x05descr\"\xb3\x05\n" +
+	"\x11ResourceLimitInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\"\n" +
+	"\acounter\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\acounter\x12K\n" +
+	"\x06action\x18\x03 \x01(\x0e2$.cluster.service.ResourceLimitActionB\r\x82\xf5\xea\x94\x0e\a\n" +
+	"\x03int\x10\x03R\x06action\x12$\n" +
+	"\bduration\x18\x04 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\bduration\x12#\n" +
+	"\bcpu_time\x18\x05 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\acpuTime\x12 \n" +
+	"\x06memory\x18\x06 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x06memory\x12\x1c\n" +
+	"\x04read\x18\a \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\aR\x04read\x12\x1e\n" +
+	"\x05write\x18\b \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\bR\x05write\x12-\n" +
+	"\rduration_dbms\x18\t \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\tR\fdurationDbms\x12'\n" +
+	"\n" +
+	"dbms_bytes\x18\n" +
+	" \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\n" +
+	"R\tdbmsBytes\x12\"\n" +
+	"\aservice\x18\v \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\vR\aservice\x12\x1c\n" +
+	"\x04call\x18\f \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\fR\x04call\x12C\n" +
+	"\x19number_of_active_sessions\x18\r \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\rR\x16numberOfActiveSessions\x126\n" +
+	"\x12number_of_sessions\x18\x0e \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x0eR\x10numberOfSessions\x12-\n" +
+	"\rerror_message\x18\x0f \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x0fR\ferrorMessage\x12\x1e\n" +
+	"\x05descr\x18\x10 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x10R\x05descr\"\xa5\x04\n" +
+	"\fCounterValue\x12 \n" +
+	"\x06object\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06object\x121\n" +
+	"\x0fcollection_time\x18\x02 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x0ecollectionTime\x12$\n" +
+	"\bduration\x18\x03 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\bduration\x12#\n" +
+	"\bcpu_time\x18\x04 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\acpuTime\x12 \n" +
+	"\x06memory\x18\x05 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x06memory\x12\x1c\n" +
+	"\x04read\x18\x06 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x04read\x12\x1e\n" +
+	"\x05write\x18\a \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\aR\x05write\x12-\n" +
+	"\rduration_dbms\x18\b \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\bR\fdurationDbms\x12'\n" +
+	"\n" +
+	"dbms_bytes\x18\t \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\tR\tdbmsBytes\x12\"\n" +
+	"\aservice\x18\n" +
+	" \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\n" +
+	"R\aservice\x12\x1c\n" +
+	"\x04call\x18\v \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\vR\x04call\x12C\n" +
+	"\x19number_of_active_sessions\x18\f \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\fR\x16numberOfActiveSessions\x126\n" +
+	"\x12number_of_sessions\x18\r \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\rR\x10numberOfSessions\"r\n" +
+	"\x1aGetResourceCountersRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_RESOURCE_COUNTERS_REQUEST\"\x91\x01\n" +
+	"\x1bGetResourceCountersResponse\x12J\n" +
+	"\bcounters\x18\x01 \x03(\v2$.cluster.service.ResourceCounterInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\bcounters:&\x8a\xf5\xea\x94\x0e :\x1eGET_RESOURCE_COUNTERS_RESPONSE\"\x97\x01\n" +
+	"\x1dGetResourceCounterInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04name:)\x8a\xf5\xea\x94\x0e#:!GET_RESOURCE_COUNTER_INFO_REQUEST\"\x96\x01\n" +
+	"\x1eGetResourceCounterInfoResponse\x12H\n" +
+	"\acounter\x18\x01 \x01(\v2$.cluster.service.ResourceCounterInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\acounter:*\x8a\xf5\xea\x94\x0e$:\"GET_RESOURCE_COUNTER_INFO_RESPONSE\"\xba\x01\n" +
+	"\x19RegResourceCounterRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12H\n" +
+	"\acounter\x18\x02 \x01(\v2$.cluster.service.ResourceCounterInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\acounter:$\x8a\xf5\xea\x94\x0e\x1e:\x1cREG_RESOURCE_COUNTER_REQUEST\"\x92\x01\n" +
+	"\x1bUnregResourceCounterRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04name:&\x8a\xf5\xea\x94\x0e :\x1eUNREG_RESOURCE_COUNTER_REQUEST\"n\n" +
+	"\x18GetResourceLimitsRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:#\x8a\xf5\xea\x94\x0e\x1d:\x1bGET_RESOURCE_LIMITS_REQUEST\"\x87\x01\n" +
+	"\x19GetResourceLimitsResponse\x12D\n" +
+	"\x06limits\x18\x01 \x03(\v2\".cluster.service.ResourceLimitInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06limits:$\x8a\xf5\xea\x94\x0e\x1e:\x1cGET_RESOURCE_LIMITS_RESPONSE\"\x93\x01\n" +
+	"\x1bGetResourceLimitInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04name:'\x8a\xf5\xea\x94\x0e!:\x1fGET_RESOURCE_LIMIT_INFO_REQUEST\"\x8c\x01\n" +
+	"\x1cGetResourceLimitInfoResponse\x12B\n" +
+	"\x05limit\x18\x01 \x01(\v2\".cluster.service.ResourceLimitInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05limit:(\x8a\xf5\xea\x94\x0e\": GET_RESOURCE_LIMIT_INFO_RESPONSE\"\xb0\x01\n" +
+	"\x17RegResourceLimitRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12B\n" +
+	"\x05limit\x18\x02 \x01(\v2\".cluster.service.ResourceLimitInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05limit:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aREG_RESOURCE_LIMIT_REQUEST\"\x8e\x01\n" +
+	"\x19UnregResourceLimitRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04name:$\x8a\xf5\xea\x94\x0e\x1e:\x1cUNREG_RESOURCE_LIMIT_REQUEST\"\xb2\x01\n" +
+	"\x17GetCounterValuesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\"\n" +
+	"\acounter\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\acounter\x12 \n" +
+	"\x06object\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x06object:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aGET_COUNTER_VALUES_REQUEST\"\x80\x01\n" +
+	"\x18GetCounterValuesResponse\x12?\n" +
+	"\x06values\x18\x01 \x03(\v2\x1d.cluster.service.CounterValueB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06values:#\x8a\xf5\xea\x94\x0e\x1d:\x1bGET_COUNTER_VALUES_RESPONSE\"\xc9\x01\n" +
+	"\"GetCounterAccumulatedValuesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\"\n" +
+	"\acounter\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\acounter\x12 \n" +
+	"\x06object\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x06object:.\x8a\xf5\xea\x94\x0e(:&GET_COUNTER_ACCUMULATED_VALUES_REQUEST\"\x97\x01\n" +
+	"#GetCounterAccumulatedValuesResponse\x12?\n" +
+	"\x06values\x18\x01 \x03(\v2\x1d.cluster.service.CounterValueB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06values:/\x8a\xf5\xea\x94\x0e):'GET_COUNTER_ACCUMULATED_VALUES_RESPONSE*d\n" +
+	"\x14ResourceCounterGroup\x12 \n" +
+	"\x1cRESOURCE_COUNTER_GROUP_USERS\x10\x00\x12*\n" +
+	"&RESOURCE_COUNTER_GROUP_DATA_SEPARATION\x10\x01*\xa3\x01\n" +
+	"\x19ResourceCounterFilterType\x12-\n" +
+	")RESOURCE_COUNTER_FILTER_TYPE_ALL_SELECTED\x10\x00\x121\n" +
+	"-RESOURCE_COUNTER_FILTER_TYPE_ALL_BUT_SELECTED\x10\x01\x12$\n" +
+	" RESOURCE_COUNTER_FILTER_TYPE_ALL\x10\x02*\xc7\x01\n" +
+	"\x13ResourceLimitAction\x12\x1e\n" +
+	"\x1aRESOURCE_LIMIT_ACTION_NONE\x10\x00\x121\n" +
+	"-RESOURCE_LIMIT_ACTION_SET_LOW_PRIORITY_THREAD\x10\x01\x120\n" +
+	",RESOURCE_LIMIT_ACTION_INTERRUPT_CURRENT_CALL\x10\x02\x12+\n" +
+	"'RESOURCE_LIMIT_ACTION_INTERRUPT_SESSION\x10\x032\xf6\t\n" +
+	"\x10ResourcesService\x12p\n" +
+	"\x13GetResourceCounters\x12+.cluster.service.GetResourceCountersRequest\x1a,.cluster.service.GetResourceCountersResponse\x12y\n" +
+	"\x16GetResourceCounterInfo\x12..cluster.service.GetResourceCounterInfoRequest\x1a/.cluster.service.GetResourceCounterInfoResponse\x12[\n" +
+	"\x15CreateResourceCounter\x12*.cluster.service.RegResourceCounterRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x15UpdateResourceCounter\x12*.cluster.service.RegResourceCounterRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x13DropResourceCounter\x12,.cluster.service.UnregResourceCounterRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
+	"\x11GetResourceLimits\x12).cluster.service.GetResourceLimitsRequest\x1a*.cluster.service.GetResourceLimitsResponse\x12s\n" +
+	"\x14GetResourceLimitInfo\x12,.cluster.service.GetResourceLimitInfoRequest\x1a-.cluster.service.GetResourceLimitInfoResponse\x12W\n" +
+	"\x13CreateResourceLimit\x12(.cluster.service.RegResourceLimitRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x13UpdateResourceLimit\x12(.cluster.service.RegResourceLimitRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
+	"\x11DropResourceLimit\x12*.cluster.service.UnregResourceLimitRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
+	"\x10GetCounterValues\x12(.cluster.service.GetCounterValuesRequest\x1a).cluster.service.GetCounterValuesResponse\x12\x88\x01\n" +
+	"\x1bGetCounterAccumulatedValues\x123.cluster.service.GetCounterAccumulatedValuesRequest\x1a4.cluster.service.GetCounterAccumulatedValuesResponseB\xbd\x01\n" +
+	"\x13com.cluster.serviceB\x0eResourcesProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_resources_proto_rawDescOnce sync.Once
+	file_cluster_service_resources_proto_rawDescData []byte
+)
+
+func file_cluster_service_resources_proto_rawDescGZIP() []byte {
+	file_cluster_service_resources_proto_rawDescOnce.Do(func() {
+		file_cluster_service_resources_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_resources_proto_rawDesc), len(file_cluster_service_resources_proto_rawDesc)))
+	})
+	return file_cluster_service_resources_proto_rawDescData
+}
+
+var file_cluster_service_resources_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_cluster_service_resources_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_cluster_service_resources_proto_goTypes = []any{
+	(ResourceCounterGroup)(0),                   // 0: cluster.service.ResourceCounterGroup
+	(ResourceCounterFilterType)(0),              // 1: cluster.service.ResourceCounterFilterType
+	(ResourceLimitAction)(0),                    // 2: cluster.service.ResourceLimitAction
+	(*ResourceCounterInfo)(nil),                 // 3: cluster.service.ResourceCounterInfo
+	(*ResourceLimitInfo)(nil),                   // 4: cluster.service.ResourceLimitInfo
+	(*CounterValue)(nil),                        // 5: cluster.service.CounterValue
+	(*GetResourceCountersRequest)(nil),          // 6: cluster.service.GetResourceCountersRequest
+	(*GetResourceCountersResponse)(nil),         // 7: cluster.service.GetResourceCountersResponse
+	(*GetResourceCounterInfoRequest)(nil),       // 8: cluster.service.GetResourceCounterInfoRequest
+	(*GetResourceCounterInfoResponse)(nil),      // 9: cluster.service.GetResourceCounterInfoResponse
+	(*RegResourceCounterRequest)(nil),           // 10: cluster.service.RegResourceCounterRequest
+	(*UnregResourceCounterRequest)(nil),         // 11: cluster.service.UnregResourceCounterRequest
+	(*GetResourceLimitsRequest)(nil),            // 12: cluster.service.GetResourceLimitsRequest
+	(*GetResourceLimitsResponse)(nil),           // 13: cluster.service.GetResourceLimitsResponse
+	(*GetResourceLimitInfoRequest)(nil),         // 14: cluster.service.GetResourceLimitInfoRequest
+	(*GetResourceLimitInfoResponse)(nil),        // 15: cluster.service.GetResourceLimitInfoResponse
+	(*RegResourceLimitRequest)(nil),             // 16: cluster.service.RegResourceLimitRequest
+	(*UnregResourceLimitRequest)(nil),           // 17: cluster.service.UnregResourceLimitRequest
+	(*GetCounterValuesRequest)(nil),             // 18: cluster.service.GetCounterValuesRequest
+	(*GetCounterValuesResponse)(nil),            // 19: cluster.service.GetCounterValuesResponse
+	(*GetCounterAccumulatedValuesRequest)(nil),  // 20: cluster.service.GetCounterAccumulatedValuesRequest
+	(*GetCounterAccumulatedValuesResponse)(nil), // 21: cluster.service.GetCounterAccumulatedValuesResponse
+	(*emptypb.Empty)(nil),                       // 22: google.protobuf.Empty
+}
+var file_cluster_service_resources_proto_depIdxs = []int32{
+	0,  // 0: cluster.service.ResourceCounterInfo.group:type_name -> cluster.service.ResourceCounterGroup
+	1,  // 1: cluster.service.ResourceCounterInfo.filter_type:type_name -> cluster.service.ResourceCounterFilterType
+	2,  // 2: cluster.service.ResourceLimitInfo.action:type_name -> cluster.service.ResourceLimitAction
+	3,  // 3: cluster.service.GetResourceCountersResponse.counters:type_name -> cluster.service.ResourceCounterInfo
+	3,  // 4: cluster.service.GetResourceCounterInfoResponse.counter:type_name -> cluster.service.ResourceCounterInfo
+	3,  // 5: cluster.service.RegResourceCounterRequest.counter:type_name -> cluster.service.ResourceCounterInfo
+	4,  // 6: cluster.service.GetResourceLimitsResponse.limits:type_name -> cluster.service.ResourceLimitInfo
+	4,  // 7: cluster.service.GetResourceLimitInfoResponse.limit:type_name -> cluster.service.ResourceLimitInfo
+	4,  // 8: cluster.service.RegResourceLimitRequest.limit:type_name -> cluster.service.ResourceLimitInfo
+	5,  // 9: cluster.service.GetCounterValuesResponse.values:type_name -> cluster.service.CounterValue
+	5,  // 10: cluster.service.GetCounterAccumulatedValuesResponse.values:type_name -> cluster.service.CounterValue
+	6,  // 11: cluster.service.ResourcesService.GetResourceCounters:input_type -> cluster.service.GetResourceCountersRequest
+	8,  // 12: cluster.service.ResourcesService.GetResourceCounterInfo:input_type -> cluster.service.GetResourceCounterInfoRequest
+	10, // 13: cluster.service.ResourcesService.CreateResourceCounter:input_type -> cluster.service.RegResourceCounterRequest
+	10, // 14: cluster.service.ResourcesService.UpdateResourceCounter:input_type -> cluster.service.RegResourceCounterRequest
+	11, // 15: cluster.service.ResourcesService.DropResourceCounter:input_type -> cluster.service.UnregResourceCounterRequest
+	12, // 16: cluster.service.ResourcesService.GetResourceLimits:input_type -> cluster.service.GetResourceLimitsRequest
+	14, // 17: cluster.service.ResourcesService.GetResourceLimitInfo:input_type -> cluster.service.GetResourceLimitInfoRequest
+	16, // 18: cluster.service.ResourcesService.CreateResourceLimit:input_type -> cluster.service.RegResourceLimitRequest
+	16, // 19: cluster.service.ResourcesService.UpdateResourceLimit:input_type -> cluster.service.RegResourceLimitRequest
+	17, // 20: cluster.service.ResourcesService.DropResourceLimit:input_type -> cluster.service.UnregResourceLimitRequest
+	18, // 21: cluster.service.ResourcesService.GetCounterValues:input_type -> cluster.service.GetCounterValuesRequest
+	20, // 22: cluster.service.ResourcesService.GetCounterAccumulatedValues:input_type -> cluster.service.GetCounterAccumulatedValuesRequest
+	7,  // 23: cluster.service.ResourcesService.GetResourceCounters:output_type -> cluster.service.GetResourceCountersResponse
+	9,  // 24: cluster.service.ResourcesService.GetResourceCounterInfo:output_type -> cluster.service.GetResourceCounterInfoResponse
+	22, // 25: cluster.service.ResourcesService.CreateResourceCounter:output_type -> google.protobuf.Empty
+	22, // 26: cluster.service.ResourcesService.UpdateResourceCounter:output_type -> google.protobuf.Empty
+	22, // 27: cluster.service.ResourcesService.DropResourceCounter:output_type -> google.protobuf.Empty
+	13, // 28: cluster.service.ResourcesService.GetResourceLimits:output_type -> cluster.service.GetResourceLimitsResponse
+	15, // 29: cluster.service.ResourcesService.GetResourceLimitInfo:output_type -> cluster.service.GetResourceLimitInfoResponse
+	22, // 30: cluster.service.ResourcesService.CreateResourceLimit:output_type -> google.protobuf.Empty
+	22, // 31: cluster.service.ResourcesService.UpdateResourceLimit:output_type -> google.protobuf.Empty
+	22, // 32: cluster.service.ResourcesService.DropResourceLimit:output_type -> google.protobuf.Empty
+	19, // 33: cluster.service.ResourcesService.GetCounterValues:output_type -> cluster.service.GetCounterValuesResponse
+	21, // 34: cluster.service.ResourcesService.GetCounterAccumulatedValues:output_type -> cluster.service.GetCounterAccumulatedValuesResponse
+	23, // [23:35] is the sub-list for method output_type
+	11, // [11:23] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_resources_proto_init() }
+func file_cluster_service_resources_proto_init() {
+	if File_cluster_service_resources_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_resources_proto_rawDesc), len(file_cluster_service_resources_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_resources_proto_goTypes,
+		DependencyIndexes: file_cluster_service_resources_proto_depIdxs,
+		EnumInfos:         file_cluster_service_resources_proto_enumTypes,
+		MessageInfos:      file_cluster_service_resources_proto_msgTypes,
+	}.Build()
+	File_cluster_service_resources_proto = out.File
+	file_cluster_service_resources_proto_goTypes = nil
+	file_cluster_service_resources_proto_depIdxs = nil
+}
@@ -0,0 +1,556 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/resources.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ResourcesService_GetResourceCounters_FullMethodName         = "/cluster.service.ResourcesService/GetResourceCounters"
+	ResourcesService_GetResourceCounterInfo_FullMethodName      = "/cluster.service.ResourcesService/GetResourceCounterInfo"
+	ResourcesService_CreateResourceCounter_FullMethodName       = "/cluster.service.ResourcesService/CreateResourceCounter"
+	ResourcesService_UpdateResourceCounter_FullMethodName       = "/cluster.service.ResourcesService/UpdateResourceCounter"
+	ResourcesService_DropResourceCounter_FullMethodName         = "/cluster.service.ResourcesService/DropResourceCounter"
+	ResourcesService_GetResourceLimits_FullMethodName           = "/cluster.service.ResourcesService/GetResourceLimits"
+	ResourcesService_GetResourceLimitInfo_FullMethodName        = "/cluster.service.ResourcesService/GetResourceLimitInfo"
+	ResourcesService_CreateResourceLimit_FullMethodName         = "/cluster.service.ResourcesService/CreateResourceLimit"
+	ResourcesService_UpdateResourceLimit_FullMethodName         = "/cluster.service.ResourcesService/UpdateResourceLimit"
+	ResourcesService_DropResourceLimit_FullMethodName           = "/cluster.service.ResourcesService/DropResourceLimit"
+	ResourcesService_GetCounterValues_FullMethodName            = "/cluster.service.ResourcesService/GetCounterValues"
+	ResourcesService_GetCounterAccumulatedValues_FullMethodName = "/cluster.service.ResourcesService/GetCounterAccumulatedValues"
+)
+
+// ResourcesServiceClient is the client API for ResourcesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ResourcesService счетчики и ограничения потребления ресурсов
+type ResourcesServiceClient interface {
+	GetResourceCounters(ctx context.Context, in *GetResourceCountersRequest, opts ...grpc.CallOption) (*GetResourceCountersResponse, error)
+	GetResourceCounterInfo(ctx context.Context, in *GetResourceCounterInfoRequest, opts ...grpc.CallOption) (*GetResourceCounterInfoResponse, error)
+	// CreateResourceCounter создание счетчика, счетчик не должен существовать
+	CreateResourceCounter(ctx context.Context, in *RegResourceCounterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateResourceCounter изменение существующего счетчика
+	UpdateResourceCounter(ctx context.Context, in *RegResourceCounterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropResourceCounter(ctx context.Context, in *UnregResourceCounterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetResourceLimits(ctx context.Context, in *GetResourceLimitsRequest, opts ...grpc.CallOption) (*GetResourceLimitsResponse, error)
+	GetResourceLimitInfo(ctx context.Context, in *GetResourceLimitInfoRequest, opts ...grpc.CallOption) (*GetResourceLimitInfoResponse, error)
+	// CreateResourceLimit создание ограничения, ограничение не должно существовать
+	CreateResourceLimit(ctx context.Context, in *RegResourceLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateResourceLimit изменение существующего ограничения
+	UpdateResourceLimit(ctx context.Context, in *RegResourceLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropResourceLimit(ctx context.Context, in *UnregResourceLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetCounterValues текущие значения счетчика по сеансам или информационным базам
+	GetCounterValues(ctx context.Context, in *GetCounterValuesRequest, opts ...grpc.CallOption) (*GetCounterValuesResponse, error)
+	// GetCounterAccumulatedValues накопленные значения счетчика
+	GetCounterAccumulatedValues(ctx context.Context, in *GetCounterAccumulatedValuesRequest, opts ...grpc.CallOption) (*GetCounterAccumulatedValuesResponse, error)
+}
+
+type resourcesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewResourcesServiceClient(cc grpc.ClientConnInterface) ResourcesServiceClient {
+	return &resourcesServiceClient{cc}
+}
+
+func (c *resourcesServiceClient) GetResourceCounters(ctx context.Context, in *GetResourceCountersRequest, opts ...grpc.CallOption) (*GetResourceCountersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceCountersResponse)
+	err := c.cc.Invoke(ctx, ResourcesService_GetResourceCounters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetResourceCounterInfo(ctx context.Context, in *GetResourceCounterInfoRequest, opts ...grpc.CallOption) (*GetResourceCounterInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceCounterInfoResponse)
+	err := c.cc.Invoke(ctx, ResourcesService_GetResourceCounterInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) CreateResourceCounter(ctx context.Context, in *RegResourceCounterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResourcesService_CreateResourceCounter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) UpdateResourceCounter(ctx context.Context, in *RegResourceCounterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResourcesService_UpdateResourceCounter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) DropResourceCounter(ctx context.Context, in *UnregResourceCounterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResourcesService_DropResourceCounter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetResourceLimits(ctx context.Context, in *GetResourceLimitsRequest, opts ...grpc.CallOption) (*GetResourceLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceLimitsResponse)
+	err := c.cc.Invoke(ctx, ResourcesService_GetResourceLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetResourceLimitInfo(ctx context.Context, in *GetResourceLimitInfoRequest, opts ...grpc.CallOption) (*GetResourceLimitInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceLimitInfoResponse)
+	err := c.cc.Invoke(ctx, ResourcesService_GetResourceLimitInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) CreateResourceLimit(ctx context.Context, in *RegResourceLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResourcesService_CreateResourceLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) UpdateResourceLimit(ctx context.Context, in *RegResourceLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResourcesService_UpdateResourceLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) DropResourceLimit(ctx context.Context, in *UnregResourceLimitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ResourcesService_DropResourceLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetCounterValues(ctx context.Context, in *GetCounterValuesRequest, opts ...grpc.CallOption) (*GetCounterValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCounterValuesResponse)
+	err := c.cc.Invoke(ctx, ResourcesService_GetCounterValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcesServiceClient) GetCounterAccumulatedValues(ctx context.Context, in *GetCounterAccumulatedValuesRequest, opts ...grpc.CallOption) (*GetCounterAccumulatedValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCounterAccumulatedValuesResponse)
+	err := c.cc.Invoke(ctx, ResourcesService_GetCounterAccumulatedValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResourcesServiceServer is the server API for ResourcesService service.
+// All implementations must embed UnimplementedResourcesServiceServer
+// for forward compatibility.
+//
+// ResourcesService счетчики и ограничения потребления ресурсов
+type ResourcesServiceServer interface {
+	GetResourceCounters(context.Context, *GetResourceCountersRequest) (*GetResourceCountersResponse, error)
+	GetResourceCounterInfo(context.Context, *GetResourceCounterInfoRequest) (*GetResourceCounterInfoResponse, error)
+	// CreateResourceCounter создание счетчика, счетчик не должен существовать
+	CreateResourceCounter(context.Context, *RegResourceCounterRequest) (*emptypb.Empty, error)
+	// UpdateResourceCounter изменение существующего счетчика
+	UpdateResourceCounter(context.Context, *RegResourceCounterRequest) (*emptypb.Empty, error)
+	DropResourceCounter(context.Context, *UnregResourceCounterRequest) (*emptypb.Empty, error)
+	GetResourceLimits(context.Context, *GetResourceLimitsRequest) (*GetResourceLimitsResponse, error)
+	GetResourceLimitInfo(context.Context, *GetResourceLimitInfoRequest) (*GetResourceLimitInfoResponse, error)
+	// CreateResourceLimit создание ограничения, ограничение не должно существовать
+	CreateResourceLimit(context.Context, *RegResourceLimitRequest) (*emptypb.Empty, error)
+	// UpdateResourceLimit изменение существующего ограничения
+	UpdateResourceLimit(context.Context, *RegResourceLimitRequest) (*emptypb.Empty, error)
+	DropResourceLimit(context.Context, *UnregResourceLimitRequest) (*emptypb.Empty, error)
+	// GetCounterValues текущие значения счетчика по сеансам или информационным базам
+	GetCounterValues(context.Context, *GetCounterValuesRequest) (*GetCounterValuesResponse, error)
+	// GetCounterAccumulatedValues накопленные значения счетчика
+	GetCounterAccumulatedValues(context.Context, *GetCounterAccumulatedValuesRequest) (*GetCounterAccumulatedValuesResponse, error)
+	mustEmbedUnimplementedResourcesServiceServer()
+}
+
+// UnimplementedResourcesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedResourcesServiceServer struct{}
+
+func (UnimplementedResourcesServiceServer) GetResourceCounters(context.Context, *GetResourceCountersRequest) (*GetResourceCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceCounters not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetResourceCounterInfo(context.Context, *GetResourceCounterInfoRequest) (*GetResourceCounterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceCounterInfo not implemented")
+}
+func (UnimplementedResourcesServiceServer) CreateResourceCounter(context.Context, *RegResourceCounterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceCounter not implemented")
+}
+func (UnimplementedResourcesServiceServer) UpdateResourceCounter(context.Context, *RegResourceCounterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResourceCounter not implemented")
+}
+func (UnimplementedResourcesServiceServer) DropResourceCounter(context.Context, *UnregResourceCounterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropResourceCounter not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetResourceLimits(context.Context, *GetResourceLimitsRequest) (*GetResourceLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceLimits not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetResourceLimitInfo(context.Context, *GetResourceLimitInfoRequest) (*GetResourceLimitInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceLimitInfo not implemented")
+}
+func (UnimplementedResourcesServiceServer) CreateResourceLimit(context.Context, *RegResourceLimitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResourceLimit not implemented")
+}
+func (UnimplementedResourcesServiceServer) UpdateResourceLimit(context.Context, *RegResourceLimitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResourceLimit not implemented")
+}
+func (UnimplementedResourcesServiceServer) DropResourceLimit(context.Context, *UnregResourceLimitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropResourceLimit not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetCounterValues(context.Context, *GetCounterValuesRequest) (*GetCounterValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounterValues not implemented")
+}
+func (UnimplementedResourcesServiceServer) GetCounterAccumulatedValues(context.Context, *GetCounterAccumulatedValuesRequest) (*GetCounterAccumulatedValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCounterAccumulatedValues not implemented")
+}
+func (UnimplementedResourcesServiceServer) mustEmbedUnimplementedResourcesServiceServer() {}
+func (UnimplementedResourcesServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeResourcesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ResourcesServiceServer will
+// result in compilation errors.
+type UnsafeResourcesServiceServer interface {
+	mustEmbedUnimplementedResourcesServiceServer()
+}
+
+func RegisterResourcesServiceServer(s grpc.ServiceRegistrar, srv ResourcesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedResourcesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ResourcesService_ServiceDesc, srv)
+}
+
+func _ResourcesService_GetResourceCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourceCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_GetResourceCounters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourceCounters(ctx, req.(*GetResourceCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetResourceCounterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceCounterInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourceCounterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_GetResourceCounterInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourceCounterInfo(ctx, req.(*GetResourceCounterInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_CreateResourceCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegResourceCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).CreateResourceCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_CreateResourceCounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).CreateResourceCounter(ctx, req.(*RegResourceCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_UpdateResourceCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegResourceCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).UpdateResourceCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_UpdateResourceCounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).UpdateResourceCounter(ctx, req.(*RegResourceCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_DropResourceCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregResourceCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).DropResourceCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_DropResourceCounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).DropResourceCounter(ctx, req.(*UnregResourceCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetResourceLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourceLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_GetResourceLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourceLimits(ctx, req.(*GetResourceLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetResourceLimitInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceLimitInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetResourceLimitInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_GetResourceLimitInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetResourceLimitInfo(ctx, req.(*GetResourceLimitInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_CreateResourceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegResourceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).CreateResourceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_CreateResourceLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).CreateResourceLimit(ctx, req.(*RegResourceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_UpdateResourceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegResourceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).UpdateResourceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_UpdateResourceLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).UpdateResourceLimit(ctx, req.(*RegResourceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_DropResourceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregResourceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).DropResourceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_DropResourceLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).DropResourceLimit(ctx, req.(*UnregResourceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetCounterValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCounterValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetCounterValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_GetCounterValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetCounterValues(ctx, req.(*GetCounterValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcesService_GetCounterAccumulatedValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCounterAccumulatedValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcesServiceServer).GetCounterAccumulatedValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourcesService_GetCounterAccumulatedValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcesServiceServer).GetCounterAccumulatedValues(ctx, req.(*GetCounterAccumulatedValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResourcesService_ServiceDesc is the grpc.ServiceDesc for ResourcesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResourcesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.ResourcesService",
+	HandlerType: (*ResourcesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetResourceCounters",
+			Handler:    _ResourcesService_GetResourceCounters_Handler,
+		},
+		{
+			MethodName: "GetResourceCounterInfo",
+			Handler:    _ResourcesService_GetResourceCounterInfo_Handler,
+		},
+		{
+			MethodName: "CreateResourceCounter",
+			Handler:    _ResourcesService_CreateResourceCounter_Handler,
+		},
+		{
+			MethodName: "UpdateResourceCounter",
+			Handler:    _ResourcesService_UpdateResourceCounter_Handler,
+		},
+		{
+			MethodName: "DropResourceCounter",
+			Handler:    _ResourcesService_DropResourceCounter_Handler,
+		},
+		{
+			MethodName: "GetResourceLimits",
+			Handler:    _ResourcesService_GetResourceLimits_Handler,
+		},
+		{
+			MethodName: "GetResourceLimitInfo",
+			Handler:    _ResourcesService_GetResourceLimitInfo_Handler,
+		},
+		{
+			MethodName: "CreateResourceLimit",
+			Handler:    _ResourcesService_CreateResourceLimit_Handler,
+		},
+		{
+			MethodName: "UpdateResourceLimit",
+			Handler:    _ResourcesService_UpdateResourceLimit_Handler,
+		},
+		{
+			MethodName: "DropResourceLimit",
+			Handler:    _ResourcesService_DropResourceLimit_Handler,
+		},
+		{
+			MethodName: "GetCounterValues",
+			Handler:    _ResourcesService_GetCounterValues_Handler,
+		},
+		{
+			MethodName: "GetCounterAccumulatedValues",
+			Handler:    _ResourcesService_GetCounterAccumulatedValues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/resources.proto",
+}
@@ -0,0 +1,951 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	io "io"
+)
+
+func (x *ResourceCounterInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.CollectionTime opts: order:2
+	if err := codec256.ParseLong(reader, &x.CollectionTime); err != nil {
+		return err
+	}
+	// decode x.Group opts: encoder:"int" order:3
+	var val_Group int32
+	if err := codec256.ParseInt(reader, &val_Group); err != nil {
+		return err
+	}
+	x.Group = ResourceCounterGroup(val_Group)
+	// decode x.FilterType opts: encoder:"int" order:4
+	var val_FilterType int32
+	if err := codec256.ParseInt(reader, &val_FilterType); err != nil {
+		return err
+	}
+	x.FilterType = ResourceCounterFilterType(val_FilterType)
+	// decode x.Filter opts: order:5
+	if err := codec256.ParseString(reader, &x.Filter); err != nil {
+		return err
+	}
+	// decode x.Duration opts: order:6
+	if err := codec256.ParseBool(reader, &x.Duration); err != nil {
+		return err
+	}
+	// decode x.CpuTime opts: order:7
+	if err := codec256.ParseBool(reader, &x.CpuTime); err != nil {
+		return err
+	}
+	// decode x.Memory opts: order:8
+	if err := codec256.ParseBool(reader, &x.Memory); err != nil {
+		return err
+	}
+	// decode x.Read opts: order:9
+	if err := codec256.ParseBool(reader, &x.Read); err != nil {
+		return err
+	}
+	// decode x.Write opts: order:10
+	if err := codec256.ParseBool(reader, &x.Write); err != nil {
+		return err
+	}
+	// decode x.DurationDbms opts: order:11
+	if err := codec256.ParseBool(reader, &x.DurationDbms); err != nil {
+		return err
+	}
+	// decode x.DbmsBytes opts: order:12
+	if err := codec256.ParseBool(reader, &x.DbmsBytes); err != nil {
+		return err
+	}
+	// decode x.Service opts: order:13
+	if err := codec256.ParseBool(reader, &x.Service); err != nil {
+		return err
+	}
+	// decode x.Call opts: order:14
+	if err := codec256.ParseBool(reader, &x.Call); err != nil {
+		return err
+	}
+	// decode x.NumberOfActiveSessions opts: order:15
+	if err := codec256.ParseBool(reader, &x.NumberOfActiveSessions); err != nil {
+		return err
+	}
+	// decode x.NumberOfSessions opts: order:16
+	if err := codec256.ParseBool(reader, &x.NumberOfSessions); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:17
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ResourceCounterInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.CollectionTime opts: order:2
+	if err := codec256.FormatLong(writer, x.CollectionTime); err != nil {
+		return err
+	}
+	// decode x.Group opts: encoder:"int" order:3
+	if err := codec256.FormatInt(writer, int32(x.Group)); err != nil {
+		return err
+	}
+	// decode x.FilterType opts: encoder:"int" order:4
+	if err := codec256.FormatInt(writer, int32(x.FilterType)); err != nil {
+		return err
+	}
+	// decode x.Filter opts: order:5
+	if err := codec256.FormatString(writer, x.Filter); err != nil {
+		return err
+	}
+	// decode x.Duration opts: order:6
+	if err := codec256.FormatBool(writer, x.Duration); err != nil {
+		return err
+	}
+	// decode x.CpuTime opts: order:7
+	if err := codec256.FormatBool(writer, x.CpuTime); err != nil {
+		return err
+	}
+	// decode x.Memory opts: order:8
+	if err := codec256.FormatBool(writer, x.Memory); err != nil {
+		return err
+	}
+	// decode x.Read opts: order:9
+	if err := codec256.FormatBool(writer, x.Read); err != nil {
+		return err
+	}
+	// decode x.Write opts: order:10
+	if err := codec256.FormatBool(writer, x.Write); err != nil {
+		return err
+	}
+	// decode x.DurationDbms opts: order:11
+	if err := codec256.FormatBool(writer, x.DurationDbms); err != nil {
+		return err
+	}
+	// decode x.DbmsBytes opts: order:12
+	if err := codec256.FormatBool(writer, x.DbmsBytes); err != nil {
+		return err
+	}
+	// decode x.Service opts: order:13
+	if err := codec256.FormatBool(writer, x.Service); err != nil {
+		return err
+	}
+	// decode x.Call opts: order:14
+	if err := codec256.FormatBool(writer, x.Call); err != nil {
+		return err
+	}
+	// decode x.NumberOfActiveSessions opts: order:15
+	if err := codec256.FormatBool(writer, x.NumberOfActiveSessions); err != nil {
+		return err
+	}
+	// decode x.NumberOfSessions opts: order:16
+	if err := codec256.FormatBool(writer, x.NumberOfSessions); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:17
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ResourceLimitInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := codec256.ParseString(reader, &x.Counter); err != nil {
+		return err
+	}
+	// decode x.Action opts: encoder:"int" order:3
+	var val_Action int32
+	if err := codec256.ParseInt(reader, &val_Action); err != nil {
+		return err
+	}
+	x.Action = ResourceLimitAction(val_Action)
+	// decode x.Duration opts: order:4
+	if err := codec256.ParseLong(reader, &x.Duration); err != nil {
+		return err
+	}
+	// decode x.CpuTime opts: order:5
+	if err := codec256.ParseLong(reader, &x.CpuTime); err != nil {
+		return err
+	}
+	// decode x.Memory opts: order:6
+	if err := codec256.ParseLong(reader, &x.Memory); err != nil {
+		return err
+	}
+	// decode x.Read opts: order:7
+	if err := codec256.ParseLong(reader, &x.Read); err != nil {
+		return err
+	}
+	// decode x.Write opts: order:8
+	if err := codec256.ParseLong(reader, &x.Write); err != nil {
+		return err
+	}
+	// decode x.DurationDbms opts: order:9
+	if err := codec256.ParseLong(reader, &x.DurationDbms); err != nil {
+		return err
+	}
+	// decode x.DbmsBytes opts: order:10
+	if err := codec256.ParseLong(reader, &x.DbmsBytes); err != nil {
+		return err
+	}
+	// decode x.Service opts: order:11
+	if err := codec256.ParseLong(reader, &x.Service); err != nil {
+		return err
+	}
+	// decode x.Call opts: order:12
+	if err := codec256.ParseLong(reader, &x.Call); err != nil {
+		return err
+	}
+	// decode x.NumberOfActiveSessions opts: order:13
+	if err := codec256.ParseLong(reader, &x.NumberOfActiveSessions); err != nil {
+		return err
+	}
+	// decode x.NumberOfSessions opts: order:14
+	if err := codec256.ParseLong(reader, &x.NumberOfSessions); err != nil {
+		return err
+	}
+	// decode x.ErrorMessage opts: order:15
+	if err := codec256.ParseString(reader, &x.ErrorMessage); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:16
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ResourceLimitInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := codec256.FormatString(writer, x.Counter); err != nil {
+		return err
+	}
+	// decode x.Action opts: encoder:"int" order:3
+	if err := codec256.FormatInt(writer, int32(x.Action)); err != nil {
+		return err
+	}
+	// decode x.Duration opts: order:4
+	if err := codec256.FormatLong(writer, x.Duration); err != nil {
+		return err
+	}
+	// decode x.CpuTime opts: order:5
+	if err := codec256.FormatLong(writer, x.CpuTime); err != nil {
+		return err
+	}
+	// decode x.Memory opts: order:6
+	if err := codec256.FormatLong(writer, x.Memory); err != nil {
+		return err
+	}
+	// decode x.Read opts: order:7
+	if err := codec256.FormatLong(writer, x.Read); err != nil {
+		return err
+	}
+	// decode x.Write opts: order:8
+	if err := codec256.FormatLong(writer, x.Write); err != nil {
+		return err
+	}
+	// decode x.DurationDbms opts: order:9
+	if err := codec256.FormatLong(writer, x.DurationDbms); err != nil {
+		return err
+	}
+	// decode x.DbmsBytes opts: order:10
+	if err := codec256.FormatLong(writer, x.DbmsBytes); err != nil {
+		return err
+	}
+	// decode x.Service opts: order:11
+	if err := codec256.FormatLong(writer, x.Service); err != nil {
+		return err
+	}
+	// decode x.Call opts: order:12
+	if err := codec256.FormatLong(writer, x.Call); err != nil {
+		return err
+	}
+	// decode x.NumberOfActiveSessions opts: order:13
+	if err := codec256.FormatLong(writer, x.NumberOfActiveSessions); err != nil {
+		return err
+	}
+	// decode x.NumberOfSessions opts: order:14
+	if err := codec256.FormatLong(writer, x.NumberOfSessions); err != nil {
+		return err
+	}
+	// decode x.ErrorMessage opts: order:15
+	if err := codec256.FormatString(writer, x.ErrorMessage); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:16
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *CounterValue) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Object opts: order:1
+	if err := codec256.ParseString(reader, &x.Object); err != nil {
+		return err
+	}
+	// decode x.CollectionTime opts: order:2
+	if err := codec256.ParseLong(reader, &x.CollectionTime); err != nil {
+		return err
+	}
+	// decode x.Duration opts: order:3
+	if err := codec256.ParseLong(reader, &x.Duration); err != nil {
+		return err
+	}
+	// decode x.CpuTime opts: order:4
+	if err := codec256.ParseLong(reader, &x.CpuTime); err != nil {
+		return err
+	}
+	// decode x.Memory opts: order:5
+	if err := codec256.ParseLong(reader, &x.Memory); err != nil {
+		return err
+	}
+	// decode x.Read opts: order:6
+	if err := codec256.ParseLong(reader, &x.Read); err != nil {
+		return err
+	}
+	// decode x.Write opts: order:7
+	if err := codec256.ParseLong(reader, &x.Write); err != nil {
+		return err
+	}
+	// decode x.DurationDbms opts: order:8
+	if err := codec256.ParseLong(reader, &x.DurationDbms); err != nil {
+		return err
+	}
+	// decode x.DbmsBytes opts: order:9
+	if err := codec256.ParseLong(reader, &x.DbmsBytes); err != nil {
+		return err
+	}
+	// decode x.Service opts: order:10
+	if err := codec256.ParseLong(reader, &x.Service); err != nil {
+		return err
+	}
+	// decode x.Call opts: order:11
+	if err := codec256.ParseLong(reader, &x.Call); err != nil {
+		return err
+	}
+	// decode x.NumberOfActiveSessions opts: order:12
+	if err := codec256.ParseLong(reader, &x.NumberOfActiveSessions); err != nil {
+		return err
+	}
+	// decode x.NumberOfSessions opts: order:13
+	if err := codec256.ParseLong(reader, &x.NumberOfSessions); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *CounterValue) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Object opts: order:1
+	if err := codec256.FormatString(writer, x.Object); err != nil {
+		return err
+	}
+	// decode x.CollectionTime opts: order:2
+	if err := codec256.FormatLong(writer, x.CollectionTime); err != nil {
+		return err
+	}
+	// decode x.Duration opts: order:3
+	if err := codec256.FormatLong(writer, x.Duration); err != nil {
+		return err
+	}
+	// decode x.CpuTime opts: order:4
+	if err := codec256.FormatLong(writer, x.CpuTime); err != nil {
+		return err
+	}
+	// decode x.Memory opts: order:5
+	if err := codec256.FormatLong(writer, x.Memory); err != nil {
+		return err
+	}
+	// decode x.Read opts: order:6
+	if err := codec256.FormatLong(writer, x.Read); err != nil {
+		return err
+	}
+	// decode x.Write opts: order:7
+	if err := codec256.FormatLong(writer, x.Write); err != nil {
+		return err
+	}
+	// decode x.DurationDbms opts: order:8
+	if err := codec256.FormatLong(writer, x.DurationDbms); err != nil {
+		return err
+	}
+	// decode x.DbmsBytes opts: order:9
+	if err := codec256.FormatLong(writer, x.DbmsBytes); err != nil {
+		return err
+	}
+	// decode x.Service opts: order:10
+	if err := codec256.FormatLong(writer, x.Service); err != nil {
+		return err
+	}
+	// decode x.Call opts: order:11
+	if err := codec256.FormatLong(writer, x.Call); err != nil {
+		return err
+	}
+	// decode x.NumberOfActiveSessions opts: order:12
+	if err := codec256.FormatLong(writer, x.NumberOfActiveSessions); err != nil {
+		return err
+	}
+	// decode x.NumberOfSessions opts: order:13
+	if err := codec256.FormatLong(writer, x.NumberOfSessions); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceCountersRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_COUNTERS_REQUEST
+}
+
+func (x *GetResourceCountersRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceCountersRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceCountersResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_COUNTERS_RESPONSE
+}
+
+func (x *GetResourceCountersResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Counters opts: order:1
+	var size_Counters int
+	if err := codec256.ParseSize(reader, &size_Counters); err != nil {
+		return err
+	}
+	for i := 0; i < size_Counters; i++ {
+		val := &ResourceCounterInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Counters = append(x.Counters, val)
+	}
+	return nil
+}
+func (x *GetResourceCountersResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Counters opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Counters)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Counters); i++ {
+		if err := x.Counters[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetResourceCounterInfoRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_COUNTER_INFO_REQUEST
+}
+
+func (x *GetResourceCounterInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceCounterInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceCounterInfoResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_COUNTER_INFO_RESPONSE
+}
+
+func (x *GetResourceCounterInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Counter opts: order:1
+	x.Counter = &ResourceCounterInfo{}
+	if err := x.Counter.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetResourceCounterInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Counter opts: order:1
+	if err := x.Counter.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegResourceCounterRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_REG_RESOURCE_COUNTER_REQUEST
+}
+
+func (x *RegResourceCounterRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	x.Counter = &ResourceCounterInfo{}
+	if err := x.Counter.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *RegResourceCounterRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := x.Counter.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregResourceCounterRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_UNREG_RESOURCE_COUNTER_REQUEST
+}
+
+func (x *UnregResourceCounterRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregResourceCounterRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceLimitsRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_LIMITS_REQUEST
+}
+
+func (x *GetResourceLimitsRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceLimitsRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceLimitsResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_LIMITS_RESPONSE
+}
+
+func (x *GetResourceLimitsResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Limits opts: order:1
+	var size_Limits int
+	if err := codec256.ParseSize(reader, &size_Limits); err != nil {
+		return err
+	}
+	for i := 0; i < size_Limits; i++ {
+		val := &ResourceLimitInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Limits = append(x.Limits, val)
+	}
+	return nil
+}
+func (x *GetResourceLimitsResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Limits opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Limits)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Limits); i++ {
+		if err := x.Limits[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetResourceLimitInfoRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_LIMIT_INFO_REQUEST
+}
+
+func (x *GetResourceLimitInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceLimitInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetResourceLimitInfoResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_RESOURCE_LIMIT_INFO_RESPONSE
+}
+
+func (x *GetResourceLimitInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Limit opts: order:1
+	x.Limit = &ResourceLimitInfo{}
+	if err := x.Limit.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetResourceLimitInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Limit opts: order:1
+	if err := x.Limit.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *RegResourceLimitRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_REG_RESOURCE_LIMIT_REQUEST
+}
+
+func (x *RegResourceLimitRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Limit opts: order:2
+	x.Limit = &ResourceLimitInfo{}
+	if err := x.Limit.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *RegResourceLimitRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Limit opts: order:2
+	if err := x.Limit.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregResourceLimitRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_UNREG_RESOURCE_LIMIT_REQUEST
+}
+
+func (x *UnregResourceLimitRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *UnregResourceLimitRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCounterValuesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_COUNTER_VALUES_REQUEST
+}
+
+func (x *GetCounterValuesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := codec256.ParseString(reader, &x.Counter); err != nil {
+		return err
+	}
+	// decode x.Object opts: order:3
+	if err := codec256.ParseString(reader, &x.Object); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCounterValuesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := codec256.FormatString(writer, x.Counter); err != nil {
+		return err
+	}
+	// decode x.Object opts: order:3
+	if err := codec256.FormatString(writer, x.Object); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCounterValuesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_COUNTER_VALUES_RESPONSE
+}
+
+func (x *GetCounterValuesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Values opts: order:1
+	var size_Values int
+	if err := codec256.ParseSize(reader, &size_Values); err != nil {
+		return err
+	}
+	for i := 0; i < size_Values; i++ {
+		val := &CounterValue{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Values = append(x.Values, val)
+	}
+	return nil
+}
+func (x *GetCounterValuesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Values opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Values)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Values); i++ {
+		if err := x.Values[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *GetCounterAccumulatedValuesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_COUNTER_ACCUMULATED_VALUES_REQUEST
+}
+
+func (x *GetCounterAccumulatedValuesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := codec256.ParseString(reader, &x.Counter); err != nil {
+		return err
+	}
+	// decode x.Object opts: order:3
+	if err := codec256.ParseString(reader, &x.Object); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCounterAccumulatedValuesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Counter opts: order:2
+	if err := codec256.FormatString(writer, x.Counter); err != nil {
+		return err
+	}
+	// decode x.Object opts: order:3
+	if err := codec256.FormatString(writer, x.Object); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCounterAccumulatedValuesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_COUNTER_ACCUMULATED_VALUES_RESPONSE
+}
+
+func (x *GetCounterAccumulatedValuesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Values opts: order:1
+	var size_Values int
+	if err := codec256.ParseSize(reader, &size_Values); err != nil {
+		return err
+	}
+	for i := 0; i < size_Values; i++ {
+		val := &CounterValue{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Values = append(x.Values, val)
+	}
+	return nil
+}
+func (x *GetCounterAccumulatedValuesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Values opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Values)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Values); i++ {
+		if err := x.Values[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
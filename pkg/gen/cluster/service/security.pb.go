@@ -0,0 +1,2471 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/security.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SecurityProfileInfo профиль безопасности кластера.
+// Флаги *_full_access снимают ограничения соответствующего списка.
+type SecurityProfileInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	// Профиль может использоваться как профиль безопасного режима
+	SafeModeProfile bool `protobuf:"varint,3,opt,name=safe_mode_profile,json=safeModeProfile,proto3" json:"safe_mode_profile,omitempty"`
+	// Полный привилегированный режим
+	FullPrivilegedMode bool `protobuf:"varint,4,opt,name=full_privileged_mode,json=fullPrivilegedMode,proto3" json:"full_privileged_mode,omitempty"`
+	// Разрешен привилегированный режим в безопасном режиме
+	PrivilegedModeInSafeModeAllowed bool `protobuf:"varint,5,opt,name=privileged_mode_in_safe_mode_allowed,json=privilegedModeInSafeModeAllowed,proto3" json:"privileged_mode_in_safe_mode_allowed,omitempty"`
+	// Роли, доступные в привилегированном режиме
+	PrivilegedModeRoles             string `protobuf:"bytes,6,opt,name=privileged_mode_roles,json=privilegedModeRoles,proto3" json:"privileged_mode_roles,omitempty"`
+	CryptographyAllowed             bool   `protobuf:"varint,7,opt,name=cryptography_allowed,json=cryptographyAllowed,proto3" json:"cryptography_allowed,omitempty"`
+	RightExtension                  bool   `protobuf:"varint,8,opt,name=right_extension,json=rightExtension,proto3" json:"right_extension,omitempty"`
+	RightExtensionDefinitionRoles   string `protobuf:"bytes,9,opt,name=right_extension_definition_roles,json=rightExtensionDefinitionRoles,proto3" json:"right_extension_definition_roles,omitempty"`
+	AllModulesExtension             bool   `protobuf:"varint,10,opt,name=all_modules_extension,json=allModulesExtension,proto3" json:"all_modules_extension,omitempty"`
+	ModulesAvailableForExtension    string `protobuf:"bytes,11,opt,name=modules_available_for_extension,json=modulesAvailableForExtension,proto3" json:"modules_available_for_extension,omitempty"`
+	ModulesNotAvailableForExtension string `protobuf:"bytes,12,opt,name=modules_not_available_for_extension,json=modulesNotAvailableForExtension,proto3" json:"modules_not_available_for_extension,omitempty"`
+	FileSystemFullAccess            bool   `protobuf:"varint,13,opt,name=file_system_full_access,json=fileSystemFullAccess,proto3" json:"file_system_full_access,omitempty"`
+	ComFullAccess                   bool   `protobuf:"varint,14,opt,name=com_full_access,json=comFullAccess,proto3" json:"com_full_access,omitempty"`
+	AddinFullAccess                 bool   `protobuf:"varint,15,opt,name=addin_full_access,json=addinFullAccess,proto3" json:"addin_full_access,omitempty"`
+	ModuleFullAccess                bool   `protobuf:"varint,16,opt,name=module_full_access,json=moduleFullAccess,proto3" json:"module_full_access,omitempty"`
+	ApplicationFullAccess           bool   `protobuf:"varint,17,opt,name=application_full_access,json=applicationFullAccess,proto3" json:"application_full_access,omitempty"`
+	InternetFullAccess              bool   `protobuf:"varint,18,opt,name=internet_full_access,json=internetFullAccess,proto3" json:"internet_full_access,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
+}
+
+func (x *SecurityProfileInfo) Reset() {
+	*x = SecurityProfileInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityProfileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityProfileInfo) ProtoMessage() {}
+
+func (x *SecurityProfileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityProfileInfo.ProtoReflect.Descriptor instead.
+func (*SecurityProfileInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecurityProfileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecurityProfileInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *SecurityProfileInfo) GetSafeModeProfile() bool {
+	if x != nil {
+		return x.SafeModeProfile
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetFullPrivilegedMode() bool {
+	if x != nil {
+		return x.FullPrivilegedMode
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetPrivilegedModeInSafeModeAllowed() bool {
+	if x != nil {
+		return x.PrivilegedModeInSafeModeAllowed
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetPrivilegedModeRoles() string {
+	if x != nil {
+		return x.PrivilegedModeRoles
+	}
+	return ""
+}
+
+func (x *SecurityProfileInfo) GetCryptographyAllowed() bool {
+	if x != nil {
+		return x.CryptographyAllowed
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetRightExtension() bool {
+	if x != nil {
+		return x.RightExtension
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetRightExtensionDefinitionRoles() string {
+	if x != nil {
+		return x.RightExtensionDefinitionRoles
+	}
+	return ""
+}
+
+func (x *SecurityProfileInfo) GetAllModulesExtension() bool {
+	if x != nil {
+		return x.AllModulesExtension
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetModulesAvailableForExtension() string {
+	if x != nil {
+		return x.ModulesAvailableForExtension
+	}
+	return ""
+}
+
+func (x *SecurityProfileInfo) GetModulesNotAvailableForExtension() string {
+	if x != nil {
+		return x.ModulesNotAvailableForExtension
+	}
+	return ""
+}
+
+func (x *SecurityProfileInfo) GetFileSystemFullAccess() bool {
+	if x != nil {
+		return x.FileSystemFullAccess
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetComFullAccess() bool {
+	if x != nil {
+		return x.ComFullAccess
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetAddinFullAccess() bool {
+	if x != nil {
+		return x.AddinFullAccess
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetModuleFullAccess() bool {
+	if x != nil {
+		return x.ModuleFullAccess
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetApplicationFullAccess() bool {
+	if x != nil {
+		return x.ApplicationFullAccess
+	}
+	return false
+}
+
+func (x *SecurityProfileInfo) GetInternetFullAccess() bool {
+	if x != nil {
+		return x.InternetFullAccess
+	}
+	return false
+}
+
+// VirtualDirectoryInfo разрешенный виртуальный каталог
+type VirtualDirectoryInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alias         string                 `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	Descr         string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	PhysicalPath  string                 `protobuf:"bytes,3,opt,name=physical_path,json=physicalPath,proto3" json:"physical_path,omitempty"`
+	AllowedRead   bool                   `protobuf:"varint,4,opt,name=allowed_read,json=allowedRead,proto3" json:"allowed_read,omitempty"`
+	AllowedWrite  bool                   `protobuf:"varint,5,opt,name=allowed_write,json=allowedWrite,proto3" json:"allowed_write,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualDirectoryInfo) Reset() {
+	*x = VirtualDirectoryInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualDirectoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualDirectoryInfo) ProtoMessage() {}
+
+func (x *VirtualDirectoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualDirectoryInfo.ProtoReflect.Descriptor instead.
+func (*VirtualDirectoryInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VirtualDirectoryInfo) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *VirtualDirectoryInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *VirtualDirectoryInfo) GetPhysicalPath() string {
+	if x != nil {
+		return x.PhysicalPath
+	}
+	return ""
+}
+
+func (x *VirtualDirectoryInfo) GetAllowedRead() bool {
+	if x != nil {
+		return x.AllowedRead
+	}
+	return false
+}
+
+func (x *VirtualDirectoryInfo) GetAllowedWrite() bool {
+	if x != nil {
+		return x.AllowedWrite
+	}
+	return false
+}
+
+// COMClassInfo разрешенный COM-класс
+type COMClassInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr         string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ObjectUuid    string                 `protobuf:"bytes,4,opt,name=object_uuid,json=objectUuid,proto3" json:"object_uuid,omitempty"`
+	ComputerName  string                 `protobuf:"bytes,5,opt,name=computer_name,json=computerName,proto3" json:"computer_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *COMClassInfo) Reset() {
+	*x = COMClassInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *COMClassInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*COMClassInfo) ProtoMessage() {}
+
+func (x *COMClassInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use COMClassInfo.ProtoReflect.Descriptor instead.
+func (*COMClassInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *COMClassInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *COMClassInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *COMClassInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *COMClassInfo) GetObjectUuid() string {
+	if x != nil {
+		return x.ObjectUuid
+	}
+	return ""
+}
+
+func (x *COMClassInfo) GetComputerName() string {
+	if x != nil {
+		return x.ComputerName
+	}
+	return ""
+}
+
+// AddinInfo разрешенная внешняя компонента
+type AddinInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	// Контрольная сумма компоненты (base64 SHA-1)
+	Hash          string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddinInfo) Reset() {
+	*x = AddinInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddinInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddinInfo) ProtoMessage() {}
+
+func (x *AddinInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddinInfo.ProtoReflect.Descriptor instead.
+func (*AddinInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddinInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddinInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *AddinInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// ExternalModuleInfo разрешенный внешний модуль (отчет, обработка)
+type ExternalModuleInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	// Контрольная сумма модуля (base64 SHA-1)
+	Hash          string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalModuleInfo) Reset() {
+	*x = ExternalModuleInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalModuleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalModuleInfo) ProtoMessage() {}
+
+func (x *ExternalModuleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalModuleInfo.ProtoReflect.Descriptor instead.
+func (*ExternalModuleInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExternalModuleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExternalModuleInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ExternalModuleInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// ApplicationInfo разрешенное приложение операционной системы
+type ApplicationInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	// Шаблон командной строки
+	Wild          string `protobuf:"bytes,3,opt,name=wild,proto3" json:"wild,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplicationInfo) Reset() {
+	*x = ApplicationInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationInfo) ProtoMessage() {}
+
+func (x *ApplicationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationInfo.ProtoReflect.Descriptor instead.
+func (*ApplicationInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApplicationInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplicationInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *ApplicationInfo) GetWild() string {
+	if x != nil {
+		return x.Wild
+	}
+	return ""
+}
+
+// InternetResourceInfo разрешенный интернет-ресурс
+type InternetResourceInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Descr         string                 `protobuf:"bytes,2,opt,name=descr,proto3" json:"descr,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Port          int32                  `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternetResourceInfo) Reset() {
+	*x = InternetResourceInfo{}
+	mi := &file_cluster_service_security_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternetResourceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternetResourceInfo) ProtoMessage() {}
+
+func (x *InternetResourceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternetResourceInfo.ProtoReflect.Descriptor instead.
+func (*InternetResourceInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InternetResourceInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternetResourceInfo) GetDescr() string {
+	if x != nil {
+		return x.Descr
+	}
+	return ""
+}
+
+func (x *InternetResourceInfo) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *InternetResourceInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InternetResourceInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type GetSecurityProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityProfilesRequest) Reset() {
+	*x = GetSecurityProfilesRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityProfilesRequest) ProtoMessage() {}
+
+func (x *GetSecurityProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityProfilesRequest.ProtoReflect.Descriptor instead.
+func (*GetSecurityProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSecurityProfilesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetSecurityProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*SecurityProfileInfo `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecurityProfilesResponse) Reset() {
+	*x = GetSecurityProfilesResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecurityProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecurityProfilesResponse) ProtoMessage() {}
+
+func (x *GetSecurityProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecurityProfilesResponse.ProtoReflect.Descriptor instead.
+func (*GetSecurityProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSecurityProfilesResponse) GetProfiles() []*SecurityProfileInfo {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// CreateSecurityProfileRequest создает профиль или заменяет профиль с тем же именем
+type CreateSecurityProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Profile       *SecurityProfileInfo   `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecurityProfileRequest) Reset() {
+	*x = CreateSecurityProfileRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecurityProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecurityProfileRequest) ProtoMessage() {}
+
+func (x *CreateSecurityProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecurityProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecurityProfileRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateSecurityProfileRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateSecurityProfileRequest) GetProfile() *SecurityProfileInfo {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type DropSecurityProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropSecurityProfileRequest) Reset() {
+	*x = DropSecurityProfileRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropSecurityProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropSecurityProfileRequest) ProtoMessage() {}
+
+func (x *DropSecurityProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropSecurityProfileRequest.ProtoReflect.Descriptor instead.
+func (*DropSecurityProfileRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DropSecurityProfileRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropSecurityProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetVirtualDirectoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVirtualDirectoriesRequest) Reset() {
+	*x = GetVirtualDirectoriesRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVirtualDirectoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVirtualDirectoriesRequest) ProtoMessage() {}
+
+func (x *GetVirtualDirectoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVirtualDirectoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetVirtualDirectoriesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetVirtualDirectoriesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetVirtualDirectoriesRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+type GetVirtualDirectoriesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Directories   []*VirtualDirectoryInfo `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVirtualDirectoriesResponse) Reset() {
+	*x = GetVirtualDirectoriesResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVirtualDirectoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVirtualDirectoriesResponse) ProtoMessage() {}
+
+func (x *GetVirtualDirectoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVirtualDirectoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetVirtualDirectoriesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetVirtualDirectoriesResponse) GetDirectories() []*VirtualDirectoryInfo {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+type CreateVirtualDirectoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Directory     *VirtualDirectoryInfo  `protobuf:"bytes,3,opt,name=directory,proto3" json:"directory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVirtualDirectoryRequest) Reset() {
+	*x = CreateVirtualDirectoryRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVirtualDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVirtualDirectoryRequest) ProtoMessage() {}
+
+func (x *CreateVirtualDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVirtualDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateVirtualDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateVirtualDirectoryRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateVirtualDirectoryRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *CreateVirtualDirectoryRequest) GetDirectory() *VirtualDirectoryInfo {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+type DropVirtualDirectoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Alias         string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropVirtualDirectoryRequest) Reset() {
+	*x = DropVirtualDirectoryRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropVirtualDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropVirtualDirectoryRequest) ProtoMessage() {}
+
+func (x *DropVirtualDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropVirtualDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*DropVirtualDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DropVirtualDirectoryRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropVirtualDirectoryRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DropVirtualDirectoryRequest) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+type GetCOMClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCOMClassesRequest) Reset() {
+	*x = GetCOMClassesRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCOMClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCOMClassesRequest) ProtoMessage() {}
+
+func (x *GetCOMClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCOMClassesRequest.ProtoReflect.Descriptor instead.
+func (*GetCOMClassesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCOMClassesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetCOMClassesRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+type GetCOMClassesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Classes       []*COMClassInfo        `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCOMClassesResponse) Reset() {
+	*x = GetCOMClassesResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCOMClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCOMClassesResponse) ProtoMessage() {}
+
+func (x *GetCOMClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCOMClassesResponse.ProtoReflect.Descriptor instead.
+func (*GetCOMClassesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCOMClassesResponse) GetClasses() []*COMClassInfo {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
+type CreateCOMClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Class         *COMClassInfo          `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCOMClassRequest) Reset() {
+	*x = CreateCOMClassRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCOMClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCOMClassRequest) ProtoMessage() {}
+
+func (x *CreateCOMClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCOMClassRequest.ProtoReflect.Descriptor instead.
+func (*CreateCOMClassRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateCOMClassRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateCOMClassRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *CreateCOMClassRequest) GetClass() *COMClassInfo {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+type DropCOMClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropCOMClassRequest) Reset() {
+	*x = DropCOMClassRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropCOMClassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropCOMClassRequest) ProtoMessage() {}
+
+func (x *DropCOMClassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropCOMClassRequest.ProtoReflect.Descriptor instead.
+func (*DropCOMClassRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DropCOMClassRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropCOMClassRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DropCOMClassRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetAllowedAddinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedAddinsRequest) Reset() {
+	*x = GetAllowedAddinsRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedAddinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedAddinsRequest) ProtoMessage() {}
+
+func (x *GetAllowedAddinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedAddinsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowedAddinsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAllowedAddinsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetAllowedAddinsRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+type GetAllowedAddinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addins        []*AddinInfo           `protobuf:"bytes,1,rep,name=addins,proto3" json:"addins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedAddinsResponse) Reset() {
+	*x = GetAllowedAddinsResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedAddinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedAddinsResponse) ProtoMessage() {}
+
+func (x *GetAllowedAddinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedAddinsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllowedAddinsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAllowedAddinsResponse) GetAddins() []*AddinInfo {
+	if x != nil {
+		return x.Addins
+	}
+	return nil
+}
+
+type CreateAllowedAddinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Addin         *AddinInfo             `protobuf:"bytes,3,opt,name=addin,proto3" json:"addin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllowedAddinRequest) Reset() {
+	*x = CreateAllowedAddinRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllowedAddinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllowedAddinRequest) ProtoMessage() {}
+
+func (x *CreateAllowedAddinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllowedAddinRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllowedAddinRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateAllowedAddinRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateAllowedAddinRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *CreateAllowedAddinRequest) GetAddin() *AddinInfo {
+	if x != nil {
+		return x.Addin
+	}
+	return nil
+}
+
+type DropAllowedAddinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropAllowedAddinRequest) Reset() {
+	*x = DropAllowedAddinRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropAllowedAddinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropAllowedAddinRequest) ProtoMessage() {}
+
+func (x *DropAllowedAddinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropAllowedAddinRequest.ProtoReflect.Descriptor instead.
+func (*DropAllowedAddinRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DropAllowedAddinRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropAllowedAddinRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DropAllowedAddinRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetExternalModulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExternalModulesRequest) Reset() {
+	*x = GetExternalModulesRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExternalModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExternalModulesRequest) ProtoMessage() {}
+
+func (x *GetExternalModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExternalModulesRequest.ProtoReflect.Descriptor instead.
+func (*GetExternalModulesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetExternalModulesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetExternalModulesRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+type GetExternalModulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modules       []*ExternalModuleInfo  `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExternalModulesResponse) Reset() {
+	*x = GetExternalModulesResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExternalModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExternalModulesResponse) ProtoMessage() {}
+
+func (x *GetExternalModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExternalModulesResponse.ProtoReflect.Descriptor instead.
+func (*GetExternalModulesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetExternalModulesResponse) GetModules() []*ExternalModuleInfo {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type CreateExternalModuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Module        *ExternalModuleInfo    `protobuf:"bytes,3,opt,name=module,proto3" json:"module,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExternalModuleRequest) Reset() {
+	*x = CreateExternalModuleRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExternalModuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExternalModuleRequest) ProtoMessage() {}
+
+func (x *CreateExternalModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExternalModuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateExternalModuleRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateExternalModuleRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateExternalModuleRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *CreateExternalModuleRequest) GetModule() *ExternalModuleInfo {
+	if x != nil {
+		return x.Module
+	}
+	return nil
+}
+
+type DropExternalModuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropExternalModuleRequest) Reset() {
+	*x = DropExternalModuleRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropExternalModuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropExternalModuleRequest) ProtoMessage() {}
+
+func (x *DropExternalModuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropExternalModuleRequest.ProtoReflect.Descriptor instead.
+func (*DropExternalModuleRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DropExternalModuleRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropExternalModuleRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DropExternalModuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetAllowedApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedApplicationsRequest) Reset() {
+	*x = GetAllowedApplicationsRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedApplicationsRequest) ProtoMessage() {}
+
+func (x *GetAllowedApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowedApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetAllowedApplicationsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetAllowedApplicationsRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+type GetAllowedApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*ApplicationInfo     `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedApplicationsResponse) Reset() {
+	*x = GetAllowedApplicationsResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedApplicationsResponse) ProtoMessage() {}
+
+func (x *GetAllowedApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllowedApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAllowedApplicationsResponse) GetApplications() []*ApplicationInfo {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+type CreateAllowedApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Application   *ApplicationInfo       `protobuf:"bytes,3,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllowedApplicationRequest) Reset() {
+	*x = CreateAllowedApplicationRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllowedApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllowedApplicationRequest) ProtoMessage() {}
+
+func (x *CreateAllowedApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllowedApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllowedApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateAllowedApplicationRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateAllowedApplicationRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *CreateAllowedApplicationRequest) GetApplication() *ApplicationInfo {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type DropAllowedApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropAllowedApplicationRequest) Reset() {
+	*x = DropAllowedApplicationRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropAllowedApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropAllowedApplicationRequest) ProtoMessage() {}
+
+func (x *DropAllowedApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropAllowedApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DropAllowedApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DropAllowedApplicationRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropAllowedApplicationRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DropAllowedApplicationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetInternetResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInternetResourcesRequest) Reset() {
+	*x = GetInternetResourcesRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInternetResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInternetResourcesRequest) ProtoMessage() {}
+
+func (x *GetInternetResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInternetResourcesRequest.ProtoReflect.Descriptor instead.
+func (*GetInternetResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetInternetResourcesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetInternetResourcesRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+type GetInternetResourcesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Resources     []*InternetResourceInfo `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInternetResourcesResponse) Reset() {
+	*x = GetInternetResourcesResponse{}
+	mi := &file_cluster_service_security_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInternetResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInternetResourcesResponse) ProtoMessage() {}
+
+func (x *GetInternetResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInternetResourcesResponse.ProtoReflect.Descriptor instead.
+func (*GetInternetResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetInternetResourcesResponse) GetResources() []*InternetResourceInfo {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type CreateInternetResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Resource      *InternetResourceInfo  `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInternetResourceRequest) Reset() {
+	*x = CreateInternetResourceRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInternetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInternetResourceRequest) ProtoMessage() {}
+
+func (x *CreateInternetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInternetResourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInternetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateInternetResourceRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateInternetResourceRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *CreateInternetResourceRequest) GetResource() *InternetResourceInfo {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+type DropInternetResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ProfileName   string                 `protobuf:"bytes,2,opt,name=profile_name,json=profileName,proto3" json:"profile_name,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropInternetResourceRequest) Reset() {
+	*x = DropInternetResourceRequest{}
+	mi := &file_cluster_service_security_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropInternetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropInternetResourceRequest) ProtoMessage() {}
+
+func (x *DropInternetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_security_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropInternetResourceRequest.ProtoReflect.Descriptor instead.
+func (*DropInternetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_security_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DropInternetResourceRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropInternetResourceRequest) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DropInternetResourceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_cluster_service_security_proto protoreflect.FileDescriptor
+
+const file_cluster_service_security_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecluster/service/security.proto\x12\x0fcluster.service\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"\xe5\b\n" +
+	"\x13SecurityProfileInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x124\n" +
+	"\x11safe_mode_profile\x18\x03 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x0fsafeModeProfile\x12:\n" +
+	"\x14full_privileged_mode\x18\x04 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\x12fullPrivilegedMode\x12W\n" +
+	"$privileged_mode_in_safe_mode_allowed\x18\x05 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x1fprivilegedModeInSafeModeAllowed\x12<\n" +
+	"\x15privileged_mode_roles\x18\x06 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\x13privilegedModeRoles\x12;\n" +
+	"\x14cryptography_allowed\x18\a \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\aR\x13cryptographyAllowed\x121\n" +
+	"\x0fright_extension\x18\b \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\bR\x0erightExtension\x12Q\n" +
+	" right_extension_definition_roles\x18\t \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\tR\x1drightExtensionDefinitionRoles\x12<\n" +
+	"\x15all_modules_extension\x18\n" +
+	" \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\n" +
+	"R\x13allModulesExtension\x12O\n" +
+	"\x1fmodules_available_for_extension\x18\v \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\vR\x1cmodulesAvailableForExtension\x12V\n" +
+	"#modules_not_available_for_extension\x18\f \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\fR\x1fmodulesNotAvailableForExtension\x12?\n" +
+	"\x17file_system_full_access\x18\r \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\rR\x14fileSystemFullAccess\x120\n" +
+	"\x0fcom_full_access\x18\x0e \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x0eR\rcomFullAccess\x124\n" +
+	"\x11addin_full_access\x18\x0f \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x0fR\x0faddinFullAccess\x126\n" +
+	"\x12module_full_access\x18\x10 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x10R\x10moduleFullAccess\x12@\n" +
+	"\x17application_full_access\x18\x11 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x11R\x15applicationFullAccess\x12:\n" +
+	"\x14internet_full_access\x18\x12 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x12R\x12internetFullAccess\"\xe1\x01\n" +
+	"\x14VirtualDirectoryInfo\x12\x1e\n" +
+	"\x05alias\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x05alias\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12-\n" +
+	"\rphysical_path\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\fphysicalPath\x12+\n" +
+	"\fallowed_read\x18\x04 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\vallowedRead\x12-\n" +
+	"\rallowed_write\x18\x05 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\fallowedWrite\"\xd3\x01\n" +
+	"\fCOMClassInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12%\n" +
+	"\tfile_name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\bfileName\x12/\n" +
+	"\vobject_uuid\x18\x04 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x04R\n" +
+	"objectUuid\x12-\n" +
+	"\rcomputer_name\x18\x05 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\fcomputerName\"g\n" +
+	"\tAddinInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12\x1c\n" +
+	"\x04hash\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04hash\"p\n" +
+	"\x12ExternalModuleInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12\x1c\n" +
+	"\x04hash\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04hash\"m\n" +
+	"\x0fApplicationInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12\x1c\n" +
+	"\x04wild\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04wild\"\xb4\x01\n" +
+	"\x14InternetResourceInfo\x12\x1c\n" +
+	"\x04name\x18\x01 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04name\x12\x1e\n" +
+	"\x05descr\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x05descr\x12$\n" +
+	"\bprotocol\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\bprotocol\x12\x1a\n" +
+	"\x03url\x18\x04 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\x03url\x12\x1c\n" +
+	"\x04port\x18\x05 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\x04port\"r\n" +
+	"\x1aGetSecurityProfilesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_SECURITY_PROFILES_REQUEST\"\x91\x01\n" +
+	"\x1bGetSecurityProfilesResponse\x12J\n" +
+	"\bprofiles\x18\x01 \x03(\v2$.cluster.service.SecurityProfileInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\bprofiles:&\x8a\xf5\xea\x94\x0e :\x1eGET_SECURITY_PROFILES_RESPONSE\"\xc0\x01\n" +
+	"\x1cCreateSecurityProfileRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12H\n" +
+	"\aprofile\x18\x02 \x01(\v2$.cluster.service.SecurityProfileInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\aprofile:'\x8a\xf5\xea\x94\x0e!:\x1fCREATE_SECURITY_PROFILE_REQUEST\"\x90\x01\n" +
+	"\x1aDropSecurityProfileRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12\x1c\n" +
+	"\x04name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04name:%\x8a\xf5\xea\x94\x0e\x1f:\x1dDROP_SECURITY_PROFILE_REQUEST\"\xa3\x01\n" +
+	"\x1cGetVirtualDirectoriesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName:'\x8a\xf5\xea\x94\x0e!:\x1fGET_VIRTUAL_DIRECTORIES_REQUEST\"\x9c\x01\n" +
+	"\x1dGetVirtualDirectoriesResponse\x12Q\n" +
+	"\vdirectories\x18\x01 \x03(\v2%.cluster.service.VirtualDirectoryInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\vdirectories:(\x8a\xf5\xea\x94\x0e\": GET_VIRTUAL_DIRECTORIES_RESPONSE\"\xf4\x01\n" +
+	"\x1dCreateVirtualDirectoryRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12M\n" +
+	"\tdirectory\x18\x03 \x01(\v2%.cluster.service.VirtualDirectoryInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\tdirectory:(\x8a\xf5\xea\x94\x0e\": CREATE_VIRTUAL_DIRECTORY_REQUEST\"\xc1\x01\n" +
+	"\x1bDropVirtualDirectoryRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12\x1e\n" +
+	"\x05alias\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x05alias:&\x8a\xf5\xea\x94\x0e :\x1eDROP_VIRTUAL_DIRECTORY_REQUEST\"\x93\x01\n" +
+	"\x14GetCOMClassesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName:\x1f\x8a\xf5\xea\x94\x0e\x19:\x17GET_COM_CLASSES_REQUEST\"|\n" +
+	"\x15GetCOMClassesResponse\x12A\n" +
+	"\aclasses\x18\x01 \x03(\v2\x1d.cluster.service.COMClassInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\aclasses: \x8a\xf5\xea\x94\x0e\x1a:\x18GET_COM_CLASSES_RESPONSE\"\xd4\x01\n" +
+	"\x15CreateCOMClassRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12=\n" +
+	"\x05class\x18\x03 \x01(\v2\x1d.cluster.service.COMClassInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x05class: \x8a\xf5\xea\x94\x0e\x1a:\x18CREATE_COM_CLASS_REQUEST\"\xaf\x01\n" +
+	"\x13DropCOMClassRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12\x1c\n" +
+	"\x04name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04name:\x1e\x8a\xf5\xea\x94\x0e\x18:\x16DROP_COM_CLASS_REQUEST\"\x99\x01\n" +
+	"\x17GetAllowedAddinsRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aGET_ALLOWED_ADDINS_REQUEST\"}\n" +
+	"\x18GetAllowedAddinsResponse\x12<\n" +
+	"\x06addins\x18\x01 \x03(\v2\x1a.cluster.service.AddinInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x06addins:#\x8a\xf5\xea\x94\x0e\x1d:\x1bGET_ALLOWED_ADDINS_RESPONSE\"\xd9\x01\n" +
+	"\x19CreateAllowedAddinRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12:\n" +
+	"\x05addin\x18\x03 \x01(\v2\x1a.cluster.service.AddinInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x05addin:$\x8a\xf5\xea\x94\x0e\x1e:\x1cCREATE_ALLOWED_ADDIN_REQUEST\"\xb7\x01\n" +
+	"\x17DropAllowedAddinRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12\x1c\n" +
+	"\x04name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04name:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aDROP_ALLOWED_ADDIN_REQUEST\"\x9d\x01\n" +
+	"\x19GetExternalModulesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName:$\x8a\xf5\xea\x94\x0e\x1e:\x1cGET_EXTERNAL_MODULES_REQUEST\"\x8c\x01\n" +
+	"\x1aGetExternalModulesResponse\x12G\n" +
+	"\amodules\x18\x01 \x03(\v2#.cluster.service.ExternalModuleInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\amodules:%\x8a\xf5\xea\x94\x0e\x1f:\x1dGET_EXTERNAL_MODULES_RESPONSE\"\xe8\x01\n" +
+	"\x1bCreateExternalModuleRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12E\n" +
+	"\x06module\x18\x03 \x01(\v2#.cluster.service.ExternalModuleInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x06module:&\x8a\xf5\xea\x94\x0e :\x1eCREATE_EXTERNAL_MODULE_REQUEST\"\xbb\x01\n" +
+	"\x19DropExternalModuleRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12\x1c\n" +
+	"\x04name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04name:$\x8a\xf5\xea\x94\x0e\x1e:\x1cDROP_EXTERNAL_MODULE_REQUEST\"\xa5\x01\n" +
+	"\x1dGetAllowedApplicationsRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName:(\x8a\xf5\xea\x94\x0e\": GET_ALLOWED_APPLICATIONS_REQUEST\"\x9b\x01\n" +
+	"\x1eGetAllowedApplicationsResponse\x12N\n" +
+	"\fapplications\x18\x01 \x03(\v2 .cluster.service.ApplicationInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\fapplications:)\x8a\xf5\xea\x94\x0e#:!GET_ALLOWED_APPLICATIONS_RESPONSE\"\xf7\x01\n" +
+	"\x1fCreateAllowedApplicationRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12L\n" +
+	"\vapplication\x18\x03 \x01(\v2 .cluster.service.ApplicationInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\vapplication:*\x8a\xf5\xea\x94\x0e$:\"CREATE_ALLOWED_APPLICATION_REQUEST\"\xc3\x01\n" +
+	"\x1dDropAllowedApplicationRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12\x1c\n" +
+	"\x04name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04name:(\x8a\xf5\xea\x94\x0e\": DROP_ALLOWED_APPLICATION_REQUEST\"\xa1\x01\n" +
+	"\x1bGetInternetResourcesRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName:&\x8a\xf5\xea\x94\x0e :\x1eGET_INTERNET_RESOURCES_REQUEST\"\x96\x01\n" +
+	"\x1cGetInternetResourcesResponse\x12M\n" +
+	"\tresources\x18\x01 \x03(\v2%.cluster.service.InternetResourceInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\tresources:'\x8a\xf5\xea\x94\x0e!:\x1fGET_INTERNET_RESOURCES_RESPONSE\"\xf2\x01\n" +
+	"\x1dCreateInternetResourceRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12K\n" +
+	"\bresource\x18\x03 \x01(\v2%.cluster.service.InternetResourceInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\bresource:(\x8a\xf5\xea\x94\x0e\": CREATE_INTERNET_RESOURCE_REQUEST\"\xbf\x01\n" +
+	"\x1bDropInternetResourceRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\fprofile_name\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\vprofileName\x12\x1c\n" +
+	"\x04name\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\x04name:&\x8a\xf5\xea\x94\x0e :\x1eDROP_INTERNET_RESOURCE_REQUEST2\x97\x11\n" +
+	"\x17SecurityProfilesService\x12p\n" +
+	"\x13GetSecurityProfiles\x12+.cluster.service.GetSecurityProfilesRequest\x1a,.cluster.service.GetSecurityProfilesResponse\x12^\n" +
+	"\x15CreateSecurityProfile\x12-.cluster.service.CreateSecurityProfileRequest\x1a\x16.google.protobuf.Empty\x12^\n" +
+	"\x15UpdateSecurityProfile\x12-.cluster.service.CreateSecurityProfileRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\x13DropSecurityProfile\x12+.cluster.service.DropSecurityProfileRequest\x1a\x16.google.protobuf.Empty\x12v\n" +
+	"\x15GetVirtualDirectories\x12-.cluster.service.GetVirtualDirectoriesRequest\x1a..cluster.service.GetVirtualDirectoriesResponse\x12`\n" +
+	"\x16CreateVirtualDirectory\x12..cluster.service.CreateVirtualDirectoryRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x14DropVirtualDirectory\x12,.cluster.service.DropVirtualDirectoryRequest\x1a\x16.google.protobuf.Empty\x12^\n" +
+	"\rGetCOMClasses\x12%.cluster.service.GetCOMClassesRequest\x1a&.cluster.service.GetCOMClassesResponse\x12P\n" +
+	"\x0eCreateCOMClass\x12&.cluster.service.CreateCOMClassRequest\x1a\x16.google.protobuf.Empty\x12L\n" +
+	"\fDropCOMClass\x12$.cluster.service.DropCOMClassRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
+	"\x10GetAllowedAddins\x12(.cluster.service.GetAllowedAddinsRequest\x1a).cluster.service.GetAllowedAddinsResponse\x12X\n" +
+	"\x12CreateAllowedAddin\x12*.cluster.service.CreateAllowedAddinRequest\x1a\x16.google.protobuf.Empty\x12T\n" +
+	"\x10DropAllowedAddin\x12(.cluster.service.DropAllowedAddinRequest\x1a\x16.google.protobuf.Empty\x12m\n" +
+	"\x12GetExternalModules\x12*.cluster.service.GetExternalModulesRequest\x1a+.cluster.service.GetExternalModulesResponse\x12\\\n" +
+	"\x14CreateExternalModule\x12,.cluster.service.CreateExternalModuleRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x12DropExternalModule\x12*.cluster.service.DropExternalModuleRequest\x1a\x16.google.protobuf.Empty\x12y\n" +
+	"\x16GetAllowedApplications\x12..cluster.service.GetAllowedApplicationsRequest\x1a/.cluster.service.GetAllowedApplicationsResponse\x12d\n" +
+	"\x18CreateAllowedApplication\x120.cluster.service.CreateAllowedApplicationRequest\x1a\x16.google.protobuf.Empty\x12`\n" +
+	"\x16DropAllowedApplication\x12..cluster.service.DropAllowedApplicationRequest\x1a\x16.google.protobuf.Empty\x12s\n" +
+	"\x14GetInternetResources\x12,.cluster.service.GetInternetResourcesRequest\x1a-.cluster.service.GetInternetResourcesResponse\x12`\n" +
+	"\x16CreateInternetResource\x12..cluster.service.CreateInternetResourceRequest\x1a\x16.google.protobuf.Empty\x12\\\n" +
+	"\x14DropInternetResource\x12,.cluster.service.DropInternetResourceRequest\x1a\x16.google.protobuf.EmptyB\xbc\x01\n" +
+	"\x13com.cluster.serviceB\rSecurityProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_security_proto_rawDescOnce sync.Once
+	file_cluster_service_security_proto_rawDescData []byte
+)
+
+func file_cluster_service_security_proto_rawDescGZIP() []byte {
+	file_cluster_service_security_proto_rawDescOnce.Do(func() {
+		file_cluster_service_security_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_security_proto_rawDesc), len(file_cluster_service_security_proto_rawDesc)))
+	})
+	return file_cluster_service_security_proto_rawDescData
+}
+
+var file_cluster_service_security_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_cluster_service_security_proto_goTypes = []any{
+	(*SecurityProfileInfo)(nil),             // 0: cluster.service.SecurityProfileInfo
+	(*VirtualDirectoryInfo)(nil),            // 1: cluster.service.VirtualDirectoryInfo
+	(*COMClassInfo)(nil),                    // 2: cluster.service.COMClassInfo
+	(*AddinInfo)(nil),                       // 3: cluster.service.AddinInfo
+	(*ExternalModuleInfo)(nil),              // 4: cluster.service.ExternalModuleInfo
+	(*ApplicationInfo)(nil),                 // 5: cluster.service.ApplicationInfo
+	(*InternetResourceInfo)(nil),            // 6: cluster.service.InternetResourceInfo
+	(*GetSecurityProfilesRequest)(nil),      // 7: cluster.service.GetSecurityProfilesRequest
+	(*GetSecurityProfilesResponse)(nil),     // 8: cluster.service.GetSecurityProfilesResponse
+	(*CreateSecurityProfileRequest)(nil),    // 9: cluster.service.CreateSecurityProfileRequest
+	(*DropSecurityProfileRequest)(nil),      // 10: cluster.service.DropSecurityProfileRequest
+	(*GetVirtualDirectoriesRequest)(nil),    // 11: cluster.service.GetVirtualDirectoriesRequest
+	(*GetVirtualDirectoriesResponse)(nil),   // 12: cluster.service.GetVirtualDirectoriesResponse
+	(*CreateVirtualDirectoryRequest)(nil),   // 13: cluster.service.CreateVirtualDirectoryRequest
+	(*DropVirtualDirectoryRequest)(nil),     // 14: cluster.service.DropVirtualDirectoryRequest
+	(*GetCOMClassesRequest)(nil),            // 15: cluster.service.GetCOMClassesRequest
+	(*GetCOMClassesResponse)(nil),           // 16: cluster.service.GetCOMClassesResponse
+	(*CreateCOMClassRequest)(nil),           // 17: cluster.service.CreateCOMClassRequest
+	(*DropCOMClassRequest)(nil),             // 18: cluster.service.DropCOMClassRequest
+	(*GetAllowedAddinsRequest)(nil),         // 19: cluster.service.GetAllowedAddinsRequest
+	(*GetAllowedAddinsResponse)(nil),        // 20: cluster.service.GetAllowedAddinsResponse
+	(*CreateAllowedAddinRequest)(nil),       // 21: cluster.service.CreateAllowedAddinRequest
+	(*DropAllowedAddinRequest)(nil),         // 22: cluster.service.DropAllowedAddinRequest
+	(*GetExternalModulesRequest)(nil),       // 23: cluster.service.GetExternalModulesRequest
+	(*GetExternalModulesResponse)(nil),      // 24: cluster.service.GetExternalModulesResponse
+	(*CreateExternalModuleRequest)(nil),     // 25: cluster.service.CreateExternalModuleRequest
+	(*DropExternalModuleRequest)(nil),       // 26: cluster.service.DropExternalModuleRequest
+	(*GetAllowedApplicationsRequest)(nil),   // 27: cluster.service.GetAllowedApplicationsRequest
+	(*GetAllowedApplicationsResponse)(nil),  // 28: cluster.service.GetAllowedApplicationsResponse
+	(*CreateAllowedApplicationRequest)(nil), // 29: cluster.service.CreateAllowedApplicationRequest
+	(*DropAllowedApplicationRequest)(nil),   // 30: cluster.service.DropAllowedApplicationRequest
+	(*GetInternetResourcesRequest)(nil),     // 31: cluster.service.GetInternetResourcesRequest
+	(*GetInternetResourcesResponse)(nil),    // 32: cluster.service.GetInternetResourcesResponse
+	(*CreateInternetResourceRequest)(nil),   // 33: cluster.service.CreateInternetResourceRequest
+	(*DropInternetResourceRequest)(nil),     // 34: cluster.service.DropInternetResourceRequest
+	(*emptypb.Empty)(nil),                   // 35: google.protobuf.Empty
+}
+var file_cluster_service_security_proto_depIdxs = []int32{
+	0,  // 0: cluster.service.GetSecurityProfilesResponse.profiles:type_name -> cluster.service.SecurityProfileInfo
+	0,  // 1: cluster.service.CreateSecurityProfileRequest.profile:type_name -> cluster.service.SecurityProfileInfo
+	1,  // 2: cluster.service.GetVirtualDirectoriesResponse.directories:type_name -> cluster.service.VirtualDirectoryInfo
+	1,  // 3: cluster.service.CreateVirtualDirectoryRequest.directory:type_name -> cluster.service.VirtualDirectoryInfo
+	2,  // 4: cluster.service.GetCOMClassesResponse.classes:type_name -> cluster.service.COMClassInfo
+	2,  // 5: cluster.service.CreateCOMClassRequest.class:type_name -> cluster.service.COMClassInfo
+	3,  // 6: cluster.service.GetAllowedAddinsResponse.addins:type_name -> cluster.service.AddinInfo
+	3,  // 7: cluster.service.CreateAllowedAddinRequest.addin:type_name -> cluster.service.AddinInfo
+	4,  // 8: cluster.service.GetExternalModulesResponse.modules:type_name -> cluster.service.ExternalModuleInfo
+	4,  // 9: cluster.service.CreateExternalModuleRequest.module:type_name -> cluster.service.ExternalModuleInfo
+	5,  // 10: cluster.service.GetAllowedApplicationsResponse.applications:type_name -> cluster.service.ApplicationInfo
+	5,  // 11: cluster.service.CreateAllowedApplicationRequest.application:type_name -> cluster.service.ApplicationInfo
+	6,  // 12: cluster.service.GetInternetResourcesResponse.resources:type_name -> cluster.service.InternetResourceInfo
+	6,  // 13: cluster.service.CreateInternetResourceRequest.resource:type_name -> cluster.service.InternetResourceInfo
+	7,  // 14: cluster.service.SecurityProfilesService.GetSecurityProfiles:input_type -> cluster.service.GetSecurityProfilesRequest
+	9,  // 15: cluster.service.SecurityProfilesService.CreateSecurityProfile:input_type -> cluster.service.CreateSecurityProfileRequest
+	9,  // 16: cluster.service.SecurityProfilesService.UpdateSecurityProfile:input_type -> cluster.service.CreateSecurityProfileRequest
+	10, // 17: cluster.service.SecurityProfilesService.DropSecurityProfile:input_type -> cluster.service.DropSecurityProfileRequest
+	11, // 18: cluster.service.SecurityProfilesService.GetVirtualDirectories:input_type -> cluster.service.GetVirtualDirectoriesRequest
+	13, // 19: cluster.service.SecurityProfilesService.CreateVirtualDirectory:input_type -> cluster.service.CreateVirtualDirectoryRequest
+	14, // 20: cluster.service.SecurityProfilesService.DropVirtualDirectory:input_type -> cluster.service.DropVirtualDirectoryRequest
+	15, // 21: cluster.service.SecurityProfilesService.GetCOMClasses:input_type -> cluster.service.GetCOMClassesRequest
+	17, // 22: cluster.service.SecurityProfilesService.CreateCOMClass:input_type -> cluster.service.CreateCOMClassRequest
+	18, // 23: cluster.service.SecurityProfilesService.DropCOMClass:input_type -> cluster.service.DropCOMClassRequest
+	19, // 24: cluster.service.SecurityProfilesService.GetAllowedAddins:input_type -> cluster.service.GetAllowedAddinsRequest
+	21, // 25: cluster.service.SecurityProfilesService.CreateAllowedAddin:input_type -> cluster.service.CreateAllowedAddinRequest
+	22, // 26: cluster.service.SecurityProfilesService.DropAllowedAddin:input_type -> cluster.service.DropAllowedAddinRequest
+	23, // 27: cluster.service.SecurityProfilesService.GetExternalModules:input_type -> cluster.service.GetExternalModulesRequest
+	25, // 28: cluster.service.SecurityProfilesService.CreateExternalModule:input_type -> cluster.service.CreateExternalModuleRequest
+	26, // 29: cluster.service.SecurityProfilesService.DropExternalModule:input_type -> cluster.service.DropExternalModuleRequest
+	27, // 30: cluster.service.SecurityProfilesService.GetAllowedApplications:input_type -> cluster.service.GetAllowedApplicationsRequest
+	29, // 31: cluster.service.SecurityProfilesService.CreateAllowedApplication:input_type -> cluster.service.CreateAllowedApplicationRequest
+	30, // 32: cluster.service.SecurityProfilesService.DropAllowedApplication:input_type -> cluster.service.DropAllowedApplicationRequest
+	31, // 33: cluster.service.SecurityProfilesService.GetInternetResources:input_type -> cluster.service.GetInternetResourcesRequest
+	33, // 34: cluster.service.SecurityProfilesService.CreateInternetResource:input_type -> cluster.service.CreateInternetResourceRequest
+	34, // 35: cluster.service.SecurityProfilesService.DropInternetResource:input_type -> cluster.service.DropInternetResourceRequest
+	8,  // 36: cluster.service.SecurityProfilesService.GetSecurityProfiles:output_type -> cluster.service.GetSecurityProfilesResponse
+	35, // 37: cluster.service.SecurityProfilesService.CreateSecurityProfile:output_type -> google.protobuf.Empty
+	35, // 38: cluster.service.SecurityProfilesService.UpdateSecurityProfile:output_type -> google.protobuf.Empty
+	35, // 39: cluster.service.SecurityProfilesService.DropSecurityProfile:output_type -> google.protobuf.Empty
+	12, // 40: cluster.service.SecurityProfilesService.GetVirtualDirectories:output_type -> cluster.service.GetVirtualDirectoriesResponse
+	35, // 41: cluster.service.SecurityProfilesService.CreateVirtualDirectory:output_type -> google.protobuf.Empty
+	35, // 42: cluster.service.SecurityProfilesService.DropVirtualDirectory:output_type -> google.protobuf.Empty
+	16, // 43: cluster.service.SecurityProfilesService.GetCOMClasses:output_type -> cluster.service.GetCOMClassesResponse
+	35, // 44: cluster.service.SecurityProfilesService.CreateCOMClass:output_type -> google.protobuf.Empty
+	35, // 45: cluster.service.SecurityProfilesService.DropCOMClass:output_type -> google.protobuf.Empty
+	20, // 46: cluster.service.SecurityProfilesService.GetAllowedAddins:output_type -> cluster.service.GetAllowedAddinsResponse
+	35, // 47: cluster.service.SecurityProfilesService.CreateAllowedAddin:output_type -> google.protobuf.Empty
+	35, // 48: cluster.service.SecurityProfilesService.DropAllowedAddin:output_type -> google.protobuf.Empty
+	24, // 49: cluster.service.SecurityProfilesService.GetExternalModules:output_type -> cluster.service.GetExternalModulesResponse
+	35, // 50: cluster.service.SecurityProfilesService.CreateExternalModule:output_type -> google.protobuf.Empty
+	35, // 51: cluster.service.SecurityProfilesService.DropExternalModule:output_type -> google.protobuf.Empty
+	28, // 52: cluster.service.SecurityProfilesService.GetAllowedApplications:output_type -> cluster.service.GetAllowedApplicationsResponse
+	35, // 53: cluster.service.SecurityProfilesService.CreateAllowedApplication:output_type -> google.protobuf.Empty
+	35, // 54: cluster.service.SecurityProfilesService.DropAllowedApplication:output_type -> google.protobuf.Empty
+	32, // 55: cluster.service.SecurityProfilesService.GetInternetResources:output_type -> cluster.service.GetInternetResourcesResponse
+	35, // 56: cluster.service.SecurityProfilesService.CreateInternetResource:output_type -> google.protobuf.Empty
+	35, // 57: cluster.service.SecurityProfilesService.DropInternetResource:output_type -> google.protobuf.Empty
+	36, // [36:58] is the sub-list for method output_type
+	14, // [14:36] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_security_proto_init() }
+func file_cluster_service_security_proto_init() {
+	if File_cluster_service_security_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_security_proto_rawDesc), len(file_cluster_service_security_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_security_proto_goTypes,
+		DependencyIndexes: file_cluster_service_security_proto_depIdxs,
+		MessageInfos:      file_cluster_service_security_proto_msgTypes,
+	}.Build()
+	File_cluster_service_security_proto = out.File
+	file_cluster_service_security_proto_goTypes = nil
+	file_cluster_service_security_proto_depIdxs = nil
+}
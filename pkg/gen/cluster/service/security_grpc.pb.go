@@ -0,0 +1,937 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/security.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SecurityProfilesService_GetSecurityProfiles_FullMethodName      = "/cluster.service.SecurityProfilesService/GetSecurityProfiles"
+	SecurityProfilesService_CreateSecurityProfile_FullMethodName    = "/cluster.service.SecurityProfilesService/CreateSecurityProfile"
+	SecurityProfilesService_UpdateSecurityProfile_FullMethodName    = "/cluster.service.SecurityProfilesService/UpdateSecurityProfile"
+	SecurityProfilesService_DropSecurityProfile_FullMethodName      = "/cluster.service.SecurityProfilesService/DropSecurityProfile"
+	SecurityProfilesService_GetVirtualDirectories_FullMethodName    = "/cluster.service.SecurityProfilesService/GetVirtualDirectories"
+	SecurityProfilesService_CreateVirtualDirectory_FullMethodName   = "/cluster.service.SecurityProfilesService/CreateVirtualDirectory"
+	SecurityProfilesService_DropVirtualDirectory_FullMethodName     = "/cluster.service.SecurityProfilesService/DropVirtualDirectory"
+	SecurityProfilesService_GetCOMClasses_FullMethodName            = "/cluster.service.SecurityProfilesService/GetCOMClasses"
+	SecurityProfilesService_CreateCOMClass_FullMethodName           = "/cluster.service.SecurityProfilesService/CreateCOMClass"
+	SecurityProfilesService_DropCOMClass_FullMethodName             = "/cluster.service.SecurityProfilesService/DropCOMClass"
+	SecurityProfilesService_GetAllowedAddins_FullMethodName         = "/cluster.service.SecurityProfilesService/GetAllowedAddins"
+	SecurityProfilesService_CreateAllowedAddin_FullMethodName       = "/cluster.service.SecurityProfilesService/CreateAllowedAddin"
+	SecurityProfilesService_DropAllowedAddin_FullMethodName         = "/cluster.service.SecurityProfilesService/DropAllowedAddin"
+	SecurityProfilesService_GetExternalModules_FullMethodName       = "/cluster.service.SecurityProfilesService/GetExternalModules"
+	SecurityProfilesService_CreateExternalModule_FullMethodName     = "/cluster.service.SecurityProfilesService/CreateExternalModule"
+	SecurityProfilesService_DropExternalModule_FullMethodName       = "/cluster.service.SecurityProfilesService/DropExternalModule"
+	SecurityProfilesService_GetAllowedApplications_FullMethodName   = "/cluster.service.SecurityProfilesService/GetAllowedApplications"
+	SecurityProfilesService_CreateAllowedApplication_FullMethodName = "/cluster.service.SecurityProfilesService/CreateAllowedApplication"
+	SecurityProfilesService_DropAllowedApplication_FullMethodName   = "/cluster.service.SecurityProfilesService/DropAllowedApplication"
+	SecurityProfilesService_GetInternetResources_FullMethodName     = "/cluster.service.SecurityProfilesService/GetInternetResources"
+	SecurityProfilesService_CreateInternetResource_FullMethodName   = "/cluster.service.SecurityProfilesService/CreateInternetResource"
+	SecurityProfilesService_DropInternetResource_FullMethodName     = "/cluster.service.SecurityProfilesService/DropInternetResource"
+)
+
+// SecurityProfilesServiceClient is the client API for SecurityProfilesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SecurityProfilesService профили безопасности кластера.
+// Create* для элементов списков профиля добавляют элемент или заменяют
+// элемент с тем же именем.
+type SecurityProfilesServiceClient interface {
+	// GetSecurityProfiles список профилей безопасности
+	GetSecurityProfiles(ctx context.Context, in *GetSecurityProfilesRequest, opts ...grpc.CallOption) (*GetSecurityProfilesResponse, error)
+	// CreateSecurityProfile создание профиля, профиль не должен существовать
+	CreateSecurityProfile(ctx context.Context, in *CreateSecurityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateSecurityProfile изменение существующего профиля
+	UpdateSecurityProfile(ctx context.Context, in *CreateSecurityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DropSecurityProfile удаление профиля
+	DropSecurityProfile(ctx context.Context, in *DropSecurityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetVirtualDirectories(ctx context.Context, in *GetVirtualDirectoriesRequest, opts ...grpc.CallOption) (*GetVirtualDirectoriesResponse, error)
+	CreateVirtualDirectory(ctx context.Context, in *CreateVirtualDirectoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropVirtualDirectory(ctx context.Context, in *DropVirtualDirectoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetCOMClasses(ctx context.Context, in *GetCOMClassesRequest, opts ...grpc.CallOption) (*GetCOMClassesResponse, error)
+	CreateCOMClass(ctx context.Context, in *CreateCOMClassRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropCOMClass(ctx context.Context, in *DropCOMClassRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAllowedAddins(ctx context.Context, in *GetAllowedAddinsRequest, opts ...grpc.CallOption) (*GetAllowedAddinsResponse, error)
+	CreateAllowedAddin(ctx context.Context, in *CreateAllowedAddinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropAllowedAddin(ctx context.Context, in *DropAllowedAddinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetExternalModules(ctx context.Context, in *GetExternalModulesRequest, opts ...grpc.CallOption) (*GetExternalModulesResponse, error)
+	CreateExternalModule(ctx context.Context, in *CreateExternalModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropExternalModule(ctx context.Context, in *DropExternalModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAllowedApplications(ctx context.Context, in *GetAllowedApplicationsRequest, opts ...grpc.CallOption) (*GetAllowedApplicationsResponse, error)
+	CreateAllowedApplication(ctx context.Context, in *CreateAllowedApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropAllowedApplication(ctx context.Context, in *DropAllowedApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetInternetResources(ctx context.Context, in *GetInternetResourcesRequest, opts ...grpc.CallOption) (*GetInternetResourcesResponse, error)
+	CreateInternetResource(ctx context.Context, in *CreateInternetResourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DropInternetResource(ctx context.Context, in *DropInternetResourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type securityProfilesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSecurityProfilesServiceClient(cc grpc.ClientConnInterface) SecurityProfilesServiceClient {
+	return &securityProfilesServiceClient{cc}
+}
+
+func (c *securityProfilesServiceClient) GetSecurityProfiles(ctx context.Context, in *GetSecurityProfilesRequest, opts ...grpc.CallOption) (*GetSecurityProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecurityProfilesResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetSecurityProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateSecurityProfile(ctx context.Context, in *CreateSecurityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateSecurityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) UpdateSecurityProfile(ctx context.Context, in *CreateSecurityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_UpdateSecurityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropSecurityProfile(ctx context.Context, in *DropSecurityProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropSecurityProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) GetVirtualDirectories(ctx context.Context, in *GetVirtualDirectoriesRequest, opts ...grpc.CallOption) (*GetVirtualDirectoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVirtualDirectoriesResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetVirtualDirectories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateVirtualDirectory(ctx context.Context, in *CreateVirtualDirectoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateVirtualDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropVirtualDirectory(ctx context.Context, in *DropVirtualDirectoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropVirtualDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) GetCOMClasses(ctx context.Context, in *GetCOMClassesRequest, opts ...grpc.CallOption) (*GetCOMClassesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCOMClassesResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetCOMClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateCOMClass(ctx context.Context, in *CreateCOMClassRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateCOMClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropCOMClass(ctx context.Context, in *DropCOMClassRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropCOMClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) GetAllowedAddins(ctx context.Context, in *GetAllowedAddinsRequest, opts ...grpc.CallOption) (*GetAllowedAddinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllowedAddinsResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetAllowedAddins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateAllowedAddin(ctx context.Context, in *CreateAllowedAddinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateAllowedAddin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropAllowedAddin(ctx context.Context, in *DropAllowedAddinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropAllowedAddin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) GetExternalModules(ctx context.Context, in *GetExternalModulesRequest, opts ...grpc.CallOption) (*GetExternalModulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExternalModulesResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetExternalModules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateExternalModule(ctx context.Context, in *CreateExternalModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateExternalModule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropExternalModule(ctx context.Context, in *DropExternalModuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropExternalModule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) GetAllowedApplications(ctx context.Context, in *GetAllowedApplicationsRequest, opts ...grpc.CallOption) (*GetAllowedApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllowedApplicationsResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetAllowedApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateAllowedApplication(ctx context.Context, in *CreateAllowedApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateAllowedApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropAllowedApplication(ctx context.Context, in *DropAllowedApplicationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropAllowedApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) GetInternetResources(ctx context.Context, in *GetInternetResourcesRequest, opts ...grpc.CallOption) (*GetInternetResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInternetResourcesResponse)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_GetInternetResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) CreateInternetResource(ctx context.Context, in *CreateInternetResourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_CreateInternetResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *securityProfilesServiceClient) DropInternetResource(ctx context.Context, in *DropInternetResourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SecurityProfilesService_DropInternetResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SecurityProfilesServiceServer is the server API for SecurityProfilesService service.
+// All implementations must embed UnimplementedSecurityProfilesServiceServer
+// for forward compatibility.
+//
+// SecurityProfilesService профили безопасности кластера.
+// Create* для элементов списков профиля добавляют элемент или заменяют
+// элемент с тем же именем.
+type SecurityProfilesServiceServer interface {
+	// GetSecurityProfiles список профилей безопасности
+	GetSecurityProfiles(context.Context, *GetSecurityProfilesRequest) (*GetSecurityProfilesResponse, error)
+	// CreateSecurityProfile создание профиля, профиль не должен существовать
+	CreateSecurityProfile(context.Context, *CreateSecurityProfileRequest) (*emptypb.Empty, error)
+	// UpdateSecurityProfile изменение существующего профиля
+	UpdateSecurityProfile(context.Context, *CreateSecurityProfileRequest) (*emptypb.Empty, error)
+	// DropSecurityProfile удаление профиля
+	DropSecurityProfile(context.Context, *DropSecurityProfileRequest) (*emptypb.Empty, error)
+	GetVirtualDirectories(context.Context, *GetVirtualDirectoriesRequest) (*GetVirtualDirectoriesResponse, error)
+	CreateVirtualDirectory(context.Context, *CreateVirtualDirectoryRequest) (*emptypb.Empty, error)
+	DropVirtualDirectory(context.Context, *DropVirtualDirectoryRequest) (*emptypb.Empty, error)
+	GetCOMClasses(context.Context, *GetCOMClassesRequest) (*GetCOMClassesResponse, error)
+	CreateCOMClass(context.Context, *CreateCOMClassRequest) (*emptypb.Empty, error)
+	DropCOMClass(context.Context, *DropCOMClassRequest) (*emptypb.Empty, error)
+	GetAllowedAddins(context.Context, *GetAllowedAddinsRequest) (*GetAllowedAddinsResponse, error)
+	CreateAllowedAddin(context.Context, *CreateAllowedAddinRequest) (*emptypb.Empty, error)
+	DropAllowedAddin(context.Context, *DropAllowedAddinRequest) (*emptypb.Empty, error)
+	GetExternalModules(context.Context, *GetExternalModulesRequest) (*GetExternalModulesResponse, error)
+	CreateExternalModule(context.Context, *CreateExternalModuleRequest) (*emptypb.Empty, error)
+	DropExternalModule(context.Context, *DropExternalModuleRequest) (*emptypb.Empty, error)
+	GetAllowedApplications(context.Context, *GetAllowedApplicationsRequest) (*GetAllowedApplicationsResponse, error)
+	CreateAllowedApplication(context.Context, *CreateAllowedApplicationRequest) (*emptypb.Empty, error)
+	DropAllowedApplication(context.Context, *DropAllowedApplicationRequest) (*emptypb.Empty, error)
+	GetInternetResources(context.Context, *GetInternetResourcesRequest) (*GetInternetResourcesResponse, error)
+	CreateInternetResource(context.Context, *CreateInternetResourceRequest) (*emptypb.Empty, error)
+	DropInternetResource(context.Context, *DropInternetResourceRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSecurityProfilesServiceServer()
+}
+
+// UnimplementedSecurityProfilesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSecurityProfilesServiceServer struct{}
+
+func (UnimplementedSecurityProfilesServiceServer) GetSecurityProfiles(context.Context, *GetSecurityProfilesRequest) (*GetSecurityProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecurityProfiles not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateSecurityProfile(context.Context, *CreateSecurityProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecurityProfile not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) UpdateSecurityProfile(context.Context, *CreateSecurityProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecurityProfile not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropSecurityProfile(context.Context, *DropSecurityProfileRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropSecurityProfile not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) GetVirtualDirectories(context.Context, *GetVirtualDirectoriesRequest) (*GetVirtualDirectoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVirtualDirectories not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateVirtualDirectory(context.Context, *CreateVirtualDirectoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVirtualDirectory not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropVirtualDirectory(context.Context, *DropVirtualDirectoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualDirectory not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) GetCOMClasses(context.Context, *GetCOMClassesRequest) (*GetCOMClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCOMClasses not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateCOMClass(context.Context, *CreateCOMClassRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCOMClass not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropCOMClass(context.Context, *DropCOMClassRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCOMClass not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) GetAllowedAddins(context.Context, *GetAllowedAddinsRequest) (*GetAllowedAddinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedAddins not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateAllowedAddin(context.Context, *CreateAllowedAddinRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowedAddin not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropAllowedAddin(context.Context, *DropAllowedAddinRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropAllowedAddin not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) GetExternalModules(context.Context, *GetExternalModulesRequest) (*GetExternalModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExternalModules not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateExternalModule(context.Context, *CreateExternalModuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExternalModule not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropExternalModule(context.Context, *DropExternalModuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropExternalModule not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) GetAllowedApplications(context.Context, *GetAllowedApplicationsRequest) (*GetAllowedApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedApplications not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateAllowedApplication(context.Context, *CreateAllowedApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowedApplication not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropAllowedApplication(context.Context, *DropAllowedApplicationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropAllowedApplication not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) GetInternetResources(context.Context, *GetInternetResourcesRequest) (*GetInternetResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInternetResources not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) CreateInternetResource(context.Context, *CreateInternetResourceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInternetResource not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) DropInternetResource(context.Context, *DropInternetResourceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropInternetResource not implemented")
+}
+func (UnimplementedSecurityProfilesServiceServer) mustEmbedUnimplementedSecurityProfilesServiceServer() {
+}
+func (UnimplementedSecurityProfilesServiceServer) testEmbeddedByValue() {}
+
+// UnsafeSecurityProfilesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SecurityProfilesServiceServer will
+// result in compilation errors.
+type UnsafeSecurityProfilesServiceServer interface {
+	mustEmbedUnimplementedSecurityProfilesServiceServer()
+}
+
+func RegisterSecurityProfilesServiceServer(s grpc.ServiceRegistrar, srv SecurityProfilesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSecurityProfilesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SecurityProfilesService_ServiceDesc, srv)
+}
+
+func _SecurityProfilesService_GetSecurityProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecurityProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetSecurityProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetSecurityProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetSecurityProfiles(ctx, req.(*GetSecurityProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateSecurityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecurityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateSecurityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateSecurityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateSecurityProfile(ctx, req.(*CreateSecurityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_UpdateSecurityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecurityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).UpdateSecurityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_UpdateSecurityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).UpdateSecurityProfile(ctx, req.(*CreateSecurityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropSecurityProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropSecurityProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropSecurityProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropSecurityProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropSecurityProfile(ctx, req.(*DropSecurityProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_GetVirtualDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVirtualDirectoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetVirtualDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetVirtualDirectories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetVirtualDirectories(ctx, req.(*GetVirtualDirectoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateVirtualDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVirtualDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateVirtualDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateVirtualDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateVirtualDirectory(ctx, req.(*CreateVirtualDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropVirtualDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropVirtualDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropVirtualDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropVirtualDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropVirtualDirectory(ctx, req.(*DropVirtualDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_GetCOMClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCOMClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetCOMClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetCOMClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetCOMClasses(ctx, req.(*GetCOMClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateCOMClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCOMClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateCOMClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateCOMClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateCOMClass(ctx, req.(*CreateCOMClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropCOMClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropCOMClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropCOMClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropCOMClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropCOMClass(ctx, req.(*DropCOMClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_GetAllowedAddins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowedAddinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetAllowedAddins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetAllowedAddins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetAllowedAddins(ctx, req.(*GetAllowedAddinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateAllowedAddin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAllowedAddinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateAllowedAddin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateAllowedAddin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateAllowedAddin(ctx, req.(*CreateAllowedAddinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropAllowedAddin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAllowedAddinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropAllowedAddin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropAllowedAddin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropAllowedAddin(ctx, req.(*DropAllowedAddinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_GetExternalModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExternalModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetExternalModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetExternalModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetExternalModules(ctx, req.(*GetExternalModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateExternalModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExternalModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateExternalModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateExternalModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateExternalModule(ctx, req.(*CreateExternalModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropExternalModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropExternalModuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropExternalModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropExternalModule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropExternalModule(ctx, req.(*DropExternalModuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_GetAllowedApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowedApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetAllowedApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetAllowedApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetAllowedApplications(ctx, req.(*GetAllowedApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateAllowedApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAllowedApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateAllowedApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateAllowedApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateAllowedApplication(ctx, req.(*CreateAllowedApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropAllowedApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAllowedApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropAllowedApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropAllowedApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropAllowedApplication(ctx, req.(*DropAllowedApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_GetInternetResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInternetResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).GetInternetResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_GetInternetResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).GetInternetResources(ctx, req.(*GetInternetResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_CreateInternetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInternetResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).CreateInternetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_CreateInternetResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).CreateInternetResource(ctx, req.(*CreateInternetResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecurityProfilesService_DropInternetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropInternetResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityProfilesServiceServer).DropInternetResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityProfilesService_DropInternetResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityProfilesServiceServer).DropInternetResource(ctx, req.(*DropInternetResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SecurityProfilesService_ServiceDesc is the grpc.ServiceDesc for SecurityProfilesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SecurityProfilesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.SecurityProfilesService",
+	HandlerType: (*SecurityProfilesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSecurityProfiles",
+			Handler:    _SecurityProfilesService_GetSecurityProfiles_Handler,
+		},
+		{
+			MethodName: "CreateSecurityProfile",
+			Handler:    _SecurityProfilesService_CreateSecurityProfile_Handler,
+		},
+		{
+			MethodName: "UpdateSecurityProfile",
+			Handler:    _SecurityProfilesService_UpdateSecurityProfile_Handler,
+		},
+		{
+			MethodName: "DropSecurityProfile",
+			Handler:    _SecurityProfilesService_DropSecurityProfile_Handler,
+		},
+		{
+			MethodName: "GetVirtualDirectories",
+			Handler:    _SecurityProfilesService_GetVirtualDirectories_Handler,
+		},
+		{
+			MethodName: "CreateVirtualDirectory",
+			Handler:    _SecurityProfilesService_CreateVirtualDirectory_Handler,
+		},
+		{
+			MethodName: "DropVirtualDirectory",
+			Handler:    _SecurityProfilesService_DropVirtualDirectory_Handler,
+		},
+		{
+			MethodName: "GetCOMClasses",
+			Handler:    _SecurityProfilesService_GetCOMClasses_Handler,
+		},
+		{
+			MethodName: "CreateCOMClass",
+			Handler:    _SecurityProfilesService_CreateCOMClass_Handler,
+		},
+		{
+			MethodName: "DropCOMClass",
+			Handler:    _SecurityProfilesService_DropCOMClass_Handler,
+		},
+		{
+			MethodName: "GetAllowedAddins",
+			Handler:    _SecurityProfilesService_GetAllowedAddins_Handler,
+		},
+		{
+			MethodName: "CreateAllowedAddin",
+			Handler:    _SecurityProfilesService_CreateAllowedAddin_Handler,
+		},
+		{
+			MethodName: "DropAllowedAddin",
+			Handler:    _SecurityProfilesService_DropAllowedAddin_Handler,
+		},
+		{
+			MethodName: "GetExternalModules",
+			Handler:    _SecurityProfilesService_GetExternalModules_Handler,
+		},
+		{
+			MethodName: "CreateExternalModule",
+			Handler:    _SecurityProfilesService_CreateExternalModule_Handler,
+		},
+		{
+			MethodName: "DropExternalModule",
+			Handler:    _SecurityProfilesService_DropExternalModule_Handler,
+		},
+		{
+			MethodName: "GetAllowedApplications",
+			Handler:    _SecurityProfilesService_GetAllowedApplications_Handler,
+		},
+		{
+			MethodName: "CreateAllowedApplication",
+			Handler:    _SecurityProfilesService_CreateAllowedApplication_Handler,
+		},
+		{
+			MethodName: "DropAllowedApplication",
+			Handler:    _SecurityProfilesService_DropAllowedApplication_Handler,
+		},
+		{
+			MethodName: "GetInternetResources",
+			Handler:    _SecurityProfilesService_GetInternetResources_Handler,
+		},
+		{
+			MethodName: "CreateInternetResource",
+			Handler:    _SecurityProfilesService_CreateInternetResource_Handler,
+		},
+		{
+			MethodName: "DropInternetResource",
+			Handler:    _SecurityProfilesService_DropInternetResource_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/security.proto",
+}
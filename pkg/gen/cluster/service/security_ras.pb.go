@@ -0,0 +1,1473 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	io "io"
+)
+
+func (x *SecurityProfileInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.SafeModeProfile opts: order:3
+	if err := codec256.ParseBool(reader, &x.SafeModeProfile); err != nil {
+		return err
+	}
+	// decode x.FullPrivilegedMode opts: order:4
+	if err := codec256.ParseBool(reader, &x.FullPrivilegedMode); err != nil {
+		return err
+	}
+	// decode x.PrivilegedModeInSafeModeAllowed opts: order:5
+	if err := codec256.ParseBool(reader, &x.PrivilegedModeInSafeModeAllowed); err != nil {
+		return err
+	}
+	// decode x.PrivilegedModeRoles opts: order:6
+	if err := codec256.ParseString(reader, &x.PrivilegedModeRoles); err != nil {
+		return err
+	}
+	// decode x.CryptographyAllowed opts: order:7
+	if err := codec256.ParseBool(reader, &x.CryptographyAllowed); err != nil {
+		return err
+	}
+	// decode x.RightExtension opts: order:8
+	if err := codec256.ParseBool(reader, &x.RightExtension); err != nil {
+		return err
+	}
+	// decode x.RightExtensionDefinitionRoles opts: order:9
+	if err := codec256.ParseString(reader, &x.RightExtensionDefinitionRoles); err != nil {
+		return err
+	}
+	// decode x.AllModulesExtension opts: order:10
+	if err := codec256.ParseBool(reader, &x.AllModulesExtension); err != nil {
+		return err
+	}
+	// decode x.ModulesAvailableForExtension opts: order:11
+	if err := codec256.ParseString(reader, &x.ModulesAvailableForExtension); err != nil {
+		return err
+	}
+	// decode x.ModulesNotAvailableForExtension opts: order:12
+	if err := codec256.ParseString(reader, &x.ModulesNotAvailableForExtension); err != nil {
+		return err
+	}
+	// decode x.FileSystemFullAccess opts: order:13
+	if err := codec256.ParseBool(reader, &x.FileSystemFullAccess); err != nil {
+		return err
+	}
+	// decode x.ComFullAccess opts: order:14
+	if err := codec256.ParseBool(reader, &x.ComFullAccess); err != nil {
+		return err
+	}
+	// decode x.AddinFullAccess opts: order:15
+	if err := codec256.ParseBool(reader, &x.AddinFullAccess); err != nil {
+		return err
+	}
+	// decode x.ModuleFullAccess opts: order:16
+	if err := codec256.ParseBool(reader, &x.ModuleFullAccess); err != nil {
+		return err
+	}
+	// decode x.ApplicationFullAccess opts: order:17
+	if err := codec256.ParseBool(reader, &x.ApplicationFullAccess); err != nil {
+		return err
+	}
+	// decode x.InternetFullAccess opts: order:18
+	if err := codec256.ParseBool(reader, &x.InternetFullAccess); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *SecurityProfileInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.SafeModeProfile opts: order:3
+	if err := codec256.FormatBool(writer, x.SafeModeProfile); err != nil {
+		return err
+	}
+	// decode x.FullPrivilegedMode opts: order:4
+	if err := codec256.FormatBool(writer, x.FullPrivilegedMode); err != nil {
+		return err
+	}
+	// decode x.PrivilegedModeInSafeModeAllowed opts: order:5
+	if err := codec256.FormatBool(writer, x.PrivilegedModeInSafeModeAllowed); err != nil {
+		return err
+	}
+	// decode x.PrivilegedModeRoles opts: order:6
+	if err := codec256.FormatString(writer, x.PrivilegedModeRoles); err != nil {
+		return err
+	}
+	// decode x.CryptographyAllowed opts: order:7
+	if err := codec256.FormatBool(writer, x.CryptographyAllowed); err != nil {
+		return err
+	}
+	// decode x.RightExtension opts: order:8
+	if err := codec256.FormatBool(writer, x.RightExtension); err != nil {
+		return err
+	}
+	// decode x.RightExtensionDefinitionRoles opts: order:9
+	if err := codec256.FormatString(writer, x.RightExtensionDefinitionRoles); err != nil {
+		return err
+	}
+	// decode x.AllModulesExtension opts: order:10
+	if err := codec256.FormatBool(writer, x.AllModulesExtension); err != nil {
+		return err
+	}
+	// decode x.ModulesAvailableForExtension opts: order:11
+	if err := codec256.FormatString(writer, x.ModulesAvailableForExtension); err != nil {
+		return err
+	}
+	// decode x.ModulesNotAvailableForExtension opts: order:12
+	if err := codec256.FormatString(writer, x.ModulesNotAvailableForExtension); err != nil {
+		return err
+	}
+	// decode x.FileSystemFullAccess opts: order:13
+	if err := codec256.FormatBool(writer, x.FileSystemFullAccess); err != nil {
+		return err
+	}
+	// decode x.ComFullAccess opts: order:14
+	if err := codec256.FormatBool(writer, x.ComFullAccess); err != nil {
+		return err
+	}
+	// decode x.AddinFullAccess opts: order:15
+	if err := codec256.FormatBool(writer, x.AddinFullAccess); err != nil {
+		return err
+	}
+	// decode x.ModuleFullAccess opts: order:16
+	if err := codec256.FormatBool(writer, x.ModuleFullAccess); err != nil {
+		return err
+	}
+	// decode x.ApplicationFullAccess opts: order:17
+	if err := codec256.FormatBool(writer, x.ApplicationFullAccess); err != nil {
+		return err
+	}
+	// decode x.InternetFullAccess opts: order:18
+	if err := codec256.FormatBool(writer, x.InternetFullAccess); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *VirtualDirectoryInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Alias opts: order:1
+	if err := codec256.ParseString(reader, &x.Alias); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.PhysicalPath opts: order:3
+	if err := codec256.ParseString(reader, &x.PhysicalPath); err != nil {
+		return err
+	}
+	// decode x.AllowedRead opts: order:4
+	if err := codec256.ParseBool(reader, &x.AllowedRead); err != nil {
+		return err
+	}
+	// decode x.AllowedWrite opts: order:5
+	if err := codec256.ParseBool(reader, &x.AllowedWrite); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *VirtualDirectoryInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Alias opts: order:1
+	if err := codec256.FormatString(writer, x.Alias); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.PhysicalPath opts: order:3
+	if err := codec256.FormatString(writer, x.PhysicalPath); err != nil {
+		return err
+	}
+	// decode x.AllowedRead opts: order:4
+	if err := codec256.FormatBool(writer, x.AllowedRead); err != nil {
+		return err
+	}
+	// decode x.AllowedWrite opts: order:5
+	if err := codec256.FormatBool(writer, x.AllowedWrite); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *COMClassInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.FileName opts: order:3
+	if err := codec256.ParseString(reader, &x.FileName); err != nil {
+		return err
+	}
+	// decode x.ObjectUuid opts: encoder:"uuid" order:4
+	if err := codec256.ParseUUID(reader, &x.ObjectUuid); err != nil {
+		return err
+	}
+	// decode x.ComputerName opts: order:5
+	if err := codec256.ParseString(reader, &x.ComputerName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *COMClassInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.FileName opts: order:3
+	if err := codec256.FormatString(writer, x.FileName); err != nil {
+		return err
+	}
+	// decode x.ObjectUuid opts: encoder:"uuid" order:4
+	if err := codec256.FormatUuid(writer, x.ObjectUuid); err != nil {
+		return err
+	}
+	// decode x.ComputerName opts: order:5
+	if err := codec256.FormatString(writer, x.ComputerName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *AddinInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.Hash opts: order:3
+	if err := codec256.ParseString(reader, &x.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *AddinInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.Hash opts: order:3
+	if err := codec256.FormatString(writer, x.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ExternalModuleInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.Hash opts: order:3
+	if err := codec256.ParseString(reader, &x.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ExternalModuleInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.Hash opts: order:3
+	if err := codec256.FormatString(writer, x.Hash); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ApplicationInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.Wild opts: order:3
+	if err := codec256.ParseString(reader, &x.Wild); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *ApplicationInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.Wild opts: order:3
+	if err := codec256.FormatString(writer, x.Wild); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *InternetResourceInfo) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.ParseString(reader, &x.Descr); err != nil {
+		return err
+	}
+	// decode x.Protocol opts: order:3
+	if err := codec256.ParseString(reader, &x.Protocol); err != nil {
+		return err
+	}
+	// decode x.Url opts: order:4
+	if err := codec256.ParseString(reader, &x.Url); err != nil {
+		return err
+	}
+	// decode x.Port opts: order:5
+	if err := codec256.ParseInt(reader, &x.Port); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *InternetResourceInfo) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Name opts: order:1
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	// decode x.Descr opts: order:2
+	if err := codec256.FormatString(writer, x.Descr); err != nil {
+		return err
+	}
+	// decode x.Protocol opts: order:3
+	if err := codec256.FormatString(writer, x.Protocol); err != nil {
+		return err
+	}
+	// decode x.Url opts: order:4
+	if err := codec256.FormatString(writer, x.Url); err != nil {
+		return err
+	}
+	// decode x.Port opts: order:5
+	if err := codec256.FormatInt(writer, x.Port); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetSecurityProfilesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_SECURITY_PROFILES_REQUEST
+}
+
+func (x *GetSecurityProfilesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetSecurityProfilesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetSecurityProfilesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_SECURITY_PROFILES_RESPONSE
+}
+
+func (x *GetSecurityProfilesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Profiles opts: order:1
+	var size_Profiles int
+	if err := codec256.ParseSize(reader, &size_Profiles); err != nil {
+		return err
+	}
+	for i := 0; i < size_Profiles; i++ {
+		val := &SecurityProfileInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Profiles = append(x.Profiles, val)
+	}
+	return nil
+}
+func (x *GetSecurityProfilesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Profiles opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Profiles)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Profiles); i++ {
+		if err := x.Profiles[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateSecurityProfileRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_SECURITY_PROFILE_REQUEST
+}
+
+func (x *CreateSecurityProfileRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Profile opts: order:2
+	x.Profile = &SecurityProfileInfo{}
+	if err := x.Profile.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateSecurityProfileRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Profile opts: order:2
+	if err := x.Profile.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropSecurityProfileRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_SECURITY_PROFILE_REQUEST
+}
+
+func (x *DropSecurityProfileRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropSecurityProfileRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:2
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetVirtualDirectoriesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_VIRTUAL_DIRECTORIES_REQUEST
+}
+
+func (x *GetVirtualDirectoriesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetVirtualDirectoriesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetVirtualDirectoriesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_VIRTUAL_DIRECTORIES_RESPONSE
+}
+
+func (x *GetVirtualDirectoriesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Directories opts: order:1
+	var size_Directories int
+	if err := codec256.ParseSize(reader, &size_Directories); err != nil {
+		return err
+	}
+	for i := 0; i < size_Directories; i++ {
+		val := &VirtualDirectoryInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Directories = append(x.Directories, val)
+	}
+	return nil
+}
+func (x *GetVirtualDirectoriesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Directories opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Directories)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Directories); i++ {
+		if err := x.Directories[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateVirtualDirectoryRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_VIRTUAL_DIRECTORY_REQUEST
+}
+
+func (x *CreateVirtualDirectoryRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Directory opts: order:3
+	x.Directory = &VirtualDirectoryInfo{}
+	if err := x.Directory.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateVirtualDirectoryRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Directory opts: order:3
+	if err := x.Directory.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropVirtualDirectoryRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_VIRTUAL_DIRECTORY_REQUEST
+}
+
+func (x *DropVirtualDirectoryRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Alias opts: order:3
+	if err := codec256.ParseString(reader, &x.Alias); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropVirtualDirectoryRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Alias opts: order:3
+	if err := codec256.FormatString(writer, x.Alias); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCOMClassesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_COM_CLASSES_REQUEST
+}
+
+func (x *GetCOMClassesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCOMClassesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetCOMClassesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_COM_CLASSES_RESPONSE
+}
+
+func (x *GetCOMClassesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Classes opts: order:1
+	var size_Classes int
+	if err := codec256.ParseSize(reader, &size_Classes); err != nil {
+		return err
+	}
+	for i := 0; i < size_Classes; i++ {
+		val := &COMClassInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Classes = append(x.Classes, val)
+	}
+	return nil
+}
+func (x *GetCOMClassesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Classes opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Classes)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Classes); i++ {
+		if err := x.Classes[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateCOMClassRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_COM_CLASS_REQUEST
+}
+
+func (x *CreateCOMClassRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Class opts: order:3
+	x.Class = &COMClassInfo{}
+	if err := x.Class.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateCOMClassRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Class opts: order:3
+	if err := x.Class.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropCOMClassRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_COM_CLASS_REQUEST
+}
+
+func (x *DropCOMClassRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropCOMClassRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAllowedAddinsRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ALLOWED_ADDINS_REQUEST
+}
+
+func (x *GetAllowedAddinsRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAllowedAddinsRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAllowedAddinsResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ALLOWED_ADDINS_RESPONSE
+}
+
+func (x *GetAllowedAddinsResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Addins opts: order:1
+	var size_Addins int
+	if err := codec256.ParseSize(reader, &size_Addins); err != nil {
+		return err
+	}
+	for i := 0; i < size_Addins; i++ {
+		val := &AddinInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Addins = append(x.Addins, val)
+	}
+	return nil
+}
+func (x *GetAllowedAddinsResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Addins opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Addins)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Addins); i++ {
+		if err := x.Addins[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateAllowedAddinRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_ALLOWED_ADDIN_REQUEST
+}
+
+func (x *CreateAllowedAddinRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Addin opts: order:3
+	x.Addin = &AddinInfo{}
+	if err := x.Addin.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateAllowedAddinRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Addin opts: order:3
+	if err := x.Addin.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropAllowedAddinRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_ALLOWED_ADDIN_REQUEST
+}
+
+func (x *DropAllowedAddinRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropAllowedAddinRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetExternalModulesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_EXTERNAL_MODULES_REQUEST
+}
+
+func (x *GetExternalModulesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetExternalModulesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetExternalModulesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_EXTERNAL_MODULES_RESPONSE
+}
+
+func (x *GetExternalModulesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Modules opts: order:1
+	var size_Modules int
+	if err := codec256.ParseSize(reader, &size_Modules); err != nil {
+		return err
+	}
+	for i := 0; i < size_Modules; i++ {
+		val := &ExternalModuleInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Modules = append(x.Modules, val)
+	}
+	return nil
+}
+func (x *GetExternalModulesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Modules opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Modules)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Modules); i++ {
+		if err := x.Modules[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateExternalModuleRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_EXTERNAL_MODULE_REQUEST
+}
+
+func (x *CreateExternalModuleRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Module opts: order:3
+	x.Module = &ExternalModuleInfo{}
+	if err := x.Module.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateExternalModuleRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Module opts: order:3
+	if err := x.Module.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropExternalModuleRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_EXTERNAL_MODULE_REQUEST
+}
+
+func (x *DropExternalModuleRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropExternalModuleRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAllowedApplicationsRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ALLOWED_APPLICATIONS_REQUEST
+}
+
+func (x *GetAllowedApplicationsRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAllowedApplicationsRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetAllowedApplicationsResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_ALLOWED_APPLICATIONS_RESPONSE
+}
+
+func (x *GetAllowedApplicationsResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Applications opts: order:1
+	var size_Applications int
+	if err := codec256.ParseSize(reader, &size_Applications); err != nil {
+		return err
+	}
+	for i := 0; i < size_Applications; i++ {
+		val := &ApplicationInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Applications = append(x.Applications, val)
+	}
+	return nil
+}
+func (x *GetAllowedApplicationsResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Applications opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Applications)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Applications); i++ {
+		if err := x.Applications[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateAllowedApplicationRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_ALLOWED_APPLICATION_REQUEST
+}
+
+func (x *CreateAllowedApplicationRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Application opts: order:3
+	x.Application = &ApplicationInfo{}
+	if err := x.Application.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateAllowedApplicationRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Application opts: order:3
+	if err := x.Application.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropAllowedApplicationRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_ALLOWED_APPLICATION_REQUEST
+}
+
+func (x *DropAllowedApplicationRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropAllowedApplicationRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInternetResourcesRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INTERNET_RESOURCES_REQUEST
+}
+
+func (x *GetInternetResourcesRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInternetResourcesRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetInternetResourcesResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_INTERNET_RESOURCES_RESPONSE
+}
+
+func (x *GetInternetResourcesResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Resources opts: order:1
+	var size_Resources int
+	if err := codec256.ParseSize(reader, &size_Resources); err != nil {
+		return err
+	}
+	for i := 0; i < size_Resources; i++ {
+		val := &InternetResourceInfo{}
+		if err := val.Parse(reader, version); err != nil {
+			return err
+		}
+
+		x.Resources = append(x.Resources, val)
+	}
+	return nil
+}
+func (x *GetInternetResourcesResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Resources opts: order:1
+	if err := codec256.FormatSize(writer, len(x.Resources)); err != nil {
+		return err
+	}
+	for i := 0; i < len(x.Resources); i++ {
+		if err := x.Resources[i].Formatter(writer, version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (x *CreateInternetResourceRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_CREATE_INTERNET_RESOURCE_REQUEST
+}
+
+func (x *CreateInternetResourceRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Resource opts: order:3
+	x.Resource = &InternetResourceInfo{}
+	if err := x.Resource.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *CreateInternetResourceRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Resource opts: order:3
+	if err := x.Resource.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropInternetResourceRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_DROP_INTERNET_RESOURCE_REQUEST
+}
+
+func (x *DropInternetResourceRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.ParseString(reader, &x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.ParseString(reader, &x.Name); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *DropInternetResourceRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.ProfileName opts: order:2
+	if err := codec256.FormatString(writer, x.ProfileName); err != nil {
+		return err
+	}
+	// decode x.Name opts: order:3
+	if err := codec256.FormatString(writer, x.Name); err != nil {
+		return err
+	}
+	return nil
+}
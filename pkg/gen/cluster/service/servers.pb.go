@@ -0,0 +1,959 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/servers.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DedicateManagers распределение сервисов по менеджерам кластера
+type DedicateManagers int32
+
+const (
+	// Все сервисы в одном менеджере
+	DedicateManagers_DEDICATE_MANAGERS_NONE DedicateManagers = 0
+	// Каждый сервис в отдельном менеджере
+	DedicateManagers_DEDICATE_MANAGERS_ALL DedicateManagers = 1
+)
+
+// Enum value maps for DedicateManagers.
+var (
+	DedicateManagers_name = map[int32]string{
+		0: "DEDICATE_MANAGERS_NONE",
+		1: "DEDICATE_MANAGERS_ALL",
+	}
+	DedicateManagers_value = map[string]int32{
+		"DEDICATE_MANAGERS_NONE": 0,
+		"DEDICATE_MANAGERS_ALL":  1,
+	}
+)
+
+func (x DedicateManagers) Enum() *DedicateManagers {
+	p := new(DedicateManagers)
+	*p = x
+	return p
+}
+
+func (x DedicateManagers) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DedicateManagers) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_servers_proto_enumTypes[0].Descriptor()
+}
+
+func (DedicateManagers) Type() protoreflect.EnumType {
+	return &file_cluster_service_servers_proto_enumTypes[0]
+}
+
+func (x DedicateManagers) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DedicateManagers.Descriptor instead.
+func (DedicateManagers) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{0}
+}
+
+// PortRange диапазон портов рабочих процессов
+type PortRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	High          int32                  `protobuf:"varint,1,opt,name=high,proto3" json:"high,omitempty"`
+	Low           int32                  `protobuf:"varint,2,opt,name=low,proto3" json:"low,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortRange) Reset() {
+	*x = PortRange{}
+	mi := &file_cluster_service_servers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortRange) ProtoMessage() {}
+
+func (x *PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
+func (*PortRange) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PortRange) GetHigh() int32 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *PortRange) GetLow() int32 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+// ServerInfo рабочий сервер кластера
+type ServerInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Uuid             string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	AgentHost        string                 `protobuf:"bytes,2,opt,name=agent_host,json=agentHost,proto3" json:"agent_host,omitempty"`
+	AgentPort        int32                  `protobuf:"varint,3,opt,name=agent_port,json=agentPort,proto3" json:"agent_port,omitempty"`
+	Name             string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MainServer       bool                   `protobuf:"varint,5,opt,name=main_server,json=mainServer,proto3" json:"main_server,omitempty"`
+	PortRanges       []*PortRange           `protobuf:"bytes,6,rep,name=port_ranges,json=portRanges,proto3" json:"port_ranges,omitempty"`
+	DedicateManagers DedicateManagers       `protobuf:"varint,7,opt,name=dedicate_managers,json=dedicateManagers,proto3,enum=cluster.service.DedicateManagers" json:"dedicate_managers,omitempty"`
+	// Количество ИБ на процесс
+	InfobasesLimit int32 `protobuf:"varint,8,opt,name=infobases_limit,json=infobasesLimit,proto3" json:"infobases_limit,omitempty"`
+	// Максимальный объем памяти рабочих процессов
+	MemoryLimit int64 `protobuf:"varint,9,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	// Количество соединений на процесс
+	ConnectionsLimit int32 `protobuf:"varint,10,opt,name=connections_limit,json=connectionsLimit,proto3" json:"connections_limit,omitempty"`
+	// Безопасный расход памяти рабочих процессов
+	SafeWorkingProcessesMemoryLimit int64 `protobuf:"varint,11,opt,name=safe_working_processes_memory_limit,json=safeWorkingProcessesMemoryLimit,proto3" json:"safe_working_processes_memory_limit,omitempty"`
+	// Безопасный расход памяти за один вызов
+	SafeCallMemoryLimit int64 `protobuf:"varint,12,opt,name=safe_call_memory_limit,json=safeCallMemoryLimit,proto3" json:"safe_call_memory_limit,omitempty"`
+	ClusterPort         int32 `protobuf:"varint,13,opt,name=cluster_port,json=clusterPort,proto3" json:"cluster_port,omitempty"`
+	// Критический объем памяти процессов
+	CriticalTotalMemory int64 `protobuf:"varint,14,opt,name=critical_total_memory,json=criticalTotalMemory,proto3" json:"critical_total_memory,omitempty"`
+	// Временно допустимый объем памяти процессов
+	TemporaryAllowedTotalMemory int64 `protobuf:"varint,15,opt,name=temporary_allowed_total_memory,json=temporaryAllowedTotalMemory,proto3" json:"temporary_allowed_total_memory,omitempty"`
+	// Интервал превышения допустимого объема памяти процессов, секунды
+	TemporaryAllowedTotalMemoryTimeLimit int64 `protobuf:"varint,16,opt,name=temporary_allowed_total_memory_time_limit,json=temporaryAllowedTotalMemoryTimeLimit,proto3" json:"temporary_allowed_total_memory_time_limit,omitempty"`
+	// Заполняется шлюзом
+	ClusterId     string `protobuf:"bytes,17,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	mi := &file_cluster_service_servers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServerInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetAgentHost() string {
+	if x != nil {
+		return x.AgentHost
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetAgentPort() int32 {
+	if x != nil {
+		return x.AgentPort
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetMainServer() bool {
+	if x != nil {
+		return x.MainServer
+	}
+	return false
+}
+
+func (x *ServerInfo) GetPortRanges() []*PortRange {
+	if x != nil {
+		return x.PortRanges
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetDedicateManagers() DedicateManagers {
+	if x != nil {
+		return x.DedicateManagers
+	}
+	return DedicateManagers_DEDICATE_MANAGERS_NONE
+}
+
+func (x *ServerInfo) GetInfobasesLimit() int32 {
+	if x != nil {
+		return x.InfobasesLimit
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetMemoryLimit() int64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetConnectionsLimit() int32 {
+	if x != nil {
+		return x.ConnectionsLimit
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetSafeWorkingProcessesMemoryLimit() int64 {
+	if x != nil {
+		return x.SafeWorkingProcessesMemoryLimit
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetSafeCallMemoryLimit() int64 {
+	if x != nil {
+		return x.SafeCallMemoryLimit
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetClusterPort() int32 {
+	if x != nil {
+		return x.ClusterPort
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetCriticalTotalMemory() int64 {
+	if x != nil {
+		return x.CriticalTotalMemory
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetTemporaryAllowedTotalMemory() int64 {
+	if x != nil {
+		return x.TemporaryAllowedTotalMemory
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetTemporaryAllowedTotalMemoryTimeLimit() int64 {
+	if x != nil {
+		return x.TemporaryAllowedTotalMemoryTimeLimit
+	}
+	return 0
+}
+
+func (x *ServerInfo) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetWorkingServersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingServersRequest) Reset() {
+	*x = GetWorkingServersRequest{}
+	mi := &file_cluster_service_servers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingServersRequest) ProtoMessage() {}
+
+func (x *GetWorkingServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingServersRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingServersRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetWorkingServersRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+type GetWorkingServersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Servers       []*ServerInfo          `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingServersResponse) Reset() {
+	*x = GetWorkingServersResponse{}
+	mi := &file_cluster_service_servers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingServersResponse) ProtoMessage() {}
+
+func (x *GetWorkingServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingServersResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkingServersResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWorkingServersResponse) GetServers() []*ServerInfo {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+type GetWorkingServerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingServerInfoRequest) Reset() {
+	*x = GetWorkingServerInfoRequest{}
+	mi := &file_cluster_service_servers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingServerInfoRequest) ProtoMessage() {}
+
+func (x *GetWorkingServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWorkingServerInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetWorkingServerInfoRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type GetWorkingServerInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *ServerInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingServerInfoResponse) Reset() {
+	*x = GetWorkingServerInfoResponse{}
+	mi := &file_cluster_service_servers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingServerInfoResponse) ProtoMessage() {}
+
+func (x *GetWorkingServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkingServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWorkingServerInfoResponse) GetInfo() *ServerInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// RegWorkingServerRequest регистрирует новый сервер (пустой uuid)
+// или изменяет параметры зарегистрированного
+type RegWorkingServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Info          *ServerInfo            `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegWorkingServerRequest) Reset() {
+	*x = RegWorkingServerRequest{}
+	mi := &file_cluster_service_servers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegWorkingServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegWorkingServerRequest) ProtoMessage() {}
+
+func (x *RegWorkingServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegWorkingServerRequest.ProtoReflect.Descriptor instead.
+func (*RegWorkingServerRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RegWorkingServerRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *RegWorkingServerRequest) GetInfo() *ServerInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type RegWorkingServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegWorkingServerResponse) Reset() {
+	*x = RegWorkingServerResponse{}
+	mi := &file_cluster_service_servers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegWorkingServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegWorkingServerResponse) ProtoMessage() {}
+
+func (x *RegWorkingServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegWorkingServerResponse.ProtoReflect.Descriptor instead.
+func (*RegWorkingServerResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RegWorkingServerResponse) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+type UnregWorkingServerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId      string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregWorkingServerRequest) Reset() {
+	*x = UnregWorkingServerRequest{}
+	mi := &file_cluster_service_servers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregWorkingServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregWorkingServerRequest) ProtoMessage() {}
+
+func (x *UnregWorkingServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregWorkingServerRequest.ProtoReflect.Descriptor instead.
+func (*UnregWorkingServerRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnregWorkingServerRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UnregWorkingServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+// UpdateWorkingServerRequest изменяет переданные параметры рабочего сервера,
+// остальные параметры сохраняются
+type UpdateWorkingServerRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ServerId  string                 `protobuf:"bytes,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	// Заменяет диапазоны портов, если не пусто
+	PortRanges                           []*PortRange      `protobuf:"bytes,3,rep,name=port_ranges,json=portRanges,proto3" json:"port_ranges,omitempty"`
+	InfobasesLimit                       *int32            `protobuf:"varint,4,opt,name=infobases_limit,json=infobasesLimit,proto3,oneof" json:"infobases_limit,omitempty"`
+	ConnectionsLimit                     *int32            `protobuf:"varint,5,opt,name=connections_limit,json=connectionsLimit,proto3,oneof" json:"connections_limit,omitempty"`
+	MemoryLimit                          *int64            `protobuf:"varint,6,opt,name=memory_limit,json=memoryLimit,proto3,oneof" json:"memory_limit,omitempty"`
+	SafeWorkingProcessesMemoryLimit      *int64            `protobuf:"varint,7,opt,name=safe_working_processes_memory_limit,json=safeWorkingProcessesMemoryLimit,proto3,oneof" json:"safe_working_processes_memory_limit,omitempty"`
+	SafeCallMemoryLimit                  *int64            `protobuf:"varint,8,opt,name=safe_call_memory_limit,json=safeCallMemoryLimit,proto3,oneof" json:"safe_call_memory_limit,omitempty"`
+	CriticalTotalMemory                  *int64            `protobuf:"varint,9,opt,name=critical_total_memory,json=criticalTotalMemory,proto3,oneof" json:"critical_total_memory,omitempty"`
+	TemporaryAllowedTotalMemory          *int64            `protobuf:"varint,10,opt,name=temporary_allowed_total_memory,json=temporaryAllowedTotalMemory,proto3,oneof" json:"temporary_allowed_total_memory,omitempty"`
+	TemporaryAllowedTotalMemoryTimeLimit *int64            `protobuf:"varint,11,opt,name=temporary_allowed_total_memory_time_limit,json=temporaryAllowedTotalMemoryTimeLimit,proto3,oneof" json:"temporary_allowed_total_memory_time_limit,omitempty"`
+	DedicateManagers                     *DedicateManagers `protobuf:"varint,12,opt,name=dedicate_managers,json=dedicateManagers,proto3,enum=cluster.service.DedicateManagers,oneof" json:"dedicate_managers,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
+}
+
+func (x *UpdateWorkingServerRequest) Reset() {
+	*x = UpdateWorkingServerRequest{}
+	mi := &file_cluster_service_servers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkingServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkingServerRequest) ProtoMessage() {}
+
+func (x *UpdateWorkingServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_servers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkingServerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkingServerRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_servers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateWorkingServerRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UpdateWorkingServerRequest) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *UpdateWorkingServerRequest) GetPortRanges() []*PortRange {
+	if x != nil {
+		return x.PortRanges
+	}
+	return nil
+}
+
+func (x *UpdateWorkingServerRequest) GetInfobasesLimit() int32 {
+	if x != nil && x.InfobasesLimit != nil {
+		return *x.InfobasesLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetConnectionsLimit() int32 {
+	if x != nil && x.ConnectionsLimit != nil {
+		return *x.ConnectionsLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetMemoryLimit() int64 {
+	if x != nil && x.MemoryLimit != nil {
+		return *x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetSafeWorkingProcessesMemoryLimit() int64 {
+	if x != nil && x.SafeWorkingProcessesMemoryLimit != nil {
+		return *x.SafeWorkingProcessesMemoryLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetSafeCallMemoryLimit() int64 {
+	if x != nil && x.SafeCallMemoryLimit != nil {
+		return *x.SafeCallMemoryLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetCriticalTotalMemory() int64 {
+	if x != nil && x.CriticalTotalMemory != nil {
+		return *x.CriticalTotalMemory
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetTemporaryAllowedTotalMemory() int64 {
+	if x != nil && x.TemporaryAllowedTotalMemory != nil {
+		return *x.TemporaryAllowedTotalMemory
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetTemporaryAllowedTotalMemoryTimeLimit() int64 {
+	if x != nil && x.TemporaryAllowedTotalMemoryTimeLimit != nil {
+		return *x.TemporaryAllowedTotalMemoryTimeLimit
+	}
+	return 0
+}
+
+func (x *UpdateWorkingServerRequest) GetDedicateManagers() DedicateManagers {
+	if x != nil && x.DedicateManagers != nil {
+		return *x.DedicateManagers
+	}
+	return DedicateManagers_DEDICATE_MANAGERS_NONE
+}
+
+var File_cluster_service_servers_proto protoreflect.FileDescriptor
+
+const file_cluster_service_servers_proto_rawDesc = "" +
+	"\n" +
+	"\x1dcluster/service/servers.proto\x12\x0fcluster.service\x1a\x1bgoogle/protobuf/empty.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\"S\n" +
+	"\tPortRange\x12#\n" +
+	"\x04high\x18\x01 \x01(\x05B\x0f\x82\xf5\xea\x94\x0e\t\n" +
+	"\x05short\x10\x01R\x04high\x12!\n" +
+	"\x03low\x18\x02 \x01(\x05B\x0f\x82\xf5\xea\x94\x0e\t\n" +
+	"\x05short\x10\x02R\x03low\"\xdb\a\n" +
+	"\n" +
+	"ServerInfo\x12\"\n" +
+	"\x04uuid\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\x04uuid\x12'\n" +
+	"\n" +
+	"agent_host\x18\x02 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\tagentHost\x12'\n" +
+	"\n" +
+	"agent_port\x18\x03 \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\tagentPort\x12\x1c\n" +
+	"\x04name\x18\x04 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x04R\x04name\x12)\n" +
+	"\vmain_server\x18\x05 \x01(\bB\b\x82\xf5\xea\x94\x0e\x02\x10\x05R\n" +
+	"mainServer\x12E\n" +
+	"\vport_ranges\x18\x06 \x03(\v2\x1a.cluster.service.PortRangeB\b\x82\xf5\xea\x94\x0e\x02\x10\x06R\n" +
+	"portRanges\x12]\n" +
+	"\x11dedicate_managers\x18\a \x01(\x0e2!.cluster.service.DedicateManagersB\r\x82\xf5\xea\x94\x0e\a\n" +
+	"\x03int\x10\aR\x10dedicateManagers\x121\n" +
+	"\x0finfobases_limit\x18\b \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\bR\x0einfobasesLimit\x12+\n" +
+	"\fmemory_limit\x18\t \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\tR\vmemoryLimit\x125\n" +
+	"\x11connections_limit\x18\n" +
+	" \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\n" +
+	"R\x10connectionsLimit\x12V\n" +
+	"#safe_working_processes_memory_limit\x18\v \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\vR\x1fsafeWorkingProcessesMemoryLimit\x12=\n" +
+	"\x16safe_call_memory_limit\x18\f \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\fR\x13safeCallMemoryLimit\x12+\n" +
+	"\fcluster_port\x18\r \x01(\x05B\b\x82\xf5\xea\x94\x0e\x02\x10\rR\vclusterPort\x12<\n" +
+	"\x15critical_total_memory\x18\x0e \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x0eR\x13criticalTotalMemory\x12M\n" +
+	"\x1etemporary_allowed_total_memory\x18\x0f \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x0fR\x1btemporaryAllowedTotalMemory\x12a\n" +
+	")temporary_allowed_total_memory_time_limit\x18\x10 \x01(\x03B\b\x82\xf5\xea\x94\x0e\x02\x10\x10R$temporaryAllowedTotalMemoryTimeLimit\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x11 \x01(\tR\tclusterId\"n\n" +
+	"\x18GetWorkingServersRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId:#\x8a\xf5\xea\x94\x0e\x1d:\x1bGET_WORKING_SERVERS_REQUEST\"\x82\x01\n" +
+	"\x19GetWorkingServersResponse\x12?\n" +
+	"\aservers\x18\x01 \x03(\v2\x1b.cluster.service.ServerInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\aservers:$\x8a\xf5\xea\x94\x0e\x1e:\x1cGET_WORKING_SERVERS_RESPONSE\"\xa2\x01\n" +
+	"\x1bGetWorkingServerInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId:'\x8a\xf5\xea\x94\x0e!:\x1fGET_WORKING_SERVER_INFO_REQUEST\"\x83\x01\n" +
+	"\x1cGetWorkingServerInfoResponse\x129\n" +
+	"\x04info\x18\x01 \x01(\v2\x1b.cluster.service.ServerInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:(\x8a\xf5\xea\x94\x0e\": GET_WORKING_SERVER_INFO_RESPONSE\"\xa7\x01\n" +
+	"\x17RegWorkingServerRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x129\n" +
+	"\x04info\x18\x02 \x01(\v2\x1b.cluster.service.ServerInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x02R\x04info:\"\x8a\xf5\xea\x94\x0e\x1c:\x1aREG_WORKING_SERVER_REQUEST\"l\n" +
+	"\x18RegWorkingServerResponse\x12+\n" +
+	"\tserver_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\bserverId:#\x8a\xf5\xea\x94\x0e\x1d:\x1bREG_WORKING_SERVER_RESPONSE\"\x9d\x01\n" +
+	"\x19UnregWorkingServerRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12+\n" +
+	"\tserver_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\bserverId:$\x8a\xf5\xea\x94\x0e\x1e:\x1cUNREG_WORKING_SERVER_REQUEST\"\xdf\a\n" +
+	"\x1aUpdateWorkingServerRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1b\n" +
+	"\tserver_id\x18\x02 \x01(\tR\bserverId\x12;\n" +
+	"\vport_ranges\x18\x03 \x03(\v2\x1a.cluster.service.PortRangeR\n" +
+	"portRanges\x12,\n" +
+	"\x0finfobases_limit\x18\x04 \x01(\x05H\x00R\x0einfobasesLimit\x88\x01\x01\x120\n" +
+	"\x11connections_limit\x18\x05 \x01(\x05H\x01R\x10connectionsLimit\x88\x01\x01\x12&\n" +
+	"\fmemory_limit\x18\x06 \x01(\x03H\x02R\vmemoryLimit\x88\x01\x01\x12Q\n" +
+	"#safe_working_processes_memory_limit\x18\a \x01(\x03H\x03R\x1fsafeWorkingProcessesMemoryLimit\x88\x01\x01\x128\n" +
+	"\x16safe_call_memory_limit\x18\b \x01(\x03H\x04R\x13safeCallMemoryLimit\x88\x01\x01\x127\n" +
+	"\x15critical_total_memory\x18\t \x01(\x03H\x05R\x13criticalTotalMemory\x88\x01\x01\x12H\n" +
+	"\x1etemporary_allowed_total_memory\x18\n" +
+	" \x01(\x03H\x06R\x1btemporaryAllowedTotalMemory\x88\x01\x01\x12\\\n" +
+	")temporary_allowed_total_memory_time_limit\x18\v \x01(\x03H\aR$temporaryAllowedTotalMemoryTimeLimit\x88\x01\x01\x12S\n" +
+	"\x11dedicate_managers\x18\f \x01(\x0e2!.cluster.service.DedicateManagersH\bR\x10dedicateManagers\x88\x01\x01B\x12\n" +
+	"\x10_infobases_limitB\x14\n" +
+	"\x12_connections_limitB\x0f\n" +
+	"\r_memory_limitB&\n" +
+	"$_safe_working_processes_memory_limitB\x19\n" +
+	"\x17_safe_call_memory_limitB\x18\n" +
+	"\x16_critical_total_memoryB!\n" +
+	"\x1f_temporary_allowed_total_memoryB,\n" +
+	"*_temporary_allowed_total_memory_time_limitB\x14\n" +
+	"\x12_dedicate_managers*I\n" +
+	"\x10DedicateManagers\x12\x1a\n" +
+	"\x16DEDICATE_MANAGERS_NONE\x10\x00\x12\x19\n" +
+	"\x15DEDICATE_MANAGERS_ALL\x10\x012\x9c\x04\n" +
+	"\x15WorkingServersService\x12j\n" +
+	"\x11GetWorkingServers\x12).cluster.service.GetWorkingServersRequest\x1a*.cluster.service.GetWorkingServersResponse\x12s\n" +
+	"\x14GetWorkingServerInfo\x12,.cluster.service.GetWorkingServerInfoRequest\x1a-.cluster.service.GetWorkingServerInfoResponse\x12g\n" +
+	"\x10RegWorkingServer\x12(.cluster.service.RegWorkingServerRequest\x1a).cluster.service.RegWorkingServerResponse\x12_\n" +
+	"\x13UpdateWorkingServer\x12+.cluster.service.UpdateWorkingServerRequest\x1a\x1b.cluster.service.ServerInfo\x12X\n" +
+	"\x12UnregWorkingServer\x12*.cluster.service.UnregWorkingServerRequest\x1a\x16.google.protobuf.EmptyB\xbb\x01\n" +
+	"\x13com.cluster.serviceB\fServersProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_servers_proto_rawDescOnce sync.Once
+	file_cluster_service_servers_proto_rawDescData []byte
+)
+
+func file_cluster_service_servers_proto_rawDescGZIP() []byte {
+	file_cluster_service_servers_proto_rawDescOnce.Do(func() {
+		file_cluster_service_servers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_servers_proto_rawDesc), len(file_cluster_service_servers_proto_rawDesc)))
+	})
+	return file_cluster_service_servers_proto_rawDescData
+}
+
+var file_cluster_service_servers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cluster_service_servers_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_cluster_service_servers_proto_goTypes = []any{
+	(DedicateManagers)(0),                // 0: cluster.service.DedicateManagers
+	(*PortRange)(nil),                    // 1: cluster.service.PortRange
+	(*ServerInfo)(nil),                   // 2: cluster.service.ServerInfo
+	(*GetWorkingServersRequest)(nil),     // 3: cluster.service.GetWorkingServersRequest
+	(*GetWorkingServersResponse)(nil),    // 4: cluster.service.GetWorkingServersResponse
+	(*GetWorkingServerInfoRequest)(nil),  // 5: cluster.service.GetWorkingServerInfoRequest
+	(*GetWorkingServerInfoResponse)(nil), // 6: cluster.service.GetWorkingServerInfoResponse
+	(*RegWorkingServerRequest)(nil),      // 7: cluster.service.RegWorkingServerRequest
+	(*RegWorkingServerResponse)(nil),     // 8: cluster.service.RegWorkingServerResponse
+	(*UnregWorkingServerRequest)(nil),    // 9: cluster.service.UnregWorkingServerRequest
+	(*UpdateWorkingServerRequest)(nil),   // 10: cluster.service.UpdateWorkingServerRequest
+	(*emptypb.Empty)(nil),                // 11: google.protobuf.Empty
+}
+var file_cluster_service_servers_proto_depIdxs = []int32{
+	1,  // 0: cluster.service.ServerInfo.port_ranges:type_name -> cluster.service.PortRange
+	0,  // 1: cluster.service.ServerInfo.dedicate_managers:type_name -> cluster.service.DedicateManagers
+	2,  // 2: cluster.service.GetWorkingServersResponse.servers:type_name -> cluster.service.ServerInfo
+	2,  // 3: cluster.service.GetWorkingServerInfoResponse.info:type_name -> cluster.service.ServerInfo
+	2,  // 4: cluster.service.RegWorkingServerRequest.info:type_name -> cluster.service.ServerInfo
+	1,  // 5: cluster.service.UpdateWorkingServerRequest.port_ranges:type_name -> cluster.service.PortRange
+	0,  // 6: cluster.service.UpdateWorkingServerRequest.dedicate_managers:type_name -> cluster.service.DedicateManagers
+	3,  // 7: cluster.service.WorkingServersService.GetWorkingServers:input_type -> cluster.service.GetWorkingServersRequest
+	5,  // 8: cluster.service.WorkingServersService.GetWorkingServerInfo:input_type -> cluster.service.GetWorkingServerInfoRequest
+	7,  // 9: cluster.service.WorkingServersService.RegWorkingServer:input_type -> cluster.service.RegWorkingServerRequest
+	10, // 10: cluster.service.WorkingServersService.UpdateWorkingServer:input_type -> cluster.service.UpdateWorkingServerRequest
+	9,  // 11: cluster.service.WorkingServersService.UnregWorkingServer:input_type -> cluster.service.UnregWorkingServerRequest
+	4,  // 12: cluster.service.WorkingServersService.GetWorkingServers:output_type -> cluster.service.GetWorkingServersResponse
+	6,  // 13: cluster.service.WorkingServersService.GetWorkingServerInfo:output_type -> cluster.service.GetWorkingServerInfoResponse
+	8,  // 14: cluster.service.WorkingServersService.RegWorkingServer:output_type -> cluster.service.RegWorkingServerResponse
+	2,  // 15: cluster.service.WorkingServersService.UpdateWorkingServer:output_type -> cluster.service.ServerInfo
+	11, // 16: cluster.service.WorkingServersService.UnregWorkingServer:output_type -> google.protobuf.Empty
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_servers_proto_init() }
+func file_cluster_service_servers_proto_init() {
+	if File_cluster_service_servers_proto != nil {
+		return
+	}
+	file_cluster_service_servers_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_servers_proto_rawDesc), len(file_cluster_service_servers_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_servers_proto_goTypes,
+		DependencyIndexes: file_cluster_service_servers_proto_depIdxs,
+		EnumInfos:         file_cluster_service_servers_proto_enumTypes,
+		MessageInfos:      file_cluster_service_servers_proto_msgTypes,
+	}.Build()
+	File_cluster_service_servers_proto = out.File
+	file_cluster_service_servers_proto_goTypes = nil
+	file_cluster_service_servers_proto_depIdxs = nil
+}
@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/servers.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WorkingServersService_GetWorkingServers_FullMethodName    = "/cluster.service.WorkingServersService/GetWorkingServers"
+	WorkingServersService_GetWorkingServerInfo_FullMethodName = "/cluster.service.WorkingServersService/GetWorkingServerInfo"
+	WorkingServersService_RegWorkingServer_FullMethodName     = "/cluster.service.WorkingServersService/RegWorkingServer"
+	WorkingServersService_UpdateWorkingServer_FullMethodName  = "/cluster.service.WorkingServersService/UpdateWorkingServer"
+	WorkingServersService_UnregWorkingServer_FullMethodName   = "/cluster.service.WorkingServersService/UnregWorkingServer"
+)
+
+// WorkingServersServiceClient is the client API for WorkingServersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// WorkingServersService рабочие серверы кластеров 1С
+type WorkingServersServiceClient interface {
+	// GetWorkingServers список рабочих серверов кластера
+	GetWorkingServers(ctx context.Context, in *GetWorkingServersRequest, opts ...grpc.CallOption) (*GetWorkingServersResponse, error)
+	// GetWorkingServerInfo информация о рабочем сервере
+	GetWorkingServerInfo(ctx context.Context, in *GetWorkingServerInfoRequest, opts ...grpc.CallOption) (*GetWorkingServerInfoResponse, error)
+	// RegWorkingServer регистрация рабочего сервера
+	RegWorkingServer(ctx context.Context, in *RegWorkingServerRequest, opts ...grpc.CallOption) (*RegWorkingServerResponse, error)
+	// UpdateWorkingServer изменение параметров рабочего сервера
+	UpdateWorkingServer(ctx context.Context, in *UpdateWorkingServerRequest, opts ...grpc.CallOption) (*ServerInfo, error)
+	// UnregWorkingServer отмена регистрации рабочего сервера
+	UnregWorkingServer(ctx context.Context, in *UnregWorkingServerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type workingServersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkingServersServiceClient(cc grpc.ClientConnInterface) WorkingServersServiceClient {
+	return &workingServersServiceClient{cc}
+}
+
+func (c *workingServersServiceClient) GetWorkingServers(ctx context.Context, in *GetWorkingServersRequest, opts ...grpc.CallOption) (*GetWorkingServersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkingServersResponse)
+	err := c.cc.Invoke(ctx, WorkingServersService_GetWorkingServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingServersServiceClient) GetWorkingServerInfo(ctx context.Context, in *GetWorkingServerInfoRequest, opts ...grpc.CallOption) (*GetWorkingServerInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkingServerInfoResponse)
+	err := c.cc.Invoke(ctx, WorkingServersService_GetWorkingServerInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingServersServiceClient) RegWorkingServer(ctx context.Context, in *RegWorkingServerRequest, opts ...grpc.CallOption) (*RegWorkingServerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegWorkingServerResponse)
+	err := c.cc.Invoke(ctx, WorkingServersService_RegWorkingServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingServersServiceClient) UpdateWorkingServer(ctx context.Context, in *UpdateWorkingServerRequest, opts ...grpc.CallOption) (*ServerInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, WorkingServersService_UpdateWorkingServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingServersServiceClient) UnregWorkingServer(ctx context.Context, in *UnregWorkingServerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WorkingServersService_UnregWorkingServer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkingServersServiceServer is the server API for WorkingServersService service.
+// All implementations must embed UnimplementedWorkingServersServiceServer
+// for forward compatibility.
+//
+// WorkingServersService рабочие серверы кластеров 1С
+type WorkingServersServiceServer interface {
+	// GetWorkingServers список рабочих серверов кластера
+	GetWorkingServers(context.Context, *GetWorkingServersRequest) (*GetWorkingServersResponse, error)
+	// GetWorkingServerInfo информация о рабочем сервере
+	GetWorkingServerInfo(context.Context, *GetWorkingServerInfoRequest) (*GetWorkingServerInfoResponse, error)
+	// RegWorkingServer регистрация рабочего сервера
+	RegWorkingServer(context.Context, *RegWorkingServerRequest) (*RegWorkingServerResponse, error)
+	// UpdateWorkingServer изменение параметров рабочего сервера
+	UpdateWorkingServer(context.Context, *UpdateWorkingServerRequest) (*ServerInfo, error)
+	// UnregWorkingServer отмена регистрации рабочего сервера
+	UnregWorkingServer(context.Context, *UnregWorkingServerRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedWorkingServersServiceServer()
+}
+
+// UnimplementedWorkingServersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWorkingServersServiceServer struct{}
+
+func (UnimplementedWorkingServersServiceServer) GetWorkingServers(context.Context, *GetWorkingServersRequest) (*GetWorkingServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingServers not implemented")
+}
+func (UnimplementedWorkingServersServiceServer) GetWorkingServerInfo(context.Context, *GetWorkingServerInfoRequest) (*GetWorkingServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingServerInfo not implemented")
+}
+func (UnimplementedWorkingServersServiceServer) RegWorkingServer(context.Context, *RegWorkingServerRequest) (*RegWorkingServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegWorkingServer not implemented")
+}
+func (UnimplementedWorkingServersServiceServer) UpdateWorkingServer(context.Context, *UpdateWorkingServerRequest) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkingServer not implemented")
+}
+func (UnimplementedWorkingServersServiceServer) UnregWorkingServer(context.Context, *UnregWorkingServerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregWorkingServer not implemented")
+}
+func (UnimplementedWorkingServersServiceServer) mustEmbedUnimplementedWorkingServersServiceServer() {}
+func (UnimplementedWorkingServersServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeWorkingServersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkingServersServiceServer will
+// result in compilation errors.
+type UnsafeWorkingServersServiceServer interface {
+	mustEmbedUnimplementedWorkingServersServiceServer()
+}
+
+func RegisterWorkingServersServiceServer(s grpc.ServiceRegistrar, srv WorkingServersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWorkingServersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WorkingServersService_ServiceDesc, srv)
+}
+
+func _WorkingServersService_GetWorkingServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingServersServiceServer).GetWorkingServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingServersService_GetWorkingServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingServersServiceServer).GetWorkingServers(ctx, req.(*GetWorkingServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingServersService_GetWorkingServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingServersServiceServer).GetWorkingServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingServersService_GetWorkingServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingServersServiceServer).GetWorkingServerInfo(ctx, req.(*GetWorkingServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingServersService_RegWorkingServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegWorkingServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingServersServiceServer).RegWorkingServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingServersService_RegWorkingServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingServersServiceServer).RegWorkingServer(ctx, req.(*RegWorkingServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingServersService_UpdateWorkingServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkingServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingServersServiceServer).UpdateWorkingServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingServersService_UpdateWorkingServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingServersServiceServer).UpdateWorkingServer(ctx, req.(*UpdateWorkingServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingServersService_UnregWorkingServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregWorkingServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingServersServiceServer).UnregWorkingServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingServersService_UnregWorkingServer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingServersServiceServer).UnregWorkingServer(ctx, req.(*UnregWorkingServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkingServersService_ServiceDesc is the grpc.ServiceDesc for WorkingServersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkingServersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.WorkingServersService",
+	HandlerType: (*WorkingServersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkingServers",
+			Handler:    _WorkingServersService_GetWorkingServers_Handler,
+		},
+		{
+			MethodName: "GetWorkingServerInfo",
+			Handler:    _WorkingServersService_GetWorkingServerInfo_Handler,
+		},
+		{
+			MethodName: "RegWorkingServer",
+			Handler:    _WorkingServersService_RegWorkingServer_Handler,
+		},
+		{
+			MethodName: "UpdateWorkingServer",
+			Handler:    _WorkingServersService_UpdateWorkingServer_Handler,
+		},
+		{
+			MethodName: "UnregWorkingServer",
+			Handler:    _WorkingServersService_UnregWorkingServer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cluster/service/servers.proto",
+}
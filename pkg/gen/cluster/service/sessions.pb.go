@@ -0,0 +1,1319 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: cluster/service/sessions.proto
+
+package service
+
+import (
+	_ "github.com/v8platform/protoc-gen-go-ras/plugin/ras/encoding"
+	_ "github.com/v8platform/protos/gen/ras/messages/v1"
+	v1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SessionLicenseType тип лицензии, выданной сеансу
+type SessionLicenseType int32
+
+const (
+	SessionLicenseType_SESSION_LICENSE_TYPE_UNSPECIFIED SessionLicenseType = 0
+	SessionLicenseType_SESSION_LICENSE_TYPE_NONE        SessionLicenseType = 1 // Сеанс без лицензии
+	SessionLicenseType_SESSION_LICENSE_TYPE_SOFTWARE    SessionLicenseType = 2 // Программная лицензия
+	SessionLicenseType_SESSION_LICENSE_TYPE_HASP        SessionLicenseType = 3 // Аппаратный ключ HASP
+)
+
+// Enum value maps for SessionLicenseType.
+var (
+	SessionLicenseType_name = map[int32]string{
+		0: "SESSION_LICENSE_TYPE_UNSPECIFIED",
+		1: "SESSION_LICENSE_TYPE_NONE",
+		2: "SESSION_LICENSE_TYPE_SOFTWARE",
+		3: "SESSION_LICENSE_TYPE_HASP",
+	}
+	SessionLicenseType_value = map[string]int32{
+		"SESSION_LICENSE_TYPE_UNSPECIFIED": 0,
+		"SESSION_LICENSE_TYPE_NONE":        1,
+		"SESSION_LICENSE_TYPE_SOFTWARE":    2,
+		"SESSION_LICENSE_TYPE_HASP":        3,
+	}
+)
+
+func (x SessionLicenseType) Enum() *SessionLicenseType {
+	p := new(SessionLicenseType)
+	*p = x
+	return p
+}
+
+func (x SessionLicenseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionLicenseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_sessions_proto_enumTypes[0].Descriptor()
+}
+
+func (SessionLicenseType) Type() protoreflect.EnumType {
+	return &file_cluster_service_sessions_proto_enumTypes[0]
+}
+
+func (x SessionLicenseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionLicenseType.Descriptor instead.
+func (SessionLicenseType) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{0}
+}
+
+// SessionSortField поле сортировки сеансов
+type SessionSortField int32
+
+const (
+	SessionSortField_SESSION_SORT_FIELD_UNSPECIFIED    SessionSortField = 0 // По времени начала
+	SessionSortField_SESSION_SORT_FIELD_STARTED_AT     SessionSortField = 1
+	SessionSortField_SESSION_SORT_FIELD_LAST_ACTIVE_AT SessionSortField = 2
+	SessionSortField_SESSION_SORT_FIELD_USER_NAME      SessionSortField = 3
+	SessionSortField_SESSION_SORT_FIELD_APP_ID         SessionSortField = 4
+	SessionSortField_SESSION_SORT_FIELD_HOST           SessionSortField = 5
+	SessionSortField_SESSION_SORT_FIELD_SESSION_NUMBER SessionSortField = 6
+	SessionSortField_SESSION_SORT_FIELD_MEMORY_TOTAL   SessionSortField = 7
+)
+
+// Enum value maps for SessionSortField.
+var (
+	SessionSortField_name = map[int32]string{
+		0: "SESSION_SORT_FIELD_UNSPECIFIED",
+		1: "SESSION_SORT_FIELD_STARTED_AT",
+		2: "SESSION_SORT_FIELD_LAST_ACTIVE_AT",
+		3: "SESSION_SORT_FIELD_USER_NAME",
+		4: "SESSION_SORT_FIELD_APP_ID",
+		5: "SESSION_SORT_FIELD_HOST",
+		6: "SESSION_SORT_FIELD_SESSION_NUMBER",
+		7: "SESSION_SORT_FIELD_MEMORY_TOTAL",
+	}
+	SessionSortField_value = map[string]int32{
+		"SESSION_SORT_FIELD_UNSPECIFIED":    0,
+		"SESSION_SORT_FIELD_STARTED_AT":     1,
+		"SESSION_SORT_FIELD_LAST_ACTIVE_AT": 2,
+		"SESSION_SORT_FIELD_USER_NAME":      3,
+		"SESSION_SORT_FIELD_APP_ID":         4,
+		"SESSION_SORT_FIELD_HOST":           5,
+		"SESSION_SORT_FIELD_SESSION_NUMBER": 6,
+		"SESSION_SORT_FIELD_MEMORY_TOTAL":   7,
+	}
+)
+
+func (x SessionSortField) Enum() *SessionSortField {
+	p := new(SessionSortField)
+	*p = x
+	return p
+}
+
+func (x SessionSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_sessions_proto_enumTypes[1].Descriptor()
+}
+
+func (SessionSortField) Type() protoreflect.EnumType {
+	return &file_cluster_service_sessions_proto_enumTypes[1]
+}
+
+func (x SessionSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionSortField.Descriptor instead.
+func (SessionSortField) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{1}
+}
+
+// SessionTerminationStatus результат завершения сеанса
+type SessionTerminationStatus int32
+
+const (
+	SessionTerminationStatus_SESSION_TERMINATION_STATUS_UNSPECIFIED SessionTerminationStatus = 0
+	SessionTerminationStatus_SESSION_TERMINATION_STATUS_MATCHED     SessionTerminationStatus = 1 // dry_run: сеанс будет завершен
+	SessionTerminationStatus_SESSION_TERMINATION_STATUS_TERMINATED  SessionTerminationStatus = 2 // Сеанс завершен
+	SessionTerminationStatus_SESSION_TERMINATION_STATUS_FAILED      SessionTerminationStatus = 3 // Ошибка завершения, см. error
+)
+
+// Enum value maps for SessionTerminationStatus.
+var (
+	SessionTerminationStatus_name = map[int32]string{
+		0: "SESSION_TERMINATION_STATUS_UNSPECIFIED",
+		1: "SESSION_TERMINATION_STATUS_MATCHED",
+		2: "SESSION_TERMINATION_STATUS_TERMINATED",
+		3: "SESSION_TERMINATION_STATUS_FAILED",
+	}
+	SessionTerminationStatus_value = map[string]int32{
+		"SESSION_TERMINATION_STATUS_UNSPECIFIED": 0,
+		"SESSION_TERMINATION_STATUS_MATCHED":     1,
+		"SESSION_TERMINATION_STATUS_TERMINATED":  2,
+		"SESSION_TERMINATION_STATUS_FAILED":      3,
+	}
+)
+
+func (x SessionTerminationStatus) Enum() *SessionTerminationStatus {
+	p := new(SessionTerminationStatus)
+	*p = x
+	return p
+}
+
+func (x SessionTerminationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionTerminationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_sessions_proto_enumTypes[2].Descriptor()
+}
+
+func (SessionTerminationStatus) Type() protoreflect.EnumType {
+	return &file_cluster_service_sessions_proto_enumTypes[2]
+}
+
+func (x SessionTerminationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionTerminationStatus.Descriptor instead.
+func (SessionTerminationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{2}
+}
+
+// SessionEventType тип изменения сеанса
+type SessionEventType int32
+
+const (
+	SessionEventType_SESSION_EVENT_TYPE_UNSPECIFIED     SessionEventType = 0
+	SessionEventType_SESSION_EVENT_TYPE_EXISTING        SessionEventType = 1 // Сеанс существовал на момент подписки
+	SessionEventType_SESSION_EVENT_TYPE_STARTED         SessionEventType = 2 // Сеанс начат
+	SessionEventType_SESSION_EVENT_TYPE_ENDED           SessionEventType = 3 // Сеанс завершен
+	SessionEventType_SESSION_EVENT_TYPE_HIBERNATED      SessionEventType = 4 // Сеанс перешел в спящий режим
+	SessionEventType_SESSION_EVENT_TYPE_AWAKENED        SessionEventType = 5 // Сеанс вышел из спящего режима
+	SessionEventType_SESSION_EVENT_TYPE_LICENSE_CHANGED SessionEventType = 6 // Изменились лицензии сеанса
+	SessionEventType_SESSION_EVENT_TYPE_BLOCKED_CHANGED SessionEventType = 7 // Изменились блокировки СУБД или менеджера блокировок
+)
+
+// Enum value maps for SessionEventType.
+var (
+	SessionEventType_name = map[int32]string{
+		0: "SESSION_EVENT_TYPE_UNSPECIFIED",
+		1: "SESSION_EVENT_TYPE_EXISTING",
+		2: "SESSION_EVENT_TYPE_STARTED",
+		3: "SESSION_EVENT_TYPE_ENDED",
+		4: "SESSION_EVENT_TYPE_HIBERNATED",
+		5: "SESSION_EVENT_TYPE_AWAKENED",
+		6: "SESSION_EVENT_TYPE_LICENSE_CHANGED",
+		7: "SESSION_EVENT_TYPE_BLOCKED_CHANGED",
+	}
+	SessionEventType_value = map[string]int32{
+		"SESSION_EVENT_TYPE_UNSPECIFIED":     0,
+		"SESSION_EVENT_TYPE_EXISTING":        1,
+		"SESSION_EVENT_TYPE_STARTED":         2,
+		"SESSION_EVENT_TYPE_ENDED":           3,
+		"SESSION_EVENT_TYPE_HIBERNATED":      4,
+		"SESSION_EVENT_TYPE_AWAKENED":        5,
+		"SESSION_EVENT_TYPE_LICENSE_CHANGED": 6,
+		"SESSION_EVENT_TYPE_BLOCKED_CHANGED": 7,
+	}
+)
+
+func (x SessionEventType) Enum() *SessionEventType {
+	p := new(SessionEventType)
+	*p = x
+	return p
+}
+
+func (x SessionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cluster_service_sessions_proto_enumTypes[3].Descriptor()
+}
+
+func (SessionEventType) Type() protoreflect.EnumType {
+	return &file_cluster_service_sessions_proto_enumTypes[3]
+}
+
+func (x SessionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionEventType.Descriptor instead.
+func (SessionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{3}
+}
+
+// SessionFilter отбор сеансов на стороне шлюза, пустые поля не учитываются
+type SessionFilter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId     string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`                                             // UUID информационной базы
+	UserName       string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`                                                   // Имя пользователя, без учета регистра
+	AppIds         []string               `protobuf:"bytes,3,rep,name=app_ids,json=appIds,proto3" json:"app_ids,omitempty"`                                                         // Приложения: 1CV8C, 1CV8, Designer, BackgroundJob, WebClient, COMConnection...
+	Host           string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`                                                                           // Компьютер пользователя, без учета регистра
+	Hibernated     *bool                  `protobuf:"varint,5,opt,name=hibernated,proto3,oneof" json:"hibernated,omitempty"`                                                        // Только спящие или только активные сеансы
+	IdleLongerThan *durationpb.Duration   `protobuf:"bytes,6,opt,name=idle_longer_than,json=idleLongerThan,proto3" json:"idle_longer_than,omitempty"`                               // Без активности дольше указанного времени
+	LicenseType    SessionLicenseType     `protobuf:"varint,7,opt,name=license_type,json=licenseType,proto3,enum=cluster.service.SessionLicenseType" json:"license_type,omitempty"` // Тип лицензии
+	// Исключения, например конфигуратор и служебный пользователь
+	ExcludeAppIds    []string `protobuf:"bytes,8,rep,name=exclude_app_ids,json=excludeAppIds,proto3" json:"exclude_app_ids,omitempty"`          // Кроме приложений
+	ExcludeUserNames []string `protobuf:"bytes,9,rep,name=exclude_user_names,json=excludeUserNames,proto3" json:"exclude_user_names,omitempty"` // Кроме пользователей, без учета регистра
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SessionFilter) Reset() {
+	*x = SessionFilter{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionFilter) ProtoMessage() {}
+
+func (x *SessionFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionFilter.ProtoReflect.Descriptor instead.
+func (*SessionFilter) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SessionFilter) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *SessionFilter) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SessionFilter) GetAppIds() []string {
+	if x != nil {
+		return x.AppIds
+	}
+	return nil
+}
+
+func (x *SessionFilter) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SessionFilter) GetHibernated() bool {
+	if x != nil && x.Hibernated != nil {
+		return *x.Hibernated
+	}
+	return false
+}
+
+func (x *SessionFilter) GetIdleLongerThan() *durationpb.Duration {
+	if x != nil {
+		return x.IdleLongerThan
+	}
+	return nil
+}
+
+func (x *SessionFilter) GetLicenseType() SessionLicenseType {
+	if x != nil {
+		return x.LicenseType
+	}
+	return SessionLicenseType_SESSION_LICENSE_TYPE_UNSPECIFIED
+}
+
+func (x *SessionFilter) GetExcludeAppIds() []string {
+	if x != nil {
+		return x.ExcludeAppIds
+	}
+	return nil
+}
+
+func (x *SessionFilter) GetExcludeUserNames() []string {
+	if x != nil {
+		return x.ExcludeUserNames
+	}
+	return nil
+}
+
+// GetSessionRequest сеанс кластера по UUID
+type GetSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetSessionRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Session       *v1.SessionInfo        `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSessionResponse) GetSession() *v1.SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// ListSessionsRequest список сеансов с отбором, сортировкой и постраничным выводом
+type ListSessionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId  string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Filter     *SessionFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy     SessionSortField       `protobuf:"varint,3,opt,name=sort_by,json=sortBy,proto3,enum=cluster.service.SessionSortField" json:"sort_by,omitempty"`
+	Descending bool                   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Размер страницы, по умолчанию 100, не больше 1000
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token предыдущего ответа. Отбор и сортировка должны совпадать с первым запросом.
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSessionsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetFilter() *SessionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListSessionsRequest) GetSortBy() SessionSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SessionSortField_SESSION_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListSessionsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListSessionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSessionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSessionsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sessions []*v1.SessionInfo      `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	// Пустой на последней странице
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Количество сеансов, удовлетворяющих отбору
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*v1.SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// GetSessionInfoRequest сведения о сеансе кластера
+type GetSessionInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionInfoRequest) Reset() {
+	*x = GetSessionInfoRequest{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionInfoRequest) ProtoMessage() {}
+
+func (x *GetSessionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSessionInfoRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetSessionInfoRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetSessionInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *v1.SessionInfo        `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionInfoResponse) Reset() {
+	*x = GetSessionInfoResponse{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionInfoResponse) ProtoMessage() {}
+
+func (x *GetSessionInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionInfoResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSessionInfoResponse) GetInfo() *v1.SessionInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// TerminateSessionRequest завершение сеанса с сообщением пользователю
+type TerminateSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateSessionRequest) Reset() {
+	*x = TerminateSessionRequest{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateSessionRequest) ProtoMessage() {}
+
+func (x *TerminateSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateSessionRequest.ProtoReflect.Descriptor instead.
+func (*TerminateSessionRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TerminateSessionRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *TerminateSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *TerminateSessionRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// TerminateSessionsRequest завершение всех сеансов, удовлетворяющих отбору
+type TerminateSessionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// Обязателен, пустой отбор не завершает все сеансы кластера
+	Filter *SessionFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Только вернуть сеансы, которые будут завершены
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Сообщение, которое увидят пользователи завершаемых сеансов
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateSessionsRequest) Reset() {
+	*x = TerminateSessionsRequest{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateSessionsRequest) ProtoMessage() {}
+
+func (x *TerminateSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateSessionsRequest.ProtoReflect.Descriptor instead.
+func (*TerminateSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TerminateSessionsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *TerminateSessionsRequest) GetFilter() *SessionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TerminateSessionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *TerminateSessionsRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SessionTerminationResult struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Session       *v1.SessionInfo          `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Status        SessionTerminationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cluster.service.SessionTerminationStatus" json:"status,omitempty"`
+	Error         string                   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionTerminationResult) Reset() {
+	*x = SessionTerminationResult{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTerminationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTerminationResult) ProtoMessage() {}
+
+func (x *SessionTerminationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTerminationResult.ProtoReflect.Descriptor instead.
+func (*SessionTerminationResult) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionTerminationResult) GetSession() *v1.SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SessionTerminationResult) GetStatus() SessionTerminationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SessionTerminationStatus_SESSION_TERMINATION_STATUS_UNSPECIFIED
+}
+
+func (x *SessionTerminationResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TerminateSessionsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Results       []*SessionTerminationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Matched       int32                       `protobuf:"varint,2,opt,name=matched,proto3" json:"matched,omitempty"`
+	Terminated    int32                       `protobuf:"varint,3,opt,name=terminated,proto3" json:"terminated,omitempty"`
+	Failed        int32                       `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateSessionsResponse) Reset() {
+	*x = TerminateSessionsResponse{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateSessionsResponse) ProtoMessage() {}
+
+func (x *TerminateSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateSessionsResponse.ProtoReflect.Descriptor instead.
+func (*TerminateSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TerminateSessionsResponse) GetResults() []*SessionTerminationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TerminateSessionsResponse) GetMatched() int32 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *TerminateSessionsResponse) GetTerminated() int32 {
+	if x != nil {
+		return x.Terminated
+	}
+	return 0
+}
+
+func (x *TerminateSessionsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// WatchSessionsRequest подписка на изменения сеансов кластера.
+// Шлюз опрашивает RAS с интервалом SESSIONS_WATCH_INTERVAL (по умолчанию 5s),
+// один опрос кластера обслуживает всех подписчиков.
+type WatchSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Filter        *SessionFilter         `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSessionsRequest) Reset() {
+	*x = WatchSessionsRequest{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSessionsRequest) ProtoMessage() {}
+
+func (x *WatchSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSessionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchSessionsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *WatchSessionsRequest) GetFilter() *SessionFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type SessionEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  SessionEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=cluster.service.SessionEventType" json:"type,omitempty"`
+	// Текущее состояние сеанса, для завершенного - последнее известное
+	Session *v1.SessionInfo `protobuf:"bytes,2,opt,name=session,proto3" json:"session,omitempty"`
+	// Предыдущее состояние для событий изменения
+	Previous *v1.SessionInfo `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Время опроса RAS, в котором обнаружено изменение
+	ObservedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	mi := &file_cluster_service_sessions_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cluster_service_sessions_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_cluster_service_sessions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SessionEvent) GetType() SessionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return SessionEventType_SESSION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *SessionEvent) GetSession() *v1.SessionInfo {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetPrevious() *v1.SessionInfo {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetObservedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedAt
+	}
+	return nil
+}
+
+var File_cluster_service_sessions_proto protoreflect.FileDescriptor
+
+const file_cluster_service_sessions_proto_rawDesc = "" +
+	"\n" +
+	"\x1ecluster/service/sessions.proto\x12\x0fcluster.service\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16ras/encoding/ras.proto\x1a\x1bras/messages/v1/types.proto\x1a&v8platform/serialize/v1/sessions.proto\"\x91\x03\n" +
+	"\rSessionFilter\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x17\n" +
+	"\aapp_ids\x18\x03 \x03(\tR\x06appIds\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12#\n" +
+	"\n" +
+	"hibernated\x18\x05 \x01(\bH\x00R\n" +
+	"hibernated\x88\x01\x01\x12C\n" +
+	"\x10idle_longer_than\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0eidleLongerThan\x12F\n" +
+	"\flicense_type\x18\a \x01(\x0e2#.cluster.service.SessionLicenseTypeR\vlicenseType\x12&\n" +
+	"\x0fexclude_app_ids\x18\b \x03(\tR\rexcludeAppIds\x12,\n" +
+	"\x12exclude_user_names\x18\t \x03(\tR\x10excludeUserNamesB\r\n" +
+	"\v_hibernated\"Q\n" +
+	"\x11GetSessionRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"T\n" +
+	"\x12GetSessionResponse\x12>\n" +
+	"\asession\x18\x01 \x01(\v2$.v8platform.serialize.v1.SessionInfoR\asession\"\x84\x02\n" +
+	"\x13ListSessionsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x126\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1e.cluster.service.SessionFilterR\x06filter\x12:\n" +
+	"\asort_by\x18\x03 \x01(\x0e2!.cluster.service.SessionSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x04 \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x9f\x01\n" +
+	"\x14ListSessionsResponse\x12@\n" +
+	"\bsessions\x18\x01 \x03(\v2$.v8platform.serialize.v1.SessionInfoR\bsessions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x97\x01\n" +
+	"\x15GetSessionInfoRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12-\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\tsessionId: \x8a\xf5\xea\x94\x0e\x1a:\x18GET_SESSION_INFO_REQUEST\"\x7f\n" +
+	"\x16GetSessionInfoResponse\x12B\n" +
+	"\x04info\x18\x01 \x01(\v2$.v8platform.serialize.v1.SessionInfoB\b\x82\xf5\xea\x94\x0e\x02\x10\x01R\x04info:!\x8a\xf5\xea\x94\x0e\x1b:\x19GET_SESSION_INFO_RESPONSE\"\xbe\x01\n" +
+	"\x17TerminateSessionRequest\x12-\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x01R\tclusterId\x12-\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB\x0e\x82\xf5\xea\x94\x0e\b\n" +
+	"\x04uuid\x10\x02R\tsessionId\x12\"\n" +
+	"\amessage\x18\x03 \x01(\tB\b\x82\xf5\xea\x94\x0e\x02\x10\x03R\amessage:!\x8a\xf5\xea\x94\x0e\x1b:\x19TERMINATE_SESSION_REQUEST\"\xb7\x01\n" +
+	"\x18TerminateSessionsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x126\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1e.cluster.service.SessionFilterR\x06filter\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessageJ\x04\b\x04\x10\x05R\vconcurrency\"\xb3\x01\n" +
+	"\x18SessionTerminationResult\x12>\n" +
+	"\asession\x18\x01 \x01(\v2$.v8platform.serialize.v1.SessionInfoR\asession\x12A\n" +
+	"\x06status\x18\x02 \x01(\x0e2).cluster.service.SessionTerminationStatusR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xb2\x01\n" +
+	"\x19TerminateSessionsResponse\x12C\n" +
+	"\aresults\x18\x01 \x03(\v2).cluster.service.SessionTerminationResultR\aresults\x12\x18\n" +
+	"\amatched\x18\x02 \x01(\x05R\amatched\x12\x1e\n" +
+	"\n" +
+	"terminated\x18\x03 \x01(\x05R\n" +
+	"terminated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x05R\x06failed\"m\n" +
+	"\x14WatchSessionsRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x126\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1e.cluster.service.SessionFilterR\x06filter\"\x84\x02\n" +
+	"\fSessionEvent\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.cluster.service.SessionEventTypeR\x04type\x12>\n" +
+	"\asession\x18\x02 \x01(\v2$.v8platform.serialize.v1.SessionInfoR\asession\x12@\n" +
+	"\bprevious\x18\x03 \x01(\v2$.v8platform.serialize.v1.SessionInfoR\bprevious\x12;\n" +
+	"\vobserved_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"observedAt*\x9b\x01\n" +
+	"\x12SessionLicenseType\x12$\n" +
+	" SESSION_LICENSE_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SESSION_LICENSE_TYPE_NONE\x10\x01\x12!\n" +
+	"\x1dSESSION_LICENSE_TYPE_SOFTWARE\x10\x02\x12\x1d\n" +
+	"\x19SESSION_LICENSE_TYPE_HASP\x10\x03*\xaa\x02\n" +
+	"\x10SessionSortField\x12\"\n" +
+	"\x1eSESSION_SORT_FIELD_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSESSION_SORT_FIELD_STARTED_AT\x10\x01\x12%\n" +
+	"!SESSION_SORT_FIELD_LAST_ACTIVE_AT\x10\x02\x12 \n" +
+	"\x1cSESSION_SORT_FIELD_USER_NAME\x10\x03\x12\x1d\n" +
+	"\x19SESSION_SORT_FIELD_APP_ID\x10\x04\x12\x1b\n" +
+	"\x17SESSION_SORT_FIELD_HOST\x10\x05\x12%\n" +
+	"!SESSION_SORT_FIELD_SESSION_NUMBER\x10\x06\x12#\n" +
+	"\x1fSESSION_SORT_FIELD_MEMORY_TOTAL\x10\a*\xc0\x01\n" +
+	"\x18SessionTerminationStatus\x12*\n" +
+	"&SESSION_TERMINATION_STATUS_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"SESSION_TERMINATION_STATUS_MATCHED\x10\x01\x12)\n" +
+	"%SESSION_TERMINATION_STATUS_TERMINATED\x10\x02\x12%\n" +
+	"!SESSION_TERMINATION_STATUS_FAILED\x10\x03*\xa9\x02\n" +
+	"\x10SessionEventType\x12\"\n" +
+	"\x1eSESSION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSESSION_EVENT_TYPE_EXISTING\x10\x01\x12\x1e\n" +
+	"\x1aSESSION_EVENT_TYPE_STARTED\x10\x02\x12\x1c\n" +
+	"\x18SESSION_EVENT_TYPE_ENDED\x10\x03\x12!\n" +
+	"\x1dSESSION_EVENT_TYPE_HIBERNATED\x10\x04\x12\x1f\n" +
+	"\x1bSESSION_EVENT_TYPE_AWAKENED\x10\x05\x12&\n" +
+	"\"SESSION_EVENT_TYPE_LICENSE_CHANGED\x10\x06\x12&\n" +
+	"\"SESSION_EVENT_TYPE_BLOCKED_CHANGED\x10\a2\x8a\x03\n" +
+	"\x0fSessionsService\x12U\n" +
+	"\n" +
+	"GetSession\x12\".cluster.service.GetSessionRequest\x1a#.cluster.service.GetSessionResponse\x12[\n" +
+	"\fListSessions\x12$.cluster.service.ListSessionsRequest\x1a%.cluster.service.ListSessionsResponse\x12j\n" +
+	"\x11TerminateSessions\x12).cluster.service.TerminateSessionsRequest\x1a*.cluster.service.TerminateSessionsResponse\x12W\n" +
+	"\rWatchSessions\x12%.cluster.service.WatchSessionsRequest\x1a\x1d.cluster.service.SessionEvent0\x01B\xbc\x01\n" +
+	"\x13com.cluster.serviceB\rSessionsProtoP\x01Z9github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service\xa2\x02\x03CSX\xaa\x02\x0fCluster.Service\xca\x02\x0fCluster\\Service\xe2\x02\x1bCluster\\Service\\GPBMetadata\xea\x02\x10Cluster::Serviceb\x06proto3"
+
+var (
+	file_cluster_service_sessions_proto_rawDescOnce sync.Once
+	file_cluster_service_sessions_proto_rawDescData []byte
+)
+
+func file_cluster_service_sessions_proto_rawDescGZIP() []byte {
+	file_cluster_service_sessions_proto_rawDescOnce.Do(func() {
+		file_cluster_service_sessions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cluster_service_sessions_proto_rawDesc), len(file_cluster_service_sessions_proto_rawDesc)))
+	})
+	return file_cluster_service_sessions_proto_rawDescData
+}
+
+var file_cluster_service_sessions_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cluster_service_sessions_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_cluster_service_sessions_proto_goTypes = []any{
+	(SessionLicenseType)(0),           // 0: cluster.service.SessionLicenseType
+	(SessionSortField)(0),             // 1: cluster.service.SessionSortField
+	(SessionTerminationStatus)(0),     // 2: cluster.service.SessionTerminationStatus
+	(SessionEventType)(0),             // 3: cluster.service.SessionEventType
+	(*SessionFilter)(nil),             // 4: cluster.service.SessionFilter
+	(*GetSessionRequest)(nil),         // 5: cluster.service.GetSessionRequest
+	(*GetSessionResponse)(nil),        // 6: cluster.service.GetSessionResponse
+	(*ListSessionsRequest)(nil),       // 7: cluster.service.ListSessionsRequest
+	(*ListSessionsResponse)(nil),      // 8: cluster.service.ListSessionsResponse
+	(*GetSessionInfoRequest)(nil),     // 9: cluster.service.GetSessionInfoRequest
+	(*GetSessionInfoResponse)(nil),    // 10: cluster.service.GetSessionInfoResponse
+	(*TerminateSessionRequest)(nil),   // 11: cluster.service.TerminateSessionRequest
+	(*TerminateSessionsRequest)(nil),  // 12: cluster.service.TerminateSessionsRequest
+	(*SessionTerminationResult)(nil),  // 13: cluster.service.SessionTerminationResult
+	(*TerminateSessionsResponse)(nil), // 14: cluster.service.TerminateSessionsResponse
+	(*WatchSessionsRequest)(nil),      // 15: cluster.service.WatchSessionsRequest
+	(*SessionEvent)(nil),              // 16: cluster.service.SessionEvent
+	(*durationpb.Duration)(nil),       // 17: google.protobuf.Duration
+	(*v1.SessionInfo)(nil),            // 18: v8platform.serialize.v1.SessionInfo
+	(*timestamppb.Timestamp)(nil),     // 19: google.protobuf.Timestamp
+}
+var file_cluster_service_sessions_proto_depIdxs = []int32{
+	17, // 0: cluster.service.SessionFilter.idle_longer_than:type_name -> google.protobuf.Duration
+	0,  // 1: cluster.service.SessionFilter.license_type:type_name -> cluster.service.SessionLicenseType
+	18, // 2: cluster.service.GetSessionResponse.session:type_name -> v8platform.serialize.v1.SessionInfo
+	4,  // 3: cluster.service.ListSessionsRequest.filter:type_name -> cluster.service.SessionFilter
+	1,  // 4: cluster.service.ListSessionsRequest.sort_by:type_name -> cluster.service.SessionSortField
+	18, // 5: cluster.service.ListSessionsResponse.sessions:type_name -> v8platform.serialize.v1.SessionInfo
+	18, // 6: cluster.service.GetSessionInfoResponse.info:type_name -> v8platform.serialize.v1.SessionInfo
+	4,  // 7: cluster.service.TerminateSessionsRequest.filter:type_name -> cluster.service.SessionFilter
+	18, // 8: cluster.service.SessionTerminationResult.session:type_name -> v8platform.serialize.v1.SessionInfo
+	2,  // 9: cluster.service.SessionTerminationResult.status:type_name -> cluster.service.SessionTerminationStatus
+	13, // 10: cluster.service.TerminateSessionsResponse.results:type_name -> cluster.service.SessionTerminationResult
+	4,  // 11: cluster.service.WatchSessionsRequest.filter:type_name -> cluster.service.SessionFilter
+	3,  // 12: cluster.service.SessionEvent.type:type_name -> cluster.service.SessionEventType
+	18, // 13: cluster.service.SessionEvent.session:type_name -> v8platform.serialize.v1.SessionInfo
+	18, // 14: cluster.service.SessionEvent.previous:type_name -> v8platform.serialize.v1.SessionInfo
+	19, // 15: cluster.service.SessionEvent.observed_at:type_name -> google.protobuf.Timestamp
+	5,  // 16: cluster.service.SessionsService.GetSession:input_type -> cluster.service.GetSessionRequest
+	7,  // 17: cluster.service.SessionsService.ListSessions:input_type -> cluster.service.ListSessionsRequest
+	12, // 18: cluster.service.SessionsService.TerminateSessions:input_type -> cluster.service.TerminateSessionsRequest
+	15, // 19: cluster.service.SessionsService.WatchSessions:input_type -> cluster.service.WatchSessionsRequest
+	6,  // 20: cluster.service.SessionsService.GetSession:output_type -> cluster.service.GetSessionResponse
+	8,  // 21: cluster.service.SessionsService.ListSessions:output_type -> cluster.service.ListSessionsResponse
+	14, // 22: cluster.service.SessionsService.TerminateSessions:output_type -> cluster.service.TerminateSessionsResponse
+	16, // 23: cluster.service.SessionsService.WatchSessions:output_type -> cluster.service.SessionEvent
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_cluster_service_sessions_proto_init() }
+func file_cluster_service_sessions_proto_init() {
+	if File_cluster_service_sessions_proto != nil {
+		return
+	}
+	file_cluster_service_sessions_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cluster_service_sessions_proto_rawDesc), len(file_cluster_service_sessions_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cluster_service_sessions_proto_goTypes,
+		DependencyIndexes: file_cluster_service_sessions_proto_depIdxs,
+		EnumInfos:         file_cluster_service_sessions_proto_enumTypes,
+		MessageInfos:      file_cluster_service_sessions_proto_msgTypes,
+	}.Build()
+	File_cluster_service_sessions_proto = out.File
+	file_cluster_service_sessions_proto_goTypes = nil
+	file_cluster_service_sessions_proto_depIdxs = nil
+}
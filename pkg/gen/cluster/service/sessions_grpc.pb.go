@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cluster/service/sessions.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SessionsService_GetSession_FullMethodName        = "/cluster.service.SessionsService/GetSession"
+	SessionsService_ListSessions_FullMethodName      = "/cluster.service.SessionsService/ListSessions"
+	SessionsService_TerminateSessions_FullMethodName = "/cluster.service.SessionsService/TerminateSessions"
+	SessionsService_WatchSessions_FullMethodName     = "/cluster.service.SessionsService/WatchSessions"
+)
+
+// SessionsServiceClient is the client API for SessionsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SessionsService сеансы кластера с отбором на стороне шлюза
+type SessionsServiceClient interface {
+	// GetSession сведения о сеансе
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	// ListSessions список сеансов с отбором, сортировкой и постраничным выводом
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// TerminateSessions завершение сеансов по отбору с результатом по каждому сеансу
+	TerminateSessions(ctx context.Context, in *TerminateSessionsRequest, opts ...grpc.CallOption) (*TerminateSessionsResponse, error)
+	// WatchSessions поток изменений сеансов: сначала существующие сеансы, затем изменения
+	// Сеансы опрашиваются на endpoint из endpoint_id запроса, без него - с учетными данными из хранилища
+	WatchSessions(ctx context.Context, in *WatchSessionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error)
+}
+
+type sessionsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSessionsServiceClient(cc grpc.ClientConnInterface) SessionsServiceClient {
+	return &sessionsServiceClient{cc}
+}
+
+func (c *sessionsServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, SessionsService_GetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, SessionsService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) TerminateSessions(ctx context.Context, in *TerminateSessionsRequest, opts ...grpc.CallOption) (*TerminateSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TerminateSessionsResponse)
+	err := c.cc.Invoke(ctx, SessionsService_TerminateSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsServiceClient) WatchSessions(ctx context.Context, in *WatchSessionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SessionsService_ServiceDesc.Streams[0], SessionsService_WatchSessions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSessionsRequest, SessionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SessionsService_WatchSessionsClient = grpc.ServerStreamingClient[SessionEvent]
+
+// SessionsServiceServer is the server API for SessionsService service.
+// All implementations must embed UnimplementedSessionsServiceServer
+// for forward compatibility.
+//
+// SessionsService сеансы кластера с отбором на стороне шлюза
+type SessionsServiceServer interface {
+	// GetSession сведения о сеансе
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	// ListSessions список сеансов с отбором, сортировкой и постраничным выводом
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// TerminateSessions завершение сеансов по отбору с результатом по каждому сеансу
+	TerminateSessions(context.Context, *TerminateSessionsRequest) (*TerminateSessionsResponse, error)
+	// WatchSessions поток изменений сеансов: сначала существующие сеансы, затем изменения
+	// Сеансы опрашиваются на endpoint из endpoint_id запроса, без него - с учетными данными из хранилища
+	WatchSessions(*WatchSessionsRequest, grpc.ServerStreamingServer[SessionEvent]) error
+	mustEmbedUnimplementedSessionsServiceServer()
+}
+
+// UnimplementedSessionsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSessionsServiceServer struct{}
+
+func (UnimplementedSessionsServiceServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedSessionsServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSessionsServiceServer) TerminateSessions(context.Context, *TerminateSessionsRequest) (*TerminateSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateSessions not implemented")
+}
+func (UnimplementedSessionsServiceServer) WatchSessions(*WatchSessionsRequest, grpc.ServerStreamingServer[SessionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSessions not implemented")
+}
+func (UnimplementedSessionsServiceServer) mustEmbedUnimplementedSessionsServiceServer() {}
+func (UnimplementedSessionsServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeSessionsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionsServiceServer will
+// result in compilation errors.
+type UnsafeSessionsServiceServer interface {
+	mustEmbedUnimplementedSessionsServiceServer()
+}
+
+func RegisterSessionsServiceServer(s grpc.ServiceRegistrar, srv SessionsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSessionsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SessionsService_ServiceDesc, srv)
+}
+
+func _SessionsService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_GetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_TerminateSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServiceServer).TerminateSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionsService_TerminateSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServiceServer).TerminateSessions(ctx, req.(*TerminateSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionsService_WatchSessions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSessionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionsServiceServer).WatchSessions(m, &grpc.GenericServerStream[WatchSessionsRequest, SessionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SessionsService_WatchSessionsServer = grpc.ServerStreamingServer[SessionEvent]
+
+// SessionsService_ServiceDesc is the grpc.ServiceDesc for SessionsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SessionsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.service.SessionsService",
+	HandlerType: (*SessionsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSession",
+			Handler:    _SessionsService_GetSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SessionsService_ListSessions_Handler,
+		},
+		{
+			MethodName: "TerminateSessions",
+			Handler:    _SessionsService_TerminateSessions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSessions",
+			Handler:       _SessionsService_WatchSessions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cluster/service/sessions.proto",
+}
@@ -0,0 +1,112 @@
+// Code generated by protoc-gen-go-ras. DO NOT EDIT.
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the v8platform/protoc-gen-go-ras ras it is being compiled against.
+
+package service
+
+import (
+	codec256 "github.com/v8platform/encoder/ras/codec256"
+	v1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	v11 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	io "io"
+)
+
+func (x *GetSessionInfoRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_SESSION_INFO_REQUEST
+}
+
+func (x *GetSessionInfoRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.SessionId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.SessionId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetSessionInfoRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.SessionId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.SessionId); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *GetSessionInfoResponse) GetMessageType() v1.MessageType {
+	return v1.MessageType_GET_SESSION_INFO_RESPONSE
+}
+
+func (x *GetSessionInfoResponse) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	x.Info = &v11.SessionInfo{}
+	if err := x.Info.Parse(reader, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+func (x *GetSessionInfoResponse) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.Info opts: order:1
+	if err := x.Info.Formatter(writer, version); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *TerminateSessionRequest) GetMessageType() v1.MessageType {
+	return v1.MessageType_TERMINATE_SESSION_REQUEST
+}
+
+func (x *TerminateSessionRequest) Parse(reader io.Reader, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.ParseUUID(reader, &x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.SessionId opts: encoder:"uuid" order:2
+	if err := codec256.ParseUUID(reader, &x.SessionId); err != nil {
+		return err
+	}
+	// decode x.Message opts: order:3
+	if err := codec256.ParseString(reader, &x.Message); err != nil {
+		return err
+	}
+	return nil
+}
+func (x *TerminateSessionRequest) Formatter(writer io.Writer, version int32) error {
+	if x == nil {
+		return nil
+	}
+	// decode x.ClusterId opts: encoder:"uuid" order:1
+	if err := codec256.FormatUuid(writer, x.ClusterId); err != nil {
+		return err
+	}
+	// decode x.SessionId opts: encoder:"uuid" order:2
+	if err := codec256.FormatUuid(writer, x.SessionId); err != nil {
+		return err
+	}
+	// decode x.Message opts: order:3
+	if err := codec256.FormatString(writer, x.Message); err != nil {
+		return err
+	}
+	return nil
+}
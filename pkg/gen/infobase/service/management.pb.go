@@ -0,0 +1,3157 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: infobase/service/management.proto
+
+package service
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DBMSType определяет тип СУБД для хранения информационной базы 1С
+type DBMSType int32
+
+const (
+	DBMSType_DBMS_TYPE_UNSPECIFIED  DBMSType = 0
+	DBMSType_DBMS_TYPE_MSSQL_SERVER DBMSType = 1 // Microsoft SQL Server
+	DBMSType_DBMS_TYPE_POSTGRESQL   DBMSType = 2 // PostgreSQL
+	DBMSType_DBMS_TYPE_IBM_DB2      DBMSType = 3 // IBM DB2
+	DBMSType_DBMS_TYPE_ORACLE       DBMSType = 4 // Oracle Database
+)
+
+// Enum value maps for DBMSType.
+var (
+	DBMSType_name = map[int32]string{
+		0: "DBMS_TYPE_UNSPECIFIED",
+		1: "DBMS_TYPE_MSSQL_SERVER",
+		2: "DBMS_TYPE_POSTGRESQL",
+		3: "DBMS_TYPE_IBM_DB2",
+		4: "DBMS_TYPE_ORACLE",
+	}
+	DBMSType_value = map[string]int32{
+		"DBMS_TYPE_UNSPECIFIED":  0,
+		"DBMS_TYPE_MSSQL_SERVER": 1,
+		"DBMS_TYPE_POSTGRESQL":   2,
+		"DBMS_TYPE_IBM_DB2":      3,
+		"DBMS_TYPE_ORACLE":       4,
+	}
+)
+
+func (x DBMSType) Enum() *DBMSType {
+	p := new(DBMSType)
+	*p = x
+	return p
+}
+
+func (x DBMSType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DBMSType) Descriptor() protoreflect.EnumDescriptor {
+	return file_infobase_service_management_proto_enumTypes[0].Descriptor()
+}
+
+func (DBMSType) Type() protoreflect.EnumType {
+	return &file_infobase_service_management_proto_enumTypes[0]
+}
+
+func (x DBMSType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DBMSType.Descriptor instead.
+func (DBMSType) EnumDescriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{0}
+}
+
+// SecurityLevel определяет уровень защиты информационной базы
+type SecurityLevel int32
+
+const (
+	SecurityLevel_SECURITY_LEVEL_UNSPECIFIED SecurityLevel = 0
+	SecurityLevel_SECURITY_LEVEL_0           SecurityLevel = 1 // Нет защиты
+	SecurityLevel_SECURITY_LEVEL_1           SecurityLevel = 2 // Базовая защита
+	SecurityLevel_SECURITY_LEVEL_2           SecurityLevel = 3 // Повышенная защита
+	SecurityLevel_SECURITY_LEVEL_3           SecurityLevel = 4 // Максимальная защита
+)
+
+// Enum value maps for SecurityLevel.
+var (
+	SecurityLevel_name = map[int32]string{
+		0: "SECURITY_LEVEL_UNSPECIFIED",
+		1: "SECURITY_LEVEL_0",
+		2: "SECURITY_LEVEL_1",
+		3: "SECURITY_LEVEL_2",
+		4: "SECURITY_LEVEL_3",
+	}
+	SecurityLevel_value = map[string]int32{
+		"SECURITY_LEVEL_UNSPECIFIED": 0,
+		"SECURITY_LEVEL_0":           1,
+		"SECURITY_LEVEL_1":           2,
+		"SECURITY_LEVEL_2":           3,
+		"SECURITY_LEVEL_3":           4,
+	}
+)
+
+func (x SecurityLevel) Enum() *SecurityLevel {
+	p := new(SecurityLevel)
+	*p = x
+	return p
+}
+
+func (x SecurityLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_infobase_service_management_proto_enumTypes[1].Descriptor()
+}
+
+func (SecurityLevel) Type() protoreflect.EnumType {
+	return &file_infobase_service_management_proto_enumTypes[1]
+}
+
+func (x SecurityLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecurityLevel.Descriptor instead.
+func (SecurityLevel) EnumDescriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{1}
+}
+
+// DropMode определяет режим удаления информационной базы
+type DropMode int32
+
+const (
+	DropMode_DROP_MODE_UNSPECIFIED     DropMode = 0 // По умолчанию: только удалить регистрацию в кластере
+	DropMode_DROP_MODE_UNREGISTER_ONLY DropMode = 1 // Только удалить регистрацию (БД остается) - БЕЗОПАСНО
+	DropMode_DROP_MODE_DROP_DATABASE   DropMode = 2 // УДАЛИТЬ БД вместе с infobase (ОПАСНО! Безвозвратное удаление данных!)
+	DropMode_DROP_MODE_CLEAR_DATABASE  DropMode = 3 // Очистить БД, но не удалять (сохранить структуру) - ОПАСНО!
+)
+
+// Enum value maps for DropMode.
+var (
+	DropMode_name = map[int32]string{
+		0: "DROP_MODE_UNSPECIFIED",
+		1: "DROP_MODE_UNREGISTER_ONLY",
+		2: "DROP_MODE_DROP_DATABASE",
+		3: "DROP_MODE_CLEAR_DATABASE",
+	}
+	DropMode_value = map[string]int32{
+		"DROP_MODE_UNSPECIFIED":     0,
+		"DROP_MODE_UNREGISTER_ONLY": 1,
+		"DROP_MODE_DROP_DATABASE":   2,
+		"DROP_MODE_CLEAR_DATABASE":  3,
+	}
+)
+
+func (x DropMode) Enum() *DropMode {
+	p := new(DropMode)
+	*p = x
+	return p
+}
+
+func (x DropMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DropMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_infobase_service_management_proto_enumTypes[2].Descriptor()
+}
+
+func (DropMode) Type() protoreflect.EnumType {
+	return &file_infobase_service_management_proto_enumTypes[2]
+}
+
+func (x DropMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DropMode.Descriptor instead.
+func (DropMode) EnumDescriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{2}
+}
+
+// MaintenanceStage этап перевода в режим обслуживания
+type MaintenanceStage int32
+
+const (
+	MaintenanceStage_MAINTENANCE_STAGE_UNSPECIFIED           MaintenanceStage = 0
+	MaintenanceStage_MAINTENANCE_STAGE_STATE_SAVED           MaintenanceStage = 1 // Сохранено состояние блокировки
+	MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_LOCKED       MaintenanceStage = 2 // Новые сеансы запрещены
+	MaintenanceStage_MAINTENANCE_STAGE_DRAINING              MaintenanceStage = 3 // Ожидание завершения сеансов
+	MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_TERMINATED   MaintenanceStage = 4 // Оставшиеся сеансы завершены
+	MaintenanceStage_MAINTENANCE_STAGE_SCHEDULED_JOBS_LOCKED MaintenanceStage = 5 // Регламентные задания заблокированы
+	MaintenanceStage_MAINTENANCE_STAGE_READY                 MaintenanceStage = 6 // База готова к обслуживанию
+)
+
+// Enum value maps for MaintenanceStage.
+var (
+	MaintenanceStage_name = map[int32]string{
+		0: "MAINTENANCE_STAGE_UNSPECIFIED",
+		1: "MAINTENANCE_STAGE_STATE_SAVED",
+		2: "MAINTENANCE_STAGE_SESSIONS_LOCKED",
+		3: "MAINTENANCE_STAGE_DRAINING",
+		4: "MAINTENANCE_STAGE_SESSIONS_TERMINATED",
+		5: "MAINTENANCE_STAGE_SCHEDULED_JOBS_LOCKED",
+		6: "MAINTENANCE_STAGE_READY",
+	}
+	MaintenanceStage_value = map[string]int32{
+		"MAINTENANCE_STAGE_UNSPECIFIED":           0,
+		"MAINTENANCE_STAGE_STATE_SAVED":           1,
+		"MAINTENANCE_STAGE_SESSIONS_LOCKED":       2,
+		"MAINTENANCE_STAGE_DRAINING":              3,
+		"MAINTENANCE_STAGE_SESSIONS_TERMINATED":   4,
+		"MAINTENANCE_STAGE_SCHEDULED_JOBS_LOCKED": 5,
+		"MAINTENANCE_STAGE_READY":                 6,
+	}
+)
+
+func (x MaintenanceStage) Enum() *MaintenanceStage {
+	p := new(MaintenanceStage)
+	*p = x
+	return p
+}
+
+func (x MaintenanceStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_infobase_service_management_proto_enumTypes[3].Descriptor()
+}
+
+func (MaintenanceStage) Type() protoreflect.EnumType {
+	return &file_infobase_service_management_proto_enumTypes[3]
+}
+
+func (x MaintenanceStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceStage.Descriptor instead.
+func (MaintenanceStage) EnumDescriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{3}
+}
+
+// ScheduledLockStatus состояние окна блокировки
+type ScheduledLockStatus int32
+
+const (
+	ScheduledLockStatus_SCHEDULED_LOCK_STATUS_UNSPECIFIED ScheduledLockStatus = 0
+	ScheduledLockStatus_SCHEDULED_LOCK_STATUS_PENDING     ScheduledLockStatus = 1 // Окно еще не началось
+	ScheduledLockStatus_SCHEDULED_LOCK_STATUS_ACTIVE      ScheduledLockStatus = 2 // Блокировки установлены
+)
+
+// Enum value maps for ScheduledLockStatus.
+var (
+	ScheduledLockStatus_name = map[int32]string{
+		0: "SCHEDULED_LOCK_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_LOCK_STATUS_PENDING",
+		2: "SCHEDULED_LOCK_STATUS_ACTIVE",
+	}
+	ScheduledLockStatus_value = map[string]int32{
+		"SCHEDULED_LOCK_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_LOCK_STATUS_PENDING":     1,
+		"SCHEDULED_LOCK_STATUS_ACTIVE":      2,
+	}
+)
+
+func (x ScheduledLockStatus) Enum() *ScheduledLockStatus {
+	p := new(ScheduledLockStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledLockStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledLockStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_infobase_service_management_proto_enumTypes[4].Descriptor()
+}
+
+func (ScheduledLockStatus) Type() protoreflect.EnumType {
+	return &file_infobase_service_management_proto_enumTypes[4]
+}
+
+func (x ScheduledLockStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledLockStatus.Descriptor instead.
+func (ScheduledLockStatus) EnumDescriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{4}
+}
+
+// CreateInfobaseRequest создает новую информационную базу в кластере 1С
+type CreateInfobaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Обязательные поля
+	ClusterId string   `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`      // UUID кластера 1С
+	Name      string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                 // Имя информационной базы (должно быть уникальным)
+	Dbms      DBMSType `protobuf:"varint,3,opt,name=dbms,proto3,enum=infobase.service.DBMSType" json:"dbms,omitempty"` // Тип СУБД
+	DbServer  string   `protobuf:"bytes,4,opt,name=db_server,json=dbServer,proto3" json:"db_server,omitempty"`         // Адрес сервера БД (hostname или IP)
+	DbName    string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`               // Имя базы данных на сервере СУБД
+	// Опциональные поля
+	DbUser                   *string        `protobuf:"bytes,6,opt,name=db_user,json=dbUser,proto3,oneof" json:"db_user,omitempty"`                                                           // Пользователь БД
+	DbPassword               *string        `protobuf:"bytes,7,opt,name=db_password,json=dbPassword,proto3,oneof" json:"db_password,omitempty"`                                               // Пароль БД (ВНИМАНИЕ: передается только через TLS!)
+	CreateDatabase           *bool          `protobuf:"varint,8,opt,name=create_database,json=createDatabase,proto3,oneof" json:"create_database,omitempty"`                                  // Создать БД при создании infobase
+	SecurityLevel            *SecurityLevel `protobuf:"varint,9,opt,name=security_level,json=securityLevel,proto3,enum=infobase.service.SecurityLevel,oneof" json:"security_level,omitempty"` // Уровень безопасности
+	Locale                   *string        `protobuf:"bytes,10,opt,name=locale,proto3,oneof" json:"locale,omitempty"`                                                                        // Локаль (например: "ru_RU")
+	DateOffset               *int32         `protobuf:"varint,11,opt,name=date_offset,json=dateOffset,proto3,oneof" json:"date_offset,omitempty"`                                             // Смещение дат (например: 2000)
+	Description              *string        `protobuf:"bytes,12,opt,name=description,proto3,oneof" json:"description,omitempty"`                                                              // Описание информационной базы
+	ScheduledJobsDeny        *bool          `protobuf:"varint,13,opt,name=scheduled_jobs_deny,json=scheduledJobsDeny,proto3,oneof" json:"scheduled_jobs_deny,omitempty"`                      // Блокировка регламентных заданий
+	LicenseDistributionAllow *bool          `protobuf:"varint,14,opt,name=license_distribution_allow,json=licenseDistributionAllow,proto3,oneof" json:"license_distribution_allow,omitempty"` // Разрешить распределение лицензий
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,15,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,16,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateInfobaseRequest) Reset() {
+	*x = CreateInfobaseRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInfobaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInfobaseRequest) ProtoMessage() {}
+
+func (x *CreateInfobaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInfobaseRequest.ProtoReflect.Descriptor instead.
+func (*CreateInfobaseRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateInfobaseRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetDbms() DBMSType {
+	if x != nil {
+		return x.Dbms
+	}
+	return DBMSType_DBMS_TYPE_UNSPECIFIED
+}
+
+func (x *CreateInfobaseRequest) GetDbServer() string {
+	if x != nil {
+		return x.DbServer
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetDbUser() string {
+	if x != nil && x.DbUser != nil {
+		return *x.DbUser
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetDbPassword() string {
+	if x != nil && x.DbPassword != nil {
+		return *x.DbPassword
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetCreateDatabase() bool {
+	if x != nil && x.CreateDatabase != nil {
+		return *x.CreateDatabase
+	}
+	return false
+}
+
+func (x *CreateInfobaseRequest) GetSecurityLevel() SecurityLevel {
+	if x != nil && x.SecurityLevel != nil {
+		return *x.SecurityLevel
+	}
+	return SecurityLevel_SECURITY_LEVEL_UNSPECIFIED
+}
+
+func (x *CreateInfobaseRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetDateOffset() int32 {
+	if x != nil && x.DateOffset != nil {
+		return *x.DateOffset
+	}
+	return 0
+}
+
+func (x *CreateInfobaseRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetScheduledJobsDeny() bool {
+	if x != nil && x.ScheduledJobsDeny != nil {
+		return *x.ScheduledJobsDeny
+	}
+	return false
+}
+
+func (x *CreateInfobaseRequest) GetLicenseDistributionAllow() bool {
+	if x != nil && x.LicenseDistributionAllow != nil {
+		return *x.LicenseDistributionAllow
+	}
+	return false
+}
+
+func (x *CreateInfobaseRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *CreateInfobaseRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+// CreateInfobaseResponse результат создания информационной базы
+type CreateInfobaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId    string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID созданной базы
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // Имя созданной базы
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                         // Сообщение о результате создания
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInfobaseResponse) Reset() {
+	*x = CreateInfobaseResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInfobaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInfobaseResponse) ProtoMessage() {}
+
+func (x *CreateInfobaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInfobaseResponse.ProtoReflect.Descriptor instead.
+func (*CreateInfobaseResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateInfobaseResponse) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *CreateInfobaseResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateInfobaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateInfobaseRequest обновляет параметры существующей информационной базы
+type UpdateInfobaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Обязательные поля
+	ClusterId  string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // UUID кластера 1С
+	InfobaseId string `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	// Опциональные поля (что изменить)
+	// Блокировка сеансов
+	SessionsDeny      *bool                  `protobuf:"varint,3,opt,name=sessions_deny,json=sessionsDeny,proto3,oneof" json:"sessions_deny,omitempty"`                  // Блокировка новых сеансов
+	DeniedFrom        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=denied_from,json=deniedFrom,proto3,oneof" json:"denied_from,omitempty"`                         // Начало блокировки
+	DeniedTo          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=denied_to,json=deniedTo,proto3,oneof" json:"denied_to,omitempty"`                               // Конец блокировки
+	DeniedMessage     *string                `protobuf:"bytes,6,opt,name=denied_message,json=deniedMessage,proto3,oneof" json:"denied_message,omitempty"`                // Сообщение пользователям при попытке входа
+	PermissionCode    *string                `protobuf:"bytes,7,opt,name=permission_code,json=permissionCode,proto3,oneof" json:"permission_code,omitempty"`             // Код разрешения для обхода блокировки
+	ScheduledJobsDeny *bool                  `protobuf:"varint,8,opt,name=scheduled_jobs_deny,json=scheduledJobsDeny,proto3,oneof" json:"scheduled_jobs_deny,omitempty"` // Блокировка регламентных заданий
+	// Изменение параметров БД
+	Dbms       *DBMSType `protobuf:"varint,9,opt,name=dbms,proto3,enum=infobase.service.DBMSType,oneof" json:"dbms,omitempty"` // Тип СУБД
+	DbServer   *string   `protobuf:"bytes,10,opt,name=db_server,json=dbServer,proto3,oneof" json:"db_server,omitempty"`        // Адрес сервера БД
+	DbName     *string   `protobuf:"bytes,11,opt,name=db_name,json=dbName,proto3,oneof" json:"db_name,omitempty"`              // Имя базы данных
+	DbUser     *string   `protobuf:"bytes,12,opt,name=db_user,json=dbUser,proto3,oneof" json:"db_user,omitempty"`              // Пользователь БД
+	DbPassword *string   `protobuf:"bytes,13,opt,name=db_password,json=dbPassword,proto3,oneof" json:"db_password,omitempty"`  // Пароль БД (ВНИМАНИЕ: передается только через TLS!)
+	// Изменение других параметров
+	Description                 *string        `protobuf:"bytes,14,opt,name=description,proto3,oneof" json:"description,omitempty"`                                                                          // Описание базы
+	SecurityLevel               *SecurityLevel `protobuf:"varint,15,opt,name=security_level,json=securityLevel,proto3,enum=infobase.service.SecurityLevel,oneof" json:"security_level,omitempty"`            // Уровень безопасности
+	SecurityProfileName         *string        `protobuf:"bytes,16,opt,name=security_profile_name,json=securityProfileName,proto3,oneof" json:"security_profile_name,omitempty"`                             // Имя профиля безопасности
+	SafeModeSecurityProfileName *string        `protobuf:"bytes,21,opt,name=safe_mode_security_profile_name,json=safeModeSecurityProfileName,proto3,oneof" json:"safe_mode_security_profile_name,omitempty"` // Профиль безопасности безопасного режима
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,17,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,18,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе
+	InfobaseUser     *string `protobuf:"bytes,19,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,20,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateInfobaseRequest) Reset() {
+	*x = UpdateInfobaseRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInfobaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInfobaseRequest) ProtoMessage() {}
+
+func (x *UpdateInfobaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInfobaseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInfobaseRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateInfobaseRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetSessionsDeny() bool {
+	if x != nil && x.SessionsDeny != nil {
+		return *x.SessionsDeny
+	}
+	return false
+}
+
+func (x *UpdateInfobaseRequest) GetDeniedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedFrom
+	}
+	return nil
+}
+
+func (x *UpdateInfobaseRequest) GetDeniedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedTo
+	}
+	return nil
+}
+
+func (x *UpdateInfobaseRequest) GetDeniedMessage() string {
+	if x != nil && x.DeniedMessage != nil {
+		return *x.DeniedMessage
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetPermissionCode() string {
+	if x != nil && x.PermissionCode != nil {
+		return *x.PermissionCode
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetScheduledJobsDeny() bool {
+	if x != nil && x.ScheduledJobsDeny != nil {
+		return *x.ScheduledJobsDeny
+	}
+	return false
+}
+
+func (x *UpdateInfobaseRequest) GetDbms() DBMSType {
+	if x != nil && x.Dbms != nil {
+		return *x.Dbms
+	}
+	return DBMSType_DBMS_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateInfobaseRequest) GetDbServer() string {
+	if x != nil && x.DbServer != nil {
+		return *x.DbServer
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetDbName() string {
+	if x != nil && x.DbName != nil {
+		return *x.DbName
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetDbUser() string {
+	if x != nil && x.DbUser != nil {
+		return *x.DbUser
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetDbPassword() string {
+	if x != nil && x.DbPassword != nil {
+		return *x.DbPassword
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetSecurityLevel() SecurityLevel {
+	if x != nil && x.SecurityLevel != nil {
+		return *x.SecurityLevel
+	}
+	return SecurityLevel_SECURITY_LEVEL_UNSPECIFIED
+}
+
+func (x *UpdateInfobaseRequest) GetSecurityProfileName() string {
+	if x != nil && x.SecurityProfileName != nil {
+		return *x.SecurityProfileName
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetSafeModeSecurityProfileName() string {
+	if x != nil && x.SafeModeSecurityProfileName != nil {
+		return *x.SafeModeSecurityProfileName
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// UpdateInfobaseResponse результат обновления информационной базы
+type UpdateInfobaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId    string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID обновленной базы
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                         // Сообщение о результате
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                        // Успешность операции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInfobaseResponse) Reset() {
+	*x = UpdateInfobaseResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInfobaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInfobaseResponse) ProtoMessage() {}
+
+func (x *UpdateInfobaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInfobaseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInfobaseResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateInfobaseResponse) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateInfobaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// DropInfobaseRequest удаляет информационную базу из кластера 1С
+//
+// Режимы удаления (drop_mode):
+//   - DROP_MODE_UNREGISTER_ONLY: Удалить только регистрацию в кластере (БД остается) - БЕЗОПАСНО
+//   - DROP_MODE_DROP_DATABASE: УДАЛИТЬ БД вместе с infobase - ОПАСНО! Безвозвратное удаление данных!
+//   - DROP_MODE_CLEAR_DATABASE: Очистить БД, но сохранить структуру - ОПАСНО!
+//
+// Примеры:
+//   - Безопасное удаление (только регистрация): drop_mode = DROP_MODE_UNREGISTER_ONLY
+//   - Полное удаление (включая БД): drop_mode = DROP_MODE_DROP_DATABASE (требует подтверждения!)
+//
+// ВНИМАНИЕ: Это деструктивная операция!
+type DropInfobaseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId  string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // UUID кластера 1С
+	InfobaseId string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	// Режим удаления (вместо двух bool флагов)
+	DropMode DropMode `protobuf:"varint,3,opt,name=drop_mode,json=dropMode,proto3,enum=infobase.service.DropMode" json:"drop_mode,omitempty"` // По умолчанию: DROP_MODE_UNSPECIFIED (безопасно)
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,4,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,5,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе
+	InfobaseUser     *string `protobuf:"bytes,6,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,7,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DropInfobaseRequest) Reset() {
+	*x = DropInfobaseRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropInfobaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropInfobaseRequest) ProtoMessage() {}
+
+func (x *DropInfobaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropInfobaseRequest.ProtoReflect.Descriptor instead.
+func (*DropInfobaseRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DropInfobaseRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DropInfobaseRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *DropInfobaseRequest) GetDropMode() DropMode {
+	if x != nil {
+		return x.DropMode
+	}
+	return DropMode_DROP_MODE_UNSPECIFIED
+}
+
+func (x *DropInfobaseRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *DropInfobaseRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *DropInfobaseRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *DropInfobaseRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// DropInfobaseResponse результат удаления информационной базы
+type DropInfobaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId    string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID удаленной базы
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                         // Сообщение о результате
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                        // Успешность операции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropInfobaseResponse) Reset() {
+	*x = DropInfobaseResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropInfobaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropInfobaseResponse) ProtoMessage() {}
+
+func (x *DropInfobaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropInfobaseResponse.ProtoReflect.Descriptor instead.
+func (*DropInfobaseResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DropInfobaseResponse) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *DropInfobaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DropInfobaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// LockInfobaseRequest блокирует доступ к информационной базе
+// Используется для проведения технических работ или перед обновлением
+type LockInfobaseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId  string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // UUID кластера 1С
+	InfobaseId string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	// Блокировка сеансов пользователей
+	SessionsDeny   bool                   `protobuf:"varint,3,opt,name=sessions_deny,json=sessionsDeny,proto3" json:"sessions_deny,omitempty"`            // Запретить новые сеансы
+	DeniedFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=denied_from,json=deniedFrom,proto3,oneof" json:"denied_from,omitempty"`             // Начало блокировки
+	DeniedTo       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=denied_to,json=deniedTo,proto3,oneof" json:"denied_to,omitempty"`                   // Конец блокировки
+	DeniedMessage  *string                `protobuf:"bytes,6,opt,name=denied_message,json=deniedMessage,proto3,oneof" json:"denied_message,omitempty"`    // Сообщение пользователям
+	PermissionCode *string                `protobuf:"bytes,7,opt,name=permission_code,json=permissionCode,proto3,oneof" json:"permission_code,omitempty"` // Код для обхода блокировки (для администраторов)
+	// Блокировка регламентных заданий
+	ScheduledJobsDeny bool `protobuf:"varint,8,opt,name=scheduled_jobs_deny,json=scheduledJobsDeny,proto3" json:"scheduled_jobs_deny,omitempty"` // Запретить выполнение регламентных заданий
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,9,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`              // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,10,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе
+	InfobaseUser     *string `protobuf:"bytes,11,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,12,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LockInfobaseRequest) Reset() {
+	*x = LockInfobaseRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockInfobaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockInfobaseRequest) ProtoMessage() {}
+
+func (x *LockInfobaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockInfobaseRequest.ProtoReflect.Descriptor instead.
+func (*LockInfobaseRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LockInfobaseRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetSessionsDeny() bool {
+	if x != nil {
+		return x.SessionsDeny
+	}
+	return false
+}
+
+func (x *LockInfobaseRequest) GetDeniedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedFrom
+	}
+	return nil
+}
+
+func (x *LockInfobaseRequest) GetDeniedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedTo
+	}
+	return nil
+}
+
+func (x *LockInfobaseRequest) GetDeniedMessage() string {
+	if x != nil && x.DeniedMessage != nil {
+		return *x.DeniedMessage
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetPermissionCode() string {
+	if x != nil && x.PermissionCode != nil {
+		return *x.PermissionCode
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetScheduledJobsDeny() bool {
+	if x != nil {
+		return x.ScheduledJobsDeny
+	}
+	return false
+}
+
+func (x *LockInfobaseRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *LockInfobaseRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// LockInfobaseResponse результат блокировки информационной базы
+type LockInfobaseResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId      string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`                  // UUID заблокированной базы
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                                          // Сообщение о результате
+	Success         bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                                         // Успешность операции
+	ScheduledLockId string                 `protobuf:"bytes,4,opt,name=scheduled_lock_id,json=scheduledLockId,proto3" json:"scheduled_lock_id,omitempty"` // ID окна блокировки, если его отслеживает шлюз
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LockInfobaseResponse) Reset() {
+	*x = LockInfobaseResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockInfobaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockInfobaseResponse) ProtoMessage() {}
+
+func (x *LockInfobaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockInfobaseResponse.ProtoReflect.Descriptor instead.
+func (*LockInfobaseResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LockInfobaseResponse) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *LockInfobaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LockInfobaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LockInfobaseResponse) GetScheduledLockId() string {
+	if x != nil {
+		return x.ScheduledLockId
+	}
+	return ""
+}
+
+// UnlockInfobaseRequest снимает блокировку с информационной базы
+type UnlockInfobaseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId  string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // UUID кластера 1С
+	InfobaseId string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	// Что разблокировать
+	UnlockSessions      bool `protobuf:"varint,3,opt,name=unlock_sessions,json=unlockSessions,proto3" json:"unlock_sessions,omitempty"`                  // Разрешить новые сеансы
+	UnlockScheduledJobs bool `protobuf:"varint,4,opt,name=unlock_scheduled_jobs,json=unlockScheduledJobs,proto3" json:"unlock_scheduled_jobs,omitempty"` // Разрешить выполнение регламентных заданий
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,5,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,6,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе
+	InfobaseUser     *string `protobuf:"bytes,7,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,8,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UnlockInfobaseRequest) Reset() {
+	*x = UnlockInfobaseRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockInfobaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockInfobaseRequest) ProtoMessage() {}
+
+func (x *UnlockInfobaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockInfobaseRequest.ProtoReflect.Descriptor instead.
+func (*UnlockInfobaseRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnlockInfobaseRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseRequest) GetUnlockSessions() bool {
+	if x != nil {
+		return x.UnlockSessions
+	}
+	return false
+}
+
+func (x *UnlockInfobaseRequest) GetUnlockScheduledJobs() bool {
+	if x != nil {
+		return x.UnlockScheduledJobs
+	}
+	return false
+}
+
+func (x *UnlockInfobaseRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// UnlockInfobaseResponse результат разблокировки информационной базы
+type UnlockInfobaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId    string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID разблокированной базы
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                         // Сообщение о результате
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`                        // Успешность операции
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockInfobaseResponse) Reset() {
+	*x = UnlockInfobaseResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockInfobaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockInfobaseResponse) ProtoMessage() {}
+
+func (x *UnlockInfobaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockInfobaseResponse.ProtoReflect.Descriptor instead.
+func (*UnlockInfobaseResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnlockInfobaseResponse) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnlockInfobaseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// InfobaseDetails полные сведения об информационной базе.
+// Пароль пользователя БД не возвращается.
+type InfobaseDetails struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId  string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // Имя информационной базы
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // Описание
+	// Параметры БД
+	Dbms       DBMSType `protobuf:"varint,4,opt,name=dbms,proto3,enum=infobase.service.DBMSType" json:"dbms,omitempty"` // Тип СУБД
+	DbServer   string   `protobuf:"bytes,5,opt,name=db_server,json=dbServer,proto3" json:"db_server,omitempty"`         // Адрес сервера БД
+	DbName     string   `protobuf:"bytes,6,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`               // Имя базы данных
+	DbUser     string   `protobuf:"bytes,7,opt,name=db_user,json=dbUser,proto3" json:"db_user,omitempty"`               // Пользователь БД
+	Locale     string   `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`                             // Локаль
+	DateOffset int32    `protobuf:"varint,9,opt,name=date_offset,json=dateOffset,proto3" json:"date_offset,omitempty"`  // Смещение дат
+	// Блокировка сеансов
+	SessionsDeny      bool                   `protobuf:"varint,10,opt,name=sessions_deny,json=sessionsDeny,proto3" json:"sessions_deny,omitempty"`                  // Блокировка новых сеансов
+	DeniedFrom        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=denied_from,json=deniedFrom,proto3" json:"denied_from,omitempty"`                         // Начало блокировки
+	DeniedTo          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=denied_to,json=deniedTo,proto3" json:"denied_to,omitempty"`                               // Конец блокировки
+	DeniedMessage     string                 `protobuf:"bytes,13,opt,name=denied_message,json=deniedMessage,proto3" json:"denied_message,omitempty"`                // Сообщение пользователям
+	DeniedParameter   string                 `protobuf:"bytes,14,opt,name=denied_parameter,json=deniedParameter,proto3" json:"denied_parameter,omitempty"`          // Параметр блокировки
+	PermissionCode    string                 `protobuf:"bytes,15,opt,name=permission_code,json=permissionCode,proto3" json:"permission_code,omitempty"`             // Код разрешения
+	ScheduledJobsDeny bool                   `protobuf:"varint,16,opt,name=scheduled_jobs_deny,json=scheduledJobsDeny,proto3" json:"scheduled_jobs_deny,omitempty"` // Блокировка регламентных заданий
+	// Безопасность и лицензии
+	SecurityLevel               SecurityLevel `protobuf:"varint,17,opt,name=security_level,json=securityLevel,proto3,enum=infobase.service.SecurityLevel" json:"security_level,omitempty"`            // Уровень безопасности
+	LicenseDistributionAllow    bool          `protobuf:"varint,18,opt,name=license_distribution_allow,json=licenseDistributionAllow,proto3" json:"license_distribution_allow,omitempty"`             // Распределение лицензий разрешено
+	SecurityProfileName         string        `protobuf:"bytes,19,opt,name=security_profile_name,json=securityProfileName,proto3" json:"security_profile_name,omitempty"`                             // Профиль безопасности
+	SafeModeSecurityProfileName string        `protobuf:"bytes,20,opt,name=safe_mode_security_profile_name,json=safeModeSecurityProfileName,proto3" json:"safe_mode_security_profile_name,omitempty"` // Профиль безопасности безопасного режима
+	// Внешнее управление сеансами
+	ExternalSessionManagerConnectionString string `protobuf:"bytes,21,opt,name=external_session_manager_connection_string,json=externalSessionManagerConnectionString,proto3" json:"external_session_manager_connection_string,omitempty"`
+	ExternalSessionManagerRequired         bool   `protobuf:"varint,22,opt,name=external_session_manager_required,json=externalSessionManagerRequired,proto3" json:"external_session_manager_required,omitempty"`
+	ReserveWorkingProcesses                bool   `protobuf:"varint,23,opt,name=reserve_working_processes,json=reserveWorkingProcesses,proto3" json:"reserve_working_processes,omitempty"` // Резервировать рабочие процессы
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
+}
+
+func (x *InfobaseDetails) Reset() {
+	*x = InfobaseDetails{}
+	mi := &file_infobase_service_management_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfobaseDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfobaseDetails) ProtoMessage() {}
+
+func (x *InfobaseDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfobaseDetails.ProtoReflect.Descriptor instead.
+func (*InfobaseDetails) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InfobaseDetails) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetDbms() DBMSType {
+	if x != nil {
+		return x.Dbms
+	}
+	return DBMSType_DBMS_TYPE_UNSPECIFIED
+}
+
+func (x *InfobaseDetails) GetDbServer() string {
+	if x != nil {
+		return x.DbServer
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetDbName() string {
+	if x != nil {
+		return x.DbName
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetDbUser() string {
+	if x != nil {
+		return x.DbUser
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetDateOffset() int32 {
+	if x != nil {
+		return x.DateOffset
+	}
+	return 0
+}
+
+func (x *InfobaseDetails) GetSessionsDeny() bool {
+	if x != nil {
+		return x.SessionsDeny
+	}
+	return false
+}
+
+func (x *InfobaseDetails) GetDeniedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedFrom
+	}
+	return nil
+}
+
+func (x *InfobaseDetails) GetDeniedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedTo
+	}
+	return nil
+}
+
+func (x *InfobaseDetails) GetDeniedMessage() string {
+	if x != nil {
+		return x.DeniedMessage
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetDeniedParameter() string {
+	if x != nil {
+		return x.DeniedParameter
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetPermissionCode() string {
+	if x != nil {
+		return x.PermissionCode
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetScheduledJobsDeny() bool {
+	if x != nil {
+		return x.ScheduledJobsDeny
+	}
+	return false
+}
+
+func (x *InfobaseDetails) GetSecurityLevel() SecurityLevel {
+	if x != nil {
+		return x.SecurityLevel
+	}
+	return SecurityLevel_SECURITY_LEVEL_UNSPECIFIED
+}
+
+func (x *InfobaseDetails) GetLicenseDistributionAllow() bool {
+	if x != nil {
+		return x.LicenseDistributionAllow
+	}
+	return false
+}
+
+func (x *InfobaseDetails) GetSecurityProfileName() string {
+	if x != nil {
+		return x.SecurityProfileName
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetSafeModeSecurityProfileName() string {
+	if x != nil {
+		return x.SafeModeSecurityProfileName
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetExternalSessionManagerConnectionString() string {
+	if x != nil {
+		return x.ExternalSessionManagerConnectionString
+	}
+	return ""
+}
+
+func (x *InfobaseDetails) GetExternalSessionManagerRequired() bool {
+	if x != nil {
+		return x.ExternalSessionManagerRequired
+	}
+	return false
+}
+
+func (x *InfobaseDetails) GetReserveWorkingProcesses() bool {
+	if x != nil {
+		return x.ReserveWorkingProcesses
+	}
+	return false
+}
+
+// GetInfobaseRequest полные сведения об информационной базе
+type GetInfobaseRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId  string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // UUID кластера 1С
+	InfobaseId string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,3,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,4,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе (RAS требует ее для полных сведений)
+	InfobaseUser     *string `protobuf:"bytes,5,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,6,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetInfobaseRequest) Reset() {
+	*x = GetInfobaseRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseRequest) ProtoMessage() {}
+
+func (x *GetInfobaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseRequest.ProtoReflect.Descriptor instead.
+func (*GetInfobaseRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInfobaseRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetInfobaseRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *GetInfobaseRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *GetInfobaseRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *GetInfobaseRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *GetInfobaseRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// GetInfobaseResponse сведения об информационной базе
+type GetInfobaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Infobase      *InfobaseDetails       `protobuf:"bytes,1,opt,name=infobase,proto3" json:"infobase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInfobaseResponse) Reset() {
+	*x = GetInfobaseResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInfobaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfobaseResponse) ProtoMessage() {}
+
+func (x *GetInfobaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfobaseResponse.ProtoReflect.Descriptor instead.
+func (*GetInfobaseResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetInfobaseResponse) GetInfobase() *InfobaseDetails {
+	if x != nil {
+		return x.Infobase
+	}
+	return nil
+}
+
+// ListInfobasesRequest полные сведения обо всех информационных базах кластера
+type ListInfobasesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"` // UUID кластера 1С
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,2,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,3,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInfobasesRequest) Reset() {
+	*x = ListInfobasesRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInfobasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInfobasesRequest) ProtoMessage() {}
+
+func (x *ListInfobasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInfobasesRequest.ProtoReflect.Descriptor instead.
+func (*ListInfobasesRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListInfobasesRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListInfobasesRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *ListInfobasesRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+// ListInfobasesResponse информационные базы кластера
+type ListInfobasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Infobases     []*InfobaseDetails     `protobuf:"bytes,1,rep,name=infobases,proto3" json:"infobases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInfobasesResponse) Reset() {
+	*x = ListInfobasesResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInfobasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInfobasesResponse) ProtoMessage() {}
+
+func (x *ListInfobasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInfobasesResponse.ProtoReflect.Descriptor instead.
+func (*ListInfobasesResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListInfobasesResponse) GetInfobases() []*InfobaseDetails {
+	if x != nil {
+		return x.Infobases
+	}
+	return nil
+}
+
+// InfobaseLockState состояние блокировки информационной базы
+type InfobaseLockState struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionsDeny      bool                   `protobuf:"varint,1,opt,name=sessions_deny,json=sessionsDeny,proto3" json:"sessions_deny,omitempty"`
+	DeniedFrom        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=denied_from,json=deniedFrom,proto3" json:"denied_from,omitempty"`
+	DeniedTo          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=denied_to,json=deniedTo,proto3" json:"denied_to,omitempty"`
+	DeniedMessage     string                 `protobuf:"bytes,4,opt,name=denied_message,json=deniedMessage,proto3" json:"denied_message,omitempty"`
+	PermissionCode    string                 `protobuf:"bytes,5,opt,name=permission_code,json=permissionCode,proto3" json:"permission_code,omitempty"`
+	ScheduledJobsDeny bool                   `protobuf:"varint,6,opt,name=scheduled_jobs_deny,json=scheduledJobsDeny,proto3" json:"scheduled_jobs_deny,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InfobaseLockState) Reset() {
+	*x = InfobaseLockState{}
+	mi := &file_infobase_service_management_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InfobaseLockState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfobaseLockState) ProtoMessage() {}
+
+func (x *InfobaseLockState) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfobaseLockState.ProtoReflect.Descriptor instead.
+func (*InfobaseLockState) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InfobaseLockState) GetSessionsDeny() bool {
+	if x != nil {
+		return x.SessionsDeny
+	}
+	return false
+}
+
+func (x *InfobaseLockState) GetDeniedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedFrom
+	}
+	return nil
+}
+
+func (x *InfobaseLockState) GetDeniedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedTo
+	}
+	return nil
+}
+
+func (x *InfobaseLockState) GetDeniedMessage() string {
+	if x != nil {
+		return x.DeniedMessage
+	}
+	return ""
+}
+
+func (x *InfobaseLockState) GetPermissionCode() string {
+	if x != nil {
+		return x.PermissionCode
+	}
+	return ""
+}
+
+func (x *InfobaseLockState) GetScheduledJobsDeny() bool {
+	if x != nil {
+		return x.ScheduledJobsDeny
+	}
+	return false
+}
+
+// BeginMaintenanceRequest перевод информационной базы в режим обслуживания:
+// блокировка сеансов с сообщением и кодом разрешения, ожидание завершения
+// сеансов, завершение оставшихся сеансов, блокировка регламентных заданий.
+// Состояние блокировки до начала обслуживания восстанавливает EndMaintenance.
+type BeginMaintenanceRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId      string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`                // UUID кластера 1С
+	InfobaseId     string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`             // UUID информационной базы
+	DeniedMessage  string                 `protobuf:"bytes,3,opt,name=denied_message,json=deniedMessage,proto3" json:"denied_message,omitempty"`    // Сообщение пользователям
+	PermissionCode string                 `protobuf:"bytes,4,opt,name=permission_code,json=permissionCode,proto3" json:"permission_code,omitempty"` // Код разрешения для обхода блокировки (для обновления)
+	// Время ожидания самостоятельного завершения сеансов, по умолчанию 60s, не больше 1h
+	GracePeriod *durationpb.Duration `protobuf:"bytes,5,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	// Сеансы, которые не завершаются (служебные пользователи, конфигуратор)
+	ExcludeUserNames []string `protobuf:"bytes,6,rep,name=exclude_user_names,json=excludeUserNames,proto3" json:"exclude_user_names,omitempty"`
+	ExcludeAppIds    []string `protobuf:"bytes,7,rep,name=exclude_app_ids,json=excludeAppIds,proto3" json:"exclude_app_ids,omitempty"`
+	// Сообщение пользователям завершаемых сеансов, по умолчанию denied_message
+	TerminateMessage string `protobuf:"bytes,8,opt,name=terminate_message,json=terminateMessage,proto3" json:"terminate_message,omitempty"`
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,9,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`              // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,10,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе
+	InfobaseUser     *string `protobuf:"bytes,11,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,12,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BeginMaintenanceRequest) Reset() {
+	*x = BeginMaintenanceRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginMaintenanceRequest) ProtoMessage() {}
+
+func (x *BeginMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*BeginMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BeginMaintenanceRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetDeniedMessage() string {
+	if x != nil {
+		return x.DeniedMessage
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetPermissionCode() string {
+	if x != nil {
+		return x.PermissionCode
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *BeginMaintenanceRequest) GetExcludeUserNames() []string {
+	if x != nil {
+		return x.ExcludeUserNames
+	}
+	return nil
+}
+
+func (x *BeginMaintenanceRequest) GetExcludeAppIds() []string {
+	if x != nil {
+		return x.ExcludeAppIds
+	}
+	return nil
+}
+
+func (x *BeginMaintenanceRequest) GetTerminateMessage() string {
+	if x != nil {
+		return x.TerminateMessage
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *BeginMaintenanceRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// MaintenanceProgress ход перевода в режим обслуживания
+type MaintenanceProgress struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Stage              MaintenanceStage       `protobuf:"varint,1,opt,name=stage,proto3,enum=infobase.service.MaintenanceStage" json:"stage,omitempty"`
+	Message            string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	RemainingSessions  int32                  `protobuf:"varint,3,opt,name=remaining_sessions,json=remainingSessions,proto3" json:"remaining_sessions,omitempty"` // Сеансы, кроме исключенных
+	TerminatedSessions int32                  `protobuf:"varint,4,opt,name=terminated_sessions,json=terminatedSessions,proto3" json:"terminated_sessions,omitempty"`
+	FailedSessions     int32                  `protobuf:"varint,5,opt,name=failed_sessions,json=failedSessions,proto3" json:"failed_sessions,omitempty"`
+	Errors             []string               `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"` // Ошибки завершения сеансов
+	// Состояние до начала обслуживания, передается в EndMaintenance после перезапуска шлюза без SCHEDULED_LOCKS_FILE
+	PreviousState *InfobaseLockState     `protobuf:"bytes,7,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceProgress) Reset() {
+	*x = MaintenanceProgress{}
+	mi := &file_infobase_service_management_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceProgress) ProtoMessage() {}
+
+func (x *MaintenanceProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceProgress.ProtoReflect.Descriptor instead.
+func (*MaintenanceProgress) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MaintenanceProgress) GetStage() MaintenanceStage {
+	if x != nil {
+		return x.Stage
+	}
+	return MaintenanceStage_MAINTENANCE_STAGE_UNSPECIFIED
+}
+
+func (x *MaintenanceProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MaintenanceProgress) GetRemainingSessions() int32 {
+	if x != nil {
+		return x.RemainingSessions
+	}
+	return 0
+}
+
+func (x *MaintenanceProgress) GetTerminatedSessions() int32 {
+	if x != nil {
+		return x.TerminatedSessions
+	}
+	return 0
+}
+
+func (x *MaintenanceProgress) GetFailedSessions() int32 {
+	if x != nil {
+		return x.FailedSessions
+	}
+	return 0
+}
+
+func (x *MaintenanceProgress) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *MaintenanceProgress) GetPreviousState() *InfobaseLockState {
+	if x != nil {
+		return x.PreviousState
+	}
+	return nil
+}
+
+func (x *MaintenanceProgress) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// EndMaintenanceRequest завершение обслуживания с восстановлением
+// состояния блокировки, сохраненного BeginMaintenance
+type EndMaintenanceRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId  string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // UUID кластера 1С
+	InfobaseId string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // UUID информационной базы
+	// Восстанавливаемое состояние, если шлюз его не хранит (после перезапуска без SCHEDULED_LOCKS_FILE)
+	RestoreState *InfobaseLockState `protobuf:"bytes,3,opt,name=restore_state,json=restoreState,proto3" json:"restore_state,omitempty"`
+	// Аутентификация кластера
+	ClusterUser     *string `protobuf:"bytes,4,opt,name=cluster_user,json=clusterUser,proto3,oneof" json:"cluster_user,omitempty"`             // Администратор кластера
+	ClusterPassword *string `protobuf:"bytes,5,opt,name=cluster_password,json=clusterPassword,proto3,oneof" json:"cluster_password,omitempty"` // Пароль администратора (ВНИМАНИЕ: передается только через TLS!)
+	// Аутентификация в информационной базе
+	InfobaseUser     *string `protobuf:"bytes,6,opt,name=infobase_user,json=infobaseUser,proto3,oneof" json:"infobase_user,omitempty"`             // Администратор информационной базы
+	InfobasePassword *string `protobuf:"bytes,7,opt,name=infobase_password,json=infobasePassword,proto3,oneof" json:"infobase_password,omitempty"` // Пароль администратора базы (ВНИМАНИЕ: передается только через TLS!)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EndMaintenanceRequest) Reset() {
+	*x = EndMaintenanceRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndMaintenanceRequest) ProtoMessage() {}
+
+func (x *EndMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*EndMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EndMaintenanceRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *EndMaintenanceRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *EndMaintenanceRequest) GetRestoreState() *InfobaseLockState {
+	if x != nil {
+		return x.RestoreState
+	}
+	return nil
+}
+
+func (x *EndMaintenanceRequest) GetClusterUser() string {
+	if x != nil && x.ClusterUser != nil {
+		return *x.ClusterUser
+	}
+	return ""
+}
+
+func (x *EndMaintenanceRequest) GetClusterPassword() string {
+	if x != nil && x.ClusterPassword != nil {
+		return *x.ClusterPassword
+	}
+	return ""
+}
+
+func (x *EndMaintenanceRequest) GetInfobaseUser() string {
+	if x != nil && x.InfobaseUser != nil {
+		return *x.InfobaseUser
+	}
+	return ""
+}
+
+func (x *EndMaintenanceRequest) GetInfobasePassword() string {
+	if x != nil && x.InfobasePassword != nil {
+		return *x.InfobasePassword
+	}
+	return ""
+}
+
+// EndMaintenanceResponse результат завершения обслуживания
+type EndMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InfobaseId    string                 `protobuf:"bytes,1,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	RestoredState *InfobaseLockState     `protobuf:"bytes,2,opt,name=restored_state,json=restoredState,proto3" json:"restored_state,omitempty"` // Восстановленное состояние
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndMaintenanceResponse) Reset() {
+	*x = EndMaintenanceResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndMaintenanceResponse) ProtoMessage() {}
+
+func (x *EndMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*EndMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EndMaintenanceResponse) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *EndMaintenanceResponse) GetRestoredState() *InfobaseLockState {
+	if x != nil {
+		return x.RestoredState
+	}
+	return nil
+}
+
+func (x *EndMaintenanceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EndMaintenanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ScheduledLock окно блокировки информационной базы, отслеживаемое шлюзом
+type ScheduledLock struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClusterId         string                 `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	InfobaseId        string                 `protobuf:"bytes,3,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"`
+	DeniedFrom        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=denied_from,json=deniedFrom,proto3" json:"denied_from,omitempty"`
+	DeniedTo          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=denied_to,json=deniedTo,proto3" json:"denied_to,omitempty"`
+	SessionsDeny      bool                   `protobuf:"varint,6,opt,name=sessions_deny,json=sessionsDeny,proto3" json:"sessions_deny,omitempty"`
+	DeniedMessage     string                 `protobuf:"bytes,7,opt,name=denied_message,json=deniedMessage,proto3" json:"denied_message,omitempty"`
+	HasPermissionCode bool                   `protobuf:"varint,8,opt,name=has_permission_code,json=hasPermissionCode,proto3" json:"has_permission_code,omitempty"`
+	ScheduledJobsDeny bool                   `protobuf:"varint,9,opt,name=scheduled_jobs_deny,json=scheduledJobsDeny,proto3" json:"scheduled_jobs_deny,omitempty"`
+	Status            ScheduledLockStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=infobase.service.ScheduledLockStatus" json:"status,omitempty"`
+	PreviousState     *InfobaseLockState     `protobuf:"bytes,11,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"` // Состояние, восстанавливаемое по окончании окна
+	LastError         string                 `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`             // Последняя ошибка установки или снятия блокировки
+	RetryAt           *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduledLock) Reset() {
+	*x = ScheduledLock{}
+	mi := &file_infobase_service_management_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledLock) ProtoMessage() {}
+
+func (x *ScheduledLock) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledLock.ProtoReflect.Descriptor instead.
+func (*ScheduledLock) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScheduledLock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledLock) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ScheduledLock) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+func (x *ScheduledLock) GetDeniedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedFrom
+	}
+	return nil
+}
+
+func (x *ScheduledLock) GetDeniedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeniedTo
+	}
+	return nil
+}
+
+func (x *ScheduledLock) GetSessionsDeny() bool {
+	if x != nil {
+		return x.SessionsDeny
+	}
+	return false
+}
+
+func (x *ScheduledLock) GetDeniedMessage() string {
+	if x != nil {
+		return x.DeniedMessage
+	}
+	return ""
+}
+
+func (x *ScheduledLock) GetHasPermissionCode() bool {
+	if x != nil {
+		return x.HasPermissionCode
+	}
+	return false
+}
+
+func (x *ScheduledLock) GetScheduledJobsDeny() bool {
+	if x != nil {
+		return x.ScheduledJobsDeny
+	}
+	return false
+}
+
+func (x *ScheduledLock) GetStatus() ScheduledLockStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledLockStatus_SCHEDULED_LOCK_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledLock) GetPreviousState() *InfobaseLockState {
+	if x != nil {
+		return x.PreviousState
+	}
+	return nil
+}
+
+func (x *ScheduledLock) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduledLock) GetRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryAt
+	}
+	return nil
+}
+
+func (x *ScheduledLock) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListScheduledLocksRequest список окон блокировки
+type ListScheduledLocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`    // Отбор по кластеру, пусто - все кластеры
+	InfobaseId    string                 `protobuf:"bytes,2,opt,name=infobase_id,json=infobaseId,proto3" json:"infobase_id,omitempty"` // Отбор по информационной базе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledLocksRequest) Reset() {
+	*x = ListScheduledLocksRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledLocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledLocksRequest) ProtoMessage() {}
+
+func (x *ListScheduledLocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledLocksRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledLocksRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListScheduledLocksRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListScheduledLocksRequest) GetInfobaseId() string {
+	if x != nil {
+		return x.InfobaseId
+	}
+	return ""
+}
+
+// ListScheduledLocksResponse окна блокировки по времени начала
+type ListScheduledLocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locks         []*ScheduledLock       `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledLocksResponse) Reset() {
+	*x = ListScheduledLocksResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledLocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledLocksResponse) ProtoMessage() {}
+
+func (x *ListScheduledLocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledLocksResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledLocksResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListScheduledLocksResponse) GetLocks() []*ScheduledLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+// CancelScheduledLockRequest отмена окна блокировки. Если окно уже
+// началось, восстанавливается состояние блокировки до него.
+type CancelScheduledLockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledLockRequest) Reset() {
+	*x = CancelScheduledLockRequest{}
+	mi := &file_infobase_service_management_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledLockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledLockRequest) ProtoMessage() {}
+
+func (x *CancelScheduledLockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledLockRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledLockRequest) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelScheduledLockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// CancelScheduledLockResponse результат отмены окна блокировки
+type CancelScheduledLockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lock          *ScheduledLock         `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"` // Отмененное окно
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledLockResponse) Reset() {
+	*x = CancelScheduledLockResponse{}
+	mi := &file_infobase_service_management_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledLockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledLockResponse) ProtoMessage() {}
+
+func (x *CancelScheduledLockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_infobase_service_management_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledLockResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledLockResponse) Descriptor() ([]byte, []int) {
+	return file_infobase_service_management_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelScheduledLockResponse) GetLock() *ScheduledLock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+func (x *CancelScheduledLockResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelScheduledLockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_infobase_service_management_proto protoreflect.FileDescriptor
+
+const file_infobase_service_management_proto_rawDesc = "" +
+	"\n" +
+	"!infobase/service/management.proto\x12\x10infobase.service\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x06\n" +
+	"\x15CreateInfobaseRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x04dbms\x18\x03 \x01(\x0e2\x1a.infobase.service.DBMSTypeR\x04dbms\x12\x1b\n" +
+	"\tdb_server\x18\x04 \x01(\tR\bdbServer\x12\x17\n" +
+	"\adb_name\x18\x05 \x01(\tR\x06dbName\x12\x1c\n" +
+	"\adb_user\x18\x06 \x01(\tH\x00R\x06dbUser\x88\x01\x01\x12$\n" +
+	"\vdb_password\x18\a \x01(\tH\x01R\n" +
+	"dbPassword\x88\x01\x01\x12,\n" +
+	"\x0fcreate_database\x18\b \x01(\bH\x02R\x0ecreateDatabase\x88\x01\x01\x12K\n" +
+	"\x0esecurity_level\x18\t \x01(\x0e2\x1f.infobase.service.SecurityLevelH\x03R\rsecurityLevel\x88\x01\x01\x12\x1b\n" +
+	"\x06locale\x18\n" +
+	" \x01(\tH\x04R\x06locale\x88\x01\x01\x12$\n" +
+	"\vdate_offset\x18\v \x01(\x05H\x05R\n" +
+	"dateOffset\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\f \x01(\tH\x06R\vdescription\x88\x01\x01\x123\n" +
+	"\x13scheduled_jobs_deny\x18\r \x01(\bH\aR\x11scheduledJobsDeny\x88\x01\x01\x12A\n" +
+	"\x1alicense_distribution_allow\x18\x0e \x01(\bH\bR\x18licenseDistributionAllow\x88\x01\x01\x12&\n" +
+	"\fcluster_user\x18\x0f \x01(\tH\tR\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x10 \x01(\tH\n" +
+	"R\x0fclusterPassword\x88\x01\x01B\n" +
+	"\n" +
+	"\b_db_userB\x0e\n" +
+	"\f_db_passwordB\x12\n" +
+	"\x10_create_databaseB\x11\n" +
+	"\x0f_security_levelB\t\n" +
+	"\a_localeB\x0e\n" +
+	"\f_date_offsetB\x0e\n" +
+	"\f_descriptionB\x16\n" +
+	"\x14_scheduled_jobs_denyB\x1d\n" +
+	"\x1b_license_distribution_allowB\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_password\"g\n" +
+	"\x16CreateInfobaseResponse\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xd2\n" +
+	"\n" +
+	"\x15UpdateInfobaseRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12(\n" +
+	"\rsessions_deny\x18\x03 \x01(\bH\x00R\fsessionsDeny\x88\x01\x01\x12@\n" +
+	"\vdenied_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"deniedFrom\x88\x01\x01\x12<\n" +
+	"\tdenied_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\bdeniedTo\x88\x01\x01\x12*\n" +
+	"\x0edenied_message\x18\x06 \x01(\tH\x03R\rdeniedMessage\x88\x01\x01\x12,\n" +
+	"\x0fpermission_code\x18\a \x01(\tH\x04R\x0epermissionCode\x88\x01\x01\x123\n" +
+	"\x13scheduled_jobs_deny\x18\b \x01(\bH\x05R\x11scheduledJobsDeny\x88\x01\x01\x123\n" +
+	"\x04dbms\x18\t \x01(\x0e2\x1a.infobase.service.DBMSTypeH\x06R\x04dbms\x88\x01\x01\x12 \n" +
+	"\tdb_server\x18\n" +
+	" \x01(\tH\aR\bdbServer\x88\x01\x01\x12\x1c\n" +
+	"\adb_name\x18\v \x01(\tH\bR\x06dbName\x88\x01\x01\x12\x1c\n" +
+	"\adb_user\x18\f \x01(\tH\tR\x06dbUser\x88\x01\x01\x12$\n" +
+	"\vdb_password\x18\r \x01(\tH\n" +
+	"R\n" +
+	"dbPassword\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x0e \x01(\tH\vR\vdescription\x88\x01\x01\x12K\n" +
+	"\x0esecurity_level\x18\x0f \x01(\x0e2\x1f.infobase.service.SecurityLevelH\fR\rsecurityLevel\x88\x01\x01\x127\n" +
+	"\x15security_profile_name\x18\x10 \x01(\tH\rR\x13securityProfileName\x88\x01\x01\x12I\n" +
+	"\x1fsafe_mode_security_profile_name\x18\x15 \x01(\tH\x0eR\x1bsafeModeSecurityProfileName\x88\x01\x01\x12&\n" +
+	"\fcluster_user\x18\x11 \x01(\tH\x0fR\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x12 \x01(\tH\x10R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\x13 \x01(\tH\x11R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\x14 \x01(\tH\x12R\x10infobasePassword\x88\x01\x01B\x10\n" +
+	"\x0e_sessions_denyB\x0e\n" +
+	"\f_denied_fromB\f\n" +
+	"\n" +
+	"_denied_toB\x11\n" +
+	"\x0f_denied_messageB\x12\n" +
+	"\x10_permission_codeB\x16\n" +
+	"\x14_scheduled_jobs_denyB\a\n" +
+	"\x05_dbmsB\f\n" +
+	"\n" +
+	"_db_serverB\n" +
+	"\n" +
+	"\b_db_nameB\n" +
+	"\n" +
+	"\b_db_userB\x0e\n" +
+	"\f_db_passwordB\x0e\n" +
+	"\f_descriptionB\x11\n" +
+	"\x0f_security_levelB\x18\n" +
+	"\x16_security_profile_nameB\"\n" +
+	" _safe_mode_security_profile_nameB\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"m\n" +
+	"\x16UpdateInfobaseResponse\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\x90\x03\n" +
+	"\x13DropInfobaseRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x127\n" +
+	"\tdrop_mode\x18\x03 \x01(\x0e2\x1a.infobase.service.DropModeR\bdropMode\x12&\n" +
+	"\fcluster_user\x18\x04 \x01(\tH\x00R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x05 \x01(\tH\x01R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\x06 \x01(\tH\x02R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\a \x01(\tH\x03R\x10infobasePassword\x88\x01\x01B\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"k\n" +
+	"\x14DropInfobaseResponse\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\xcb\x05\n" +
+	"\x13LockInfobaseRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12#\n" +
+	"\rsessions_deny\x18\x03 \x01(\bR\fsessionsDeny\x12@\n" +
+	"\vdenied_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\n" +
+	"deniedFrom\x88\x01\x01\x12<\n" +
+	"\tdenied_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\bdeniedTo\x88\x01\x01\x12*\n" +
+	"\x0edenied_message\x18\x06 \x01(\tH\x02R\rdeniedMessage\x88\x01\x01\x12,\n" +
+	"\x0fpermission_code\x18\a \x01(\tH\x03R\x0epermissionCode\x88\x01\x01\x12.\n" +
+	"\x13scheduled_jobs_deny\x18\b \x01(\bR\x11scheduledJobsDeny\x12&\n" +
+	"\fcluster_user\x18\t \x01(\tH\x04R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\n" +
+	" \x01(\tH\x05R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\v \x01(\tH\x06R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\f \x01(\tH\aR\x10infobasePassword\x88\x01\x01B\x0e\n" +
+	"\f_denied_fromB\f\n" +
+	"\n" +
+	"_denied_toB\x11\n" +
+	"\x0f_denied_messageB\x12\n" +
+	"\x10_permission_codeB\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"\x97\x01\n" +
+	"\x14LockInfobaseResponse\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12*\n" +
+	"\x11scheduled_lock_id\x18\x04 \x01(\tR\x0fscheduledLockId\"\xb6\x03\n" +
+	"\x15UnlockInfobaseRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12'\n" +
+	"\x0funlock_sessions\x18\x03 \x01(\bR\x0eunlockSessions\x122\n" +
+	"\x15unlock_scheduled_jobs\x18\x04 \x01(\bR\x13unlockScheduledJobs\x12&\n" +
+	"\fcluster_user\x18\x05 \x01(\tH\x00R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x06 \x01(\tH\x01R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\a \x01(\tH\x02R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\b \x01(\tH\x03R\x10infobasePassword\x88\x01\x01B\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"m\n" +
+	"\x16UnlockInfobaseResponse\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\"\xc9\b\n" +
+	"\x0fInfobaseDetails\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12.\n" +
+	"\x04dbms\x18\x04 \x01(\x0e2\x1a.infobase.service.DBMSTypeR\x04dbms\x12\x1b\n" +
+	"\tdb_server\x18\x05 \x01(\tR\bdbServer\x12\x17\n" +
+	"\adb_name\x18\x06 \x01(\tR\x06dbName\x12\x17\n" +
+	"\adb_user\x18\a \x01(\tR\x06dbUser\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\x12\x1f\n" +
+	"\vdate_offset\x18\t \x01(\x05R\n" +
+	"dateOffset\x12#\n" +
+	"\rsessions_deny\x18\n" +
+	" \x01(\bR\fsessionsDeny\x12;\n" +
+	"\vdenied_from\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deniedFrom\x127\n" +
+	"\tdenied_to\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bdeniedTo\x12%\n" +
+	"\x0edenied_message\x18\r \x01(\tR\rdeniedMessage\x12)\n" +
+	"\x10denied_parameter\x18\x0e \x01(\tR\x0fdeniedParameter\x12'\n" +
+	"\x0fpermission_code\x18\x0f \x01(\tR\x0epermissionCode\x12.\n" +
+	"\x13scheduled_jobs_deny\x18\x10 \x01(\bR\x11scheduledJobsDeny\x12F\n" +
+	"\x0esecurity_level\x18\x11 \x01(\x0e2\x1f.infobase.service.SecurityLevelR\rsecurityLevel\x12<\n" +
+	"\x1alicense_distribution_allow\x18\x12 \x01(\bR\x18licenseDistributionAllow\x122\n" +
+	"\x15security_profile_name\x18\x13 \x01(\tR\x13securityProfileName\x12D\n" +
+	"\x1fsafe_mode_security_profile_name\x18\x14 \x01(\tR\x1bsafeModeSecurityProfileName\x12Z\n" +
+	"*external_session_manager_connection_string\x18\x15 \x01(\tR&externalSessionManagerConnectionString\x12I\n" +
+	"!external_session_manager_required\x18\x16 \x01(\bR\x1eexternalSessionManagerRequired\x12:\n" +
+	"\x19reserve_working_processes\x18\x17 \x01(\bR\x17reserveWorkingProcesses\"\xd6\x02\n" +
+	"\x12GetInfobaseRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12&\n" +
+	"\fcluster_user\x18\x03 \x01(\tH\x00R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x04 \x01(\tH\x01R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\x05 \x01(\tH\x02R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\x06 \x01(\tH\x03R\x10infobasePassword\x88\x01\x01B\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"T\n" +
+	"\x13GetInfobaseResponse\x12=\n" +
+	"\binfobase\x18\x01 \x01(\v2!.infobase.service.InfobaseDetailsR\binfobase\"\xb3\x01\n" +
+	"\x14ListInfobasesRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12&\n" +
+	"\fcluster_user\x18\x02 \x01(\tH\x00R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x03 \x01(\tH\x01R\x0fclusterPassword\x88\x01\x01B\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_password\"X\n" +
+	"\x15ListInfobasesResponse\x12?\n" +
+	"\tinfobases\x18\x01 \x03(\v2!.infobase.service.InfobaseDetailsR\tinfobases\"\xae\x02\n" +
+	"\x11InfobaseLockState\x12#\n" +
+	"\rsessions_deny\x18\x01 \x01(\bR\fsessionsDeny\x12;\n" +
+	"\vdenied_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deniedFrom\x127\n" +
+	"\tdenied_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bdeniedTo\x12%\n" +
+	"\x0edenied_message\x18\x04 \x01(\tR\rdeniedMessage\x12'\n" +
+	"\x0fpermission_code\x18\x05 \x01(\tR\x0epermissionCode\x12.\n" +
+	"\x13scheduled_jobs_deny\x18\x06 \x01(\bR\x11scheduledJobsDeny\"\xec\x04\n" +
+	"\x17BeginMaintenanceRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12%\n" +
+	"\x0edenied_message\x18\x03 \x01(\tR\rdeniedMessage\x12'\n" +
+	"\x0fpermission_code\x18\x04 \x01(\tR\x0epermissionCode\x12<\n" +
+	"\fgrace_period\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\vgracePeriod\x12,\n" +
+	"\x12exclude_user_names\x18\x06 \x03(\tR\x10excludeUserNames\x12&\n" +
+	"\x0fexclude_app_ids\x18\a \x03(\tR\rexcludeAppIds\x12+\n" +
+	"\x11terminate_message\x18\b \x01(\tR\x10terminateMessage\x12&\n" +
+	"\fcluster_user\x18\t \x01(\tH\x00R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\n" +
+	" \x01(\tH\x01R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\v \x01(\tH\x02R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\f \x01(\tH\x03R\x10infobasePassword\x88\x01\x01B\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"\x82\x03\n" +
+	"\x13MaintenanceProgress\x128\n" +
+	"\x05stage\x18\x01 \x01(\x0e2\".infobase.service.MaintenanceStageR\x05stage\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\x12remaining_sessions\x18\x03 \x01(\x05R\x11remainingSessions\x12/\n" +
+	"\x13terminated_sessions\x18\x04 \x01(\x05R\x12terminatedSessions\x12'\n" +
+	"\x0ffailed_sessions\x18\x05 \x01(\x05R\x0efailedSessions\x12\x16\n" +
+	"\x06errors\x18\x06 \x03(\tR\x06errors\x12J\n" +
+	"\x0eprevious_state\x18\a \x01(\v2#.infobase.service.InfobaseLockStateR\rpreviousState\x12*\n" +
+	"\x02at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"\xa3\x03\n" +
+	"\x15EndMaintenanceRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\x12H\n" +
+	"\rrestore_state\x18\x03 \x01(\v2#.infobase.service.InfobaseLockStateR\frestoreState\x12&\n" +
+	"\fcluster_user\x18\x04 \x01(\tH\x00R\vclusterUser\x88\x01\x01\x12.\n" +
+	"\x10cluster_password\x18\x05 \x01(\tH\x01R\x0fclusterPassword\x88\x01\x01\x12(\n" +
+	"\rinfobase_user\x18\x06 \x01(\tH\x02R\finfobaseUser\x88\x01\x01\x120\n" +
+	"\x11infobase_password\x18\a \x01(\tH\x03R\x10infobasePassword\x88\x01\x01B\x0f\n" +
+	"\r_cluster_userB\x13\n" +
+	"\x11_cluster_passwordB\x10\n" +
+	"\x0e_infobase_userB\x14\n" +
+	"\x12_infobase_password\"\xb9\x01\n" +
+	"\x16EndMaintenanceResponse\x12\x1f\n" +
+	"\vinfobase_id\x18\x01 \x01(\tR\n" +
+	"infobaseId\x12J\n" +
+	"\x0erestored_state\x18\x02 \x01(\v2#.infobase.service.InfobaseLockStateR\rrestoredState\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\"\x9d\x05\n" +
+	"\rScheduledLock\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x03 \x01(\tR\n" +
+	"infobaseId\x12;\n" +
+	"\vdenied_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deniedFrom\x127\n" +
+	"\tdenied_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeniedTo\x12#\n" +
+	"\rsessions_deny\x18\x06 \x01(\bR\fsessionsDeny\x12%\n" +
+	"\x0edenied_message\x18\a \x01(\tR\rdeniedMessage\x12.\n" +
+	"\x13has_permission_code\x18\b \x01(\bR\x11hasPermissionCode\x12.\n" +
+	"\x13scheduled_jobs_deny\x18\t \x01(\bR\x11scheduledJobsDeny\x12=\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2%.infobase.service.ScheduledLockStatusR\x06status\x12J\n" +
+	"\x0eprevious_state\x18\v \x01(\v2#.infobase.service.InfobaseLockStateR\rpreviousState\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\f \x01(\tR\tlastError\x125\n" +
+	"\bretry_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"[\n" +
+	"\x19ListScheduledLocksRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1f\n" +
+	"\vinfobase_id\x18\x02 \x01(\tR\n" +
+	"infobaseId\"S\n" +
+	"\x1aListScheduledLocksResponse\x125\n" +
+	"\x05locks\x18\x01 \x03(\v2\x1f.infobase.service.ScheduledLockR\x05locks\",\n" +
+	"\x1aCancelScheduledLockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x86\x01\n" +
+	"\x1bCancelScheduledLockResponse\x123\n" +
+	"\x04lock\x18\x01 \x01(\v2\x1f.infobase.service.ScheduledLockR\x04lock\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess*\x88\x01\n" +
+	"\bDBMSType\x12\x19\n" +
+	"\x15DBMS_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16DBMS_TYPE_MSSQL_SERVER\x10\x01\x12\x18\n" +
+	"\x14DBMS_TYPE_POSTGRESQL\x10\x02\x12\x15\n" +
+	"\x11DBMS_TYPE_IBM_DB2\x10\x03\x12\x14\n" +
+	"\x10DBMS_TYPE_ORACLE\x10\x04*\x87\x01\n" +
+	"\rSecurityLevel\x12\x1e\n" +
+	"\x1aSECURITY_LEVEL_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10SECURITY_LEVEL_0\x10\x01\x12\x14\n" +
+	"\x10SECURITY_LEVEL_1\x10\x02\x12\x14\n" +
+	"\x10SECURITY_LEVEL_2\x10\x03\x12\x14\n" +
+	"\x10SECURITY_LEVEL_3\x10\x04*\x7f\n" +
+	"\bDropMode\x12\x19\n" +
+	"\x15DROP_MODE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DROP_MODE_UNREGISTER_ONLY\x10\x01\x12\x1b\n" +
+	"\x17DROP_MODE_DROP_DATABASE\x10\x02\x12\x1c\n" +
+	"\x18DROP_MODE_CLEAR_DATABASE\x10\x03*\x94\x02\n" +
+	"\x10MaintenanceStage\x12!\n" +
+	"\x1dMAINTENANCE_STAGE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dMAINTENANCE_STAGE_STATE_SAVED\x10\x01\x12%\n" +
+	"!MAINTENANCE_STAGE_SESSIONS_LOCKED\x10\x02\x12\x1e\n" +
+	"\x1aMAINTENANCE_STAGE_DRAINING\x10\x03\x12)\n" +
+	"%MAINTENANCE_STAGE_SESSIONS_TERMINATED\x10\x04\x12+\n" +
+	"'MAINTENANCE_STAGE_SCHEDULED_JOBS_LOCKED\x10\x05\x12\x1b\n" +
+	"\x17MAINTENANCE_STAGE_READY\x10\x06*\x81\x01\n" +
+	"\x13ScheduledLockStatus\x12%\n" +
+	"!SCHEDULED_LOCK_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dSCHEDULED_LOCK_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cSCHEDULED_LOCK_STATUS_ACTIVE\x10\x022\xf8\b\n" +
+	"\x19InfobaseManagementService\x12c\n" +
+	"\x0eCreateInfobase\x12'.infobase.service.CreateInfobaseRequest\x1a(.infobase.service.CreateInfobaseResponse\x12c\n" +
+	"\x0eUpdateInfobase\x12'.infobase.service.UpdateInfobaseRequest\x1a(.infobase.service.UpdateInfobaseResponse\x12]\n" +
+	"\fDropInfobase\x12%.infobase.service.DropInfobaseRequest\x1a&.infobase.service.DropInfobaseResponse\x12]\n" +
+	"\fLockInfobase\x12%.infobase.service.LockInfobaseRequest\x1a&.infobase.service.LockInfobaseResponse\x12c\n" +
+	"\x0eUnlockInfobase\x12'.infobase.service.UnlockInfobaseRequest\x1a(.infobase.service.UnlockInfobaseResponse\x12Z\n" +
+	"\vGetInfobase\x12$.infobase.service.GetInfobaseRequest\x1a%.infobase.service.GetInfobaseResponse\x12`\n" +
+	"\rListInfobases\x12&.infobase.service.ListInfobasesRequest\x1a'.infobase.service.ListInfobasesResponse\x12f\n" +
+	"\x10BeginMaintenance\x12).infobase.service.BeginMaintenanceRequest\x1a%.infobase.service.MaintenanceProgress0\x01\x12c\n" +
+	"\x0eEndMaintenance\x12'.infobase.service.EndMaintenanceRequest\x1a(.infobase.service.EndMaintenanceResponse\x12o\n" +
+	"\x12ListScheduledLocks\x12+.infobase.service.ListScheduledLocksRequest\x1a,.infobase.service.ListScheduledLocksResponse\x12r\n" +
+	"\x13CancelScheduledLock\x12,.infobase.service.CancelScheduledLockRequest\x1a-.infobase.service.CancelScheduledLockResponseB\xc4\x01\n" +
+	"\x14com.infobase.serviceB\x0fManagementProtoP\x01Z:github.com/v8platform/ras-grpc-gq/pkg/gen/infobase/service\xa2\x02\x03ISX\xaa\x02\x10Infobase.Service\xca\x02\x10Infobase\\Service\xe2\x02\x1cInfobase\\Service\\GPBMetadata\xea\x02\x11Infobase::Serviceb\x06proto3"
+
+var (
+	file_infobase_service_management_proto_rawDescOnce sync.Once
+	file_infobase_service_management_proto_rawDescData []byte
+)
+
+func file_infobase_service_management_proto_rawDescGZIP() []byte {
+	file_infobase_service_management_proto_rawDescOnce.Do(func() {
+		file_infobase_service_management_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_infobase_service_management_proto_rawDesc), len(file_infobase_service_management_proto_rawDesc)))
+	})
+	return file_infobase_service_management_proto_rawDescData
+}
+
+var file_infobase_service_management_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_infobase_service_management_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_infobase_service_management_proto_goTypes = []any{
+	(DBMSType)(0),                       // 0: infobase.service.DBMSType
+	(SecurityLevel)(0),                  // 1: infobase.service.SecurityLevel
+	(DropMode)(0),                       // 2: infobase.service.DropMode
+	(MaintenanceStage)(0),               // 3: infobase.service.MaintenanceStage
+	(ScheduledLockStatus)(0),            // 4: infobase.service.ScheduledLockStatus
+	(*CreateInfobaseRequest)(nil),       // 5: infobase.service.CreateInfobaseRequest
+	(*CreateInfobaseResponse)(nil),      // 6: infobase.service.CreateInfobaseResponse
+	(*UpdateInfobaseRequest)(nil),       // 7: infobase.service.UpdateInfobaseRequest
+	(*UpdateInfobaseResponse)(nil),      // 8: infobase.service.UpdateInfobaseResponse
+	(*DropInfobaseRequest)(nil),         // 9: infobase.service.DropInfobaseRequest
+	(*DropInfobaseResponse)(nil),        // 10: infobase.service.DropInfobaseResponse
+	(*LockInfobaseRequest)(nil),         // 11: infobase.service.LockInfobaseRequest
+	(*LockInfobaseResponse)(nil),        // 12: infobase.service.LockInfobaseResponse
+	(*UnlockInfobaseRequest)(nil),       // 13: infobase.service.UnlockInfobaseRequest
+	(*UnlockInfobaseResponse)(nil),      // 14: infobase.service.UnlockInfobaseResponse
+	(*InfobaseDetails)(nil),             // 15: infobase.service.InfobaseDetails
+	(*GetInfobaseRequest)(nil),          // 16: infobase.service.GetInfobaseRequest
+	(*GetInfobaseResponse)(nil),         // 17: infobase.service.GetInfobaseResponse
+	(*ListInfobasesRequest)(nil),        // 18: infobase.service.ListInfobasesRequest
+	(*ListInfobasesResponse)(nil),       // 19: infobase.service.ListInfobasesResponse
+	(*InfobaseLockState)(nil),           // 20: infobase.service.InfobaseLockState
+	(*BeginMaintenanceRequest)(nil),     // 21: infobase.service.BeginMaintenanceRequest
+	(*MaintenanceProgress)(nil),         // 22: infobase.service.MaintenanceProgress
+	(*EndMaintenanceRequest)(nil),       // 23: infobase.service.EndMaintenanceRequest
+	(*EndMaintenanceResponse)(nil),      // 24: infobase.service.EndMaintenanceResponse
+	(*ScheduledLock)(nil),               // 25: infobase.service.ScheduledLock
+	(*ListScheduledLocksRequest)(nil),   // 26: infobase.service.ListScheduledLocksRequest
+	(*ListScheduledLocksResponse)(nil),  // 27: infobase.service.ListScheduledLocksResponse
+	(*CancelScheduledLockRequest)(nil),  // 28: infobase.service.CancelScheduledLockRequest
+	(*CancelScheduledLockResponse)(nil), // 29: infobase.service.CancelScheduledLockResponse
+	(*timestamppb.Timestamp)(nil),       // 30: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 31: google.protobuf.Duration
+}
+var file_infobase_service_management_proto_depIdxs = []int32{
+	0,  // 0: infobase.service.CreateInfobaseRequest.dbms:type_name -> infobase.service.DBMSType
+	1,  // 1: infobase.service.CreateInfobaseRequest.security_level:type_name -> infobase.service.SecurityLevel
+	30, // 2: infobase.service.UpdateInfobaseRequest.denied_from:type_name -> google.protobuf.Timestamp
+	30, // 3: infobase.service.UpdateInfobaseRequest.denied_to:type_name -> google.protobuf.Timestamp
+	0,  // 4: infobase.service.UpdateInfobaseRequest.dbms:type_name -> infobase.service.DBMSType
+	1,  // 5: infobase.service.UpdateInfobaseRequest.security_level:type_name -> infobase.service.SecurityLevel
+	2,  // 6: infobase.service.DropInfobaseRequest.drop_mode:type_name -> infobase.service.DropMode
+	30, // 7: infobase.service.LockInfobaseRequest.denied_from:type_name -> google.protobuf.Timestamp
+	30, // 8: infobase.service.LockInfobaseRequest.denied_to:type_name -> google.protobuf.Timestamp
+	0,  // 9: infobase.service.InfobaseDetails.dbms:type_name -> infobase.service.DBMSType
+	30, // 10: infobase.service.InfobaseDetails.denied_from:type_name -> google.protobuf.Timestamp
+	30, // 11: infobase.service.InfobaseDetails.denied_to:type_name -> google.protobuf.Timestamp
+	1,  // 12: infobase.service.InfobaseDetails.security_level:type_name -> infobase.service.SecurityLevel
+	15, // 13: infobase.service.GetInfobaseResponse.infobase:type_name -> infobase.service.InfobaseDetails
+	15, // 14: infobase.service.ListInfobasesResponse.infobases:type_name -> infobase.service.InfobaseDetails
+	30, // 15: infobase.service.InfobaseLockState.denied_from:type_name -> google.protobuf.Timestamp
+	30, // 16: infobase.service.InfobaseLockState.denied_to:type_name -> google.protobuf.Timestamp
+	31, // 17: infobase.service.BeginMaintenanceRequest.grace_period:type_name -> google.protobuf.Duration
+	3,  // 18: infobase.service.MaintenanceProgress.stage:type_name -> infobase.service.MaintenanceStage
+	20, // 19: infobase.service.MaintenanceProgress.previous_state:type_name -> infobase.service.InfobaseLockState
+	30, // 20: infobase.service.MaintenanceProgress.at:type_name -> google.protobuf.Timestamp
+	20, // 21: infobase.service.EndMaintenanceRequest.restore_state:type_name -> infobase.service.InfobaseLockState
+	20, // 22: infobase.service.EndMaintenanceResponse.restored_state:type_name -> infobase.service.InfobaseLockState
+	30, // 23: infobase.service.ScheduledLock.denied_from:type_name -> google.protobuf.Timestamp
+	30, // 24: infobase.service.ScheduledLock.denied_to:type_name -> google.protobuf.Timestamp
+	4,  // 25: infobase.service.ScheduledLock.status:type_name -> infobase.service.ScheduledLockStatus
+	20, // 26: infobase.service.ScheduledLock.previous_state:type_name -> infobase.service.InfobaseLockState
+	30, // 27: infobase.service.ScheduledLock.retry_at:type_name -> google.protobuf.Timestamp
+	30, // 28: infobase.service.ScheduledLock.created_at:type_name -> google.protobuf.Timestamp
+	25, // 29: infobase.service.ListScheduledLocksResponse.locks:type_name -> infobase.service.ScheduledLock
+	25, // 30: infobase.service.CancelScheduledLockResponse.lock:type_name -> infobase.service.ScheduledLock
+	5,  // 31: infobase.service.InfobaseManagementService.CreateInfobase:input_type -> infobase.service.CreateInfobaseRequest
+	7,  // 32: infobase.service.InfobaseManagementService.UpdateInfobase:input_type -> infobase.service.UpdateInfobaseRequest
+	9,  // 33: infobase.service.InfobaseManagementService.DropInfobase:input_type -> infobase.service.DropInfobaseRequest
+	11, // 34: infobase.service.InfobaseManagementService.LockInfobase:input_type -> infobase.service.LockInfobaseRequest
+	13, // 35: infobase.service.InfobaseManagementService.UnlockInfobase:input_type -> infobase.service.UnlockInfobaseRequest
+	16, // 36: infobase.service.InfobaseManagementService.GetInfobase:input_type -> infobase.service.GetInfobaseRequest
+	18, // 37: infobase.service.InfobaseManagementService.ListInfobases:input_type -> infobase.service.ListInfobasesRequest
+	21, // 38: infobase.service.InfobaseManagementService.BeginMaintenance:input_type -> infobase.service.BeginMaintenanceRequest
+	23, // 39: infobase.service.InfobaseManagementService.EndMaintenance:input_type -> infobase.service.EndMaintenanceRequest
+	26, // 40: infobase.service.InfobaseManagementService.ListScheduledLocks:input_type -> infobase.service.ListScheduledLocksRequest
+	28, // 41: infobase.service.InfobaseManagementService.CancelScheduledLock:input_type -> infobase.service.CancelScheduledLockRequest
+	6,  // 42: infobase.service.InfobaseManagementService.CreateInfobase:output_type -> infobase.service.CreateInfobaseResponse
+	8,  // 43: infobase.service.InfobaseManagementService.UpdateInfobase:output_type -> infobase.service.UpdateInfobaseResponse
+	10, // 44: infobase.service.InfobaseManagementService.DropInfobase:output_type -> infobase.service.DropInfobaseResponse
+	12, // 45: infobase.service.InfobaseManagementService.LockInfobase:output_type -> infobase.service.LockInfobaseResponse
+	14, // 46: infobase.service.InfobaseManagementService.UnlockInfobase:output_type -> infobase.service.UnlockInfobaseResponse
+	17, // 47: infobase.service.InfobaseManagementService.GetInfobase:output_type -> infobase.service.GetInfobaseResponse
+	19, // 48: infobase.service.InfobaseManagementService.ListInfobases:output_type -> infobase.service.ListInfobasesResponse
+	22, // 49: infobase.service.InfobaseManagementService.BeginMaintenance:output_type -> infobase.service.MaintenanceProgress
+	24, // 50: infobase.service.InfobaseManagementService.EndMaintenance:output_type -> infobase.service.EndMaintenanceResponse
+	27, // 51: infobase.service.InfobaseManagementService.ListScheduledLocks:output_type -> infobase.service.ListScheduledLocksResponse
+	29, // 52: infobase.service.InfobaseManagementService.CancelScheduledLock:output_type -> infobase.service.CancelScheduledLockResponse
+	42, // [42:53] is the sub-list for method output_type
+	31, // [31:42] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_infobase_service_management_proto_init() }
+func file_infobase_service_management_proto_init() {
+	if File_infobase_service_management_proto != nil {
+		return
+	}
+	file_infobase_service_management_proto_msgTypes[0].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[2].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[4].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[6].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[8].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[11].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[13].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[16].OneofWrappers = []any{}
+	file_infobase_service_management_proto_msgTypes[18].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_infobase_service_management_proto_rawDesc), len(file_infobase_service_management_proto_rawDesc)),
+			NumEnums:      5,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_infobase_service_management_proto_goTypes,
+		DependencyIndexes: file_infobase_service_management_proto_depIdxs,
+		EnumInfos:         file_infobase_service_management_proto_enumTypes,
+		MessageInfos:      file_infobase_service_management_proto_msgTypes,
+	}.Build()
+	File_infobase_service_management_proto = out.File
+	file_infobase_service_management_proto_goTypes = nil
+	file_infobase_service_management_proto_depIdxs = nil
+}
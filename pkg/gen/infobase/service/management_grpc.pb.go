@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: infobase/service/management.proto
+
+package service
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InfobaseManagementService_CreateInfobase_FullMethodName      = "/infobase.service.InfobaseManagementService/CreateInfobase"
+	InfobaseManagementService_UpdateInfobase_FullMethodName      = "/infobase.service.InfobaseManagementService/UpdateInfobase"
+	InfobaseManagementService_DropInfobase_FullMethodName        = "/infobase.service.InfobaseManagementService/DropInfobase"
+	InfobaseManagementService_LockInfobase_FullMethodName        = "/infobase.service.InfobaseManagementService/LockInfobase"
+	InfobaseManagementService_UnlockInfobase_FullMethodName      = "/infobase.service.InfobaseManagementService/UnlockInfobase"
+	InfobaseManagementService_GetInfobase_FullMethodName         = "/infobase.service.InfobaseManagementService/GetInfobase"
+	InfobaseManagementService_ListInfobases_FullMethodName       = "/infobase.service.InfobaseManagementService/ListInfobases"
+	InfobaseManagementService_BeginMaintenance_FullMethodName    = "/infobase.service.InfobaseManagementService/BeginMaintenance"
+	InfobaseManagementService_EndMaintenance_FullMethodName      = "/infobase.service.InfobaseManagementService/EndMaintenance"
+	InfobaseManagementService_ListScheduledLocks_FullMethodName  = "/infobase.service.InfobaseManagementService/ListScheduledLocks"
+	InfobaseManagementService_CancelScheduledLock_FullMethodName = "/infobase.service.InfobaseManagementService/CancelScheduledLock"
+)
+
+// InfobaseManagementServiceClient is the client API for InfobaseManagementService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InfobaseManagementService предоставляет gRPC методы для управления
+// информационными базами 1С в кластере
+type InfobaseManagementServiceClient interface {
+	// CreateInfobase создает новую информационную базу в кластере
+	CreateInfobase(ctx context.Context, in *CreateInfobaseRequest, opts ...grpc.CallOption) (*CreateInfobaseResponse, error)
+	// UpdateInfobase изменяет параметры существующей информационной базы
+	UpdateInfobase(ctx context.Context, in *UpdateInfobaseRequest, opts ...grpc.CallOption) (*UpdateInfobaseResponse, error)
+	// DropInfobase удаляет информационную базу из кластера
+	// ВНИМАНИЕ: Деструктивная операция!
+	DropInfobase(ctx context.Context, in *DropInfobaseRequest, opts ...grpc.CallOption) (*DropInfobaseResponse, error)
+	// LockInfobase блокирует доступ к информационной базе
+	// (сеансы пользователей и/или регламентные задания).
+	// Если задан SCHEDULED_LOCKS_FILE, окно denied_from..denied_to отслеживает
+	// шлюз: блокировки устанавливаются и снимаются в срок с учетными данными
+	// из хранилища, по окончании окна восстанавливается прежнее состояние.
+	// Без учетных данных кластера и базы в хранилище окно не принимается.
+	LockInfobase(ctx context.Context, in *LockInfobaseRequest, opts ...grpc.CallOption) (*LockInfobaseResponse, error)
+	// UnlockInfobase снимает блокировку с информационной базы
+	UnlockInfobase(ctx context.Context, in *UnlockInfobaseRequest, opts ...grpc.CallOption) (*UnlockInfobaseResponse, error)
+	// GetInfobase возвращает полные сведения об информационной базе
+	GetInfobase(ctx context.Context, in *GetInfobaseRequest, opts ...grpc.CallOption) (*GetInfobaseResponse, error)
+	// ListInfobases возвращает полные сведения обо всех информационных базах кластера
+	ListInfobases(ctx context.Context, in *ListInfobasesRequest, opts ...grpc.CallOption) (*ListInfobasesResponse, error)
+	// BeginMaintenance переводит информационную базу в режим обслуживания,
+	// ход выполнения передается потоком
+	BeginMaintenance(ctx context.Context, in *BeginMaintenanceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MaintenanceProgress], error)
+	// EndMaintenance восстанавливает состояние блокировки до BeginMaintenance
+	EndMaintenance(ctx context.Context, in *EndMaintenanceRequest, opts ...grpc.CallOption) (*EndMaintenanceResponse, error)
+	// ListScheduledLocks возвращает окна блокировки, отслеживаемые шлюзом
+	ListScheduledLocks(ctx context.Context, in *ListScheduledLocksRequest, opts ...grpc.CallOption) (*ListScheduledLocksResponse, error)
+	// CancelScheduledLock отменяет окно блокировки
+	CancelScheduledLock(ctx context.Context, in *CancelScheduledLockRequest, opts ...grpc.CallOption) (*CancelScheduledLockResponse, error)
+}
+
+type infobaseManagementServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInfobaseManagementServiceClient(cc grpc.ClientConnInterface) InfobaseManagementServiceClient {
+	return &infobaseManagementServiceClient{cc}
+}
+
+func (c *infobaseManagementServiceClient) CreateInfobase(ctx context.Context, in *CreateInfobaseRequest, opts ...grpc.CallOption) (*CreateInfobaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInfobaseResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_CreateInfobase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) UpdateInfobase(ctx context.Context, in *UpdateInfobaseRequest, opts ...grpc.CallOption) (*UpdateInfobaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInfobaseResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_UpdateInfobase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) DropInfobase(ctx context.Context, in *DropInfobaseRequest, opts ...grpc.CallOption) (*DropInfobaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DropInfobaseResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_DropInfobase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) LockInfobase(ctx context.Context, in *LockInfobaseRequest, opts ...grpc.CallOption) (*LockInfobaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LockInfobaseResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_LockInfobase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) UnlockInfobase(ctx context.Context, in *UnlockInfobaseRequest, opts ...grpc.CallOption) (*UnlockInfobaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockInfobaseResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_UnlockInfobase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) GetInfobase(ctx context.Context, in *GetInfobaseRequest, opts ...grpc.CallOption) (*GetInfobaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInfobaseResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_GetInfobase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) ListInfobases(ctx context.Context, in *ListInfobasesRequest, opts ...grpc.CallOption) (*ListInfobasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInfobasesResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_ListInfobases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) BeginMaintenance(ctx context.Context, in *BeginMaintenanceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[MaintenanceProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InfobaseManagementService_ServiceDesc.Streams[0], InfobaseManagementService_BeginMaintenance_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BeginMaintenanceRequest, MaintenanceProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InfobaseManagementService_BeginMaintenanceClient = grpc.ServerStreamingClient[MaintenanceProgress]
+
+func (c *infobaseManagementServiceClient) EndMaintenance(ctx context.Context, in *EndMaintenanceRequest, opts ...grpc.CallOption) (*EndMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndMaintenanceResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_EndMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) ListScheduledLocks(ctx context.Context, in *ListScheduledLocksRequest, opts ...grpc.CallOption) (*ListScheduledLocksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledLocksResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_ListScheduledLocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *infobaseManagementServiceClient) CancelScheduledLock(ctx context.Context, in *CancelScheduledLockRequest, opts ...grpc.CallOption) (*CancelScheduledLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledLockResponse)
+	err := c.cc.Invoke(ctx, InfobaseManagementService_CancelScheduledLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InfobaseManagementServiceServer is the server API for InfobaseManagementService service.
+// All implementations must embed UnimplementedInfobaseManagementServiceServer
+// for forward compatibility.
+//
+// InfobaseManagementService предоставляет gRPC методы для управления
+// информационными базами 1С в кластере
+type InfobaseManagementServiceServer interface {
+	// CreateInfobase создает новую информационную базу в кластере
+	CreateInfobase(context.Context, *CreateInfobaseRequest) (*CreateInfobaseResponse, error)
+	// UpdateInfobase изменяет параметры существующей информационной базы
+	UpdateInfobase(context.Context, *UpdateInfobaseRequest) (*UpdateInfobaseResponse, error)
+	// DropInfobase удаляет информационную базу из кластера
+	// ВНИМАНИЕ: Деструктивная операция!
+	DropInfobase(context.Context, *DropInfobaseRequest) (*DropInfobaseResponse, error)
+	// LockInfobase блокирует доступ к информационной базе
+	// (сеансы пользователей и/или регламентные задания).
+	// Если задан SCHEDULED_LOCKS_FILE, окно denied_from..denied_to отслеживает
+	// шлюз: блокировки устанавливаются и снимаются в срок с учетными данными
+	// из хранилища, по окончании окна восстанавливается прежнее состояние.
+	// Без учетных данных кластера и базы в хранилище окно не принимается.
+	LockInfobase(context.Context, *LockInfobaseRequest) (*LockInfobaseResponse, error)
+	// UnlockInfobase снимает блокировку с информационной базы
+	UnlockInfobase(context.Context, *UnlockInfobaseRequest) (*UnlockInfobaseResponse, error)
+	// GetInfobase возвращает полные сведения об информационной базе
+	GetInfobase(context.Context, *GetInfobaseRequest) (*GetInfobaseResponse, error)
+	// ListInfobases возвращает полные сведения обо всех информационных базах кластера
+	ListInfobases(context.Context, *ListInfobasesRequest) (*ListInfobasesResponse, error)
+	// BeginMaintenance переводит информационную базу в режим обслуживания,
+	// ход выполнения передается потоком
+	BeginMaintenance(*BeginMaintenanceRequest, grpc.ServerStreamingServer[MaintenanceProgress]) error
+	// EndMaintenance восстанавливает состояние блокировки до BeginMaintenance
+	EndMaintenance(context.Context, *EndMaintenanceRequest) (*EndMaintenanceResponse, error)
+	// ListScheduledLocks возвращает окна блокировки, отслеживаемые шлюзом
+	ListScheduledLocks(context.Context, *ListScheduledLocksRequest) (*ListScheduledLocksResponse, error)
+	// CancelScheduledLock отменяет окно блокировки
+	CancelScheduledLock(context.Context, *CancelScheduledLockRequest) (*CancelScheduledLockResponse, error)
+	mustEmbedUnimplementedInfobaseManagementServiceServer()
+}
+
+// UnimplementedInfobaseManagementServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInfobaseManagementServiceServer struct{}
+
+func (UnimplementedInfobaseManagementServiceServer) CreateInfobase(context.Context, *CreateInfobaseRequest) (*CreateInfobaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInfobase not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) UpdateInfobase(context.Context, *UpdateInfobaseRequest) (*UpdateInfobaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInfobase not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) DropInfobase(context.Context, *DropInfobaseRequest) (*DropInfobaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropInfobase not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) LockInfobase(context.Context, *LockInfobaseRequest) (*LockInfobaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockInfobase not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) UnlockInfobase(context.Context, *UnlockInfobaseRequest) (*UnlockInfobaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockInfobase not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) GetInfobase(context.Context, *GetInfobaseRequest) (*GetInfobaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfobase not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) ListInfobases(context.Context, *ListInfobasesRequest) (*ListInfobasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInfobases not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) BeginMaintenance(*BeginMaintenanceRequest, grpc.ServerStreamingServer[MaintenanceProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method BeginMaintenance not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) EndMaintenance(context.Context, *EndMaintenanceRequest) (*EndMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndMaintenance not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) ListScheduledLocks(context.Context, *ListScheduledLocksRequest) (*ListScheduledLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledLocks not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) CancelScheduledLock(context.Context, *CancelScheduledLockRequest) (*CancelScheduledLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledLock not implemented")
+}
+func (UnimplementedInfobaseManagementServiceServer) mustEmbedUnimplementedInfobaseManagementServiceServer() {
+}
+func (UnimplementedInfobaseManagementServiceServer) testEmbeddedByValue() {}
+
+// UnsafeInfobaseManagementServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InfobaseManagementServiceServer will
+// result in compilation errors.
+type UnsafeInfobaseManagementServiceServer interface {
+	mustEmbedUnimplementedInfobaseManagementServiceServer()
+}
+
+func RegisterInfobaseManagementServiceServer(s grpc.ServiceRegistrar, srv InfobaseManagementServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInfobaseManagementServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InfobaseManagementService_ServiceDesc, srv)
+}
+
+func _InfobaseManagementService_CreateInfobase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInfobaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).CreateInfobase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_CreateInfobase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).CreateInfobase(ctx, req.(*CreateInfobaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_UpdateInfobase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInfobaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).UpdateInfobase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_UpdateInfobase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).UpdateInfobase(ctx, req.(*UpdateInfobaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_DropInfobase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropInfobaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).DropInfobase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_DropInfobase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).DropInfobase(ctx, req.(*DropInfobaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_LockInfobase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockInfobaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).LockInfobase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_LockInfobase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).LockInfobase(ctx, req.(*LockInfobaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_UnlockInfobase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockInfobaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).UnlockInfobase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_UnlockInfobase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).UnlockInfobase(ctx, req.(*UnlockInfobaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_GetInfobase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfobaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).GetInfobase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_GetInfobase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).GetInfobase(ctx, req.(*GetInfobaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_ListInfobases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInfobasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).ListInfobases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_ListInfobases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).ListInfobases(ctx, req.(*ListInfobasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_BeginMaintenance_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BeginMaintenanceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InfobaseManagementServiceServer).BeginMaintenance(m, &grpc.GenericServerStream[BeginMaintenanceRequest, MaintenanceProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InfobaseManagementService_BeginMaintenanceServer = grpc.ServerStreamingServer[MaintenanceProgress]
+
+func _InfobaseManagementService_EndMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).EndMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_EndMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).EndMaintenance(ctx, req.(*EndMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_ListScheduledLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).ListScheduledLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_ListScheduledLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).ListScheduledLocks(ctx, req.(*ListScheduledLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InfobaseManagementService_CancelScheduledLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InfobaseManagementServiceServer).CancelScheduledLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InfobaseManagementService_CancelScheduledLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InfobaseManagementServiceServer).CancelScheduledLock(ctx, req.(*CancelScheduledLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InfobaseManagementService_ServiceDesc is the grpc.ServiceDesc for InfobaseManagementService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InfobaseManagementService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "infobase.service.InfobaseManagementService",
+	HandlerType: (*InfobaseManagementServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInfobase",
+			Handler:    _InfobaseManagementService_CreateInfobase_Handler,
+		},
+		{
+			MethodName: "UpdateInfobase",
+			Handler:    _InfobaseManagementService_UpdateInfobase_Handler,
+		},
+		{
+			MethodName: "DropInfobase",
+			Handler:    _InfobaseManagementService_DropInfobase_Handler,
+		},
+		{
+			MethodName: "LockInfobase",
+			Handler:    _InfobaseManagementService_LockInfobase_Handler,
+		},
+		{
+			MethodName: "UnlockInfobase",
+			Handler:    _InfobaseManagementService_UnlockInfobase_Handler,
+		},
+		{
+			MethodName: "GetInfobase",
+			Handler:    _InfobaseManagementService_GetInfobase_Handler,
+		},
+		{
+			MethodName: "ListInfobases",
+			Handler:    _InfobaseManagementService_ListInfobases_Handler,
+		},
+		{
+			MethodName: "EndMaintenance",
+			Handler:    _InfobaseManagementService_EndMaintenance_Handler,
+		},
+		{
+			MethodName: "ListScheduledLocks",
+			Handler:    _InfobaseManagementService_ListScheduledLocks_Handler,
+		},
+		{
+			MethodName: "CancelScheduledLock",
+			Handler:    _InfobaseManagementService_CancelScheduledLock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BeginMaintenance",
+			Handler:       _InfobaseManagementService_BeginMaintenance_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "infobase/service/management.proto",
+}
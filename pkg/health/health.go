@@ -49,6 +49,11 @@ func (s *Server) SetHandler(pattern string, handler http.HandlerFunc) {
 	}
 }
 
+// Handler возвращает HTTP handler сервера со всеми зарегистрированными endpoints
+func (s *Server) Handler() http.Handler {
+	return s.server.Handler
+}
+
 // Start запускает health check сервер
 func (s *Server) Start() error {
 	logger.Log.Info("Starting health check server", zap.String("address", s.server.Addr))
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -14,14 +15,26 @@ import (
 
 // Destructive operations that require warning-level logging
 var destructiveOperations = map[string]bool{
-	"/infobase.service.InfobaseManagementService/DropInfobase": true,
+	"/infobase.service.InfobaseManagementService/DropInfobase":     true,
+	"/access.service.CredentialService/DeleteCredentials":          true,
+	"/cluster.service.WorkingServersService/UnregWorkingServer":    true,
+	"/cluster.service.AssignmentRulesService/UnregAssignmentRule":  true,
+	"/cluster.service.SecurityProfilesService/DropSecurityProfile": true,
+	"/cluster.service.ResourcesService/DropResourceCounter":        true,
+	"/cluster.service.ResourcesService/DropResourceLimit":          true,
+	"/cluster.service.AdminsService/RegAgentAdmin":                 true,
+	"/cluster.service.AdminsService/RegClusterAdmin":               true,
+	"/cluster.service.AdminsService/UnregAgentAdmin":               true,
+	"/cluster.service.AdminsService/UnregClusterAdmin":             true,
+	"/cluster.service.SessionsService/TerminateSessions":           true,
+	"/infobase.service.InfobaseManagementService/BeginMaintenance": true,
 }
 
 // AuditInterceptor logs all gRPC operations with structured metadata in JSON format.
 // It captures operation details, user information, execution time, and result status.
 //
 // Log levels:
-// - WARN: Destructive operations (DropInfobase)
+// - WARN: Destructive operations (DropInfobase) and calls denied by the policy
 // - ERROR: Failed operations
 // - INFO: Successful operations
 func AuditInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
@@ -38,6 +51,9 @@ func AuditInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 		if protoMsg, ok := req.(proto.Message); ok {
 			metadata = extractAuditMetadata(protoMsg)
 		}
+		if id, ok := auth.FromContext(ctx); ok {
+			metadata.Principal = id.Subject
+		}
 
 		// Call the actual handler
 		resp, err := handler(ctx, req)
@@ -69,24 +85,42 @@ func AuditStreamInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
 		duration := time.Since(start)
 
 		// Log audit entry for stream
-		logger.Info("gRPC stream completed",
+		fields := []zap.Field{
 			zap.String("operation", info.FullMethod),
 			zap.Bool("is_client_stream", info.IsClientStream),
 			zap.Bool("is_server_stream", info.IsServerStream),
 			zap.Int64("duration_ms", duration.Milliseconds()),
 			zap.String("result", resultStatus(err)),
 			zap.Error(err),
-		)
+		}
+		if ss != nil {
+			if id, ok := auth.FromContext(ss.Context()); ok {
+				fields = append(fields, zap.String("user", id.Subject))
+			}
+		}
+		logger.Info("gRPC stream completed", fields...)
 
 		return err
 	}
 }
 
+// AuditOperation writes the audit entry of an operation served outside the
+// gRPC interceptors, such as the HTTP routes of the gateway. operation is
+// the full gRPC method the call stands for, the caller is taken from ctx.
+func AuditOperation(ctx context.Context, logger *zap.Logger, operation, clusterID, infobaseID string, err error, duration time.Duration) {
+	metadata := auditMetadata{ClusterID: clusterID, InfobaseID: infobaseID}
+	if id, ok := auth.FromContext(ctx); ok {
+		metadata.Principal = id.Subject
+	}
+	logAuditEntry(logger, operation, metadata, err, duration)
+}
+
 // auditMetadata holds extracted metadata from gRPC requests
 type auditMetadata struct {
 	ClusterID   string
 	InfobaseID  string
 	ClusterUser string
+	Principal   string // Authenticated gateway user, see AuthInterceptor
 }
 
 // extractAuditMetadata extracts relevant metadata from proto message using reflection
@@ -134,8 +168,11 @@ func logAuditEntry(logger *zap.Logger, fullMethod string, metadata auditMetadata
 	if metadata.InfobaseID != "" {
 		fields = append(fields, zap.String("infobase_id", metadata.InfobaseID))
 	}
+	if metadata.Principal != "" {
+		fields = append(fields, zap.String("user", metadata.Principal))
+	}
 	if metadata.ClusterUser != "" {
-		fields = append(fields, zap.String("user", metadata.ClusterUser))
+		fields = append(fields, zap.String("cluster_user", metadata.ClusterUser))
 	}
 
 	// Add error if present
@@ -149,7 +186,9 @@ func logAuditEntry(logger *zap.Logger, fullMethod string, metadata auditMetadata
 	}
 
 	// Determine log level
-	if err != nil {
+	if status.Code(err) == codes.PermissionDenied {
+		logger.Warn("gRPC operation denied", fields...)
+	} else if err != nil {
 		logger.Error("gRPC operation failed", fields...)
 	} else if destructiveOperations[fullMethod] {
 		logger.Warn("gRPC destructive operation", fields...)
@@ -167,6 +206,9 @@ func resultStatus(err error) string {
 			if code == codes.OK {
 				return "success"
 			}
+			if code == codes.PermissionDenied {
+				return "denied"
+			}
 			return "error"
 		}
 		return "error"
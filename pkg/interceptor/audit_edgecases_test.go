@@ -474,7 +474,7 @@ func TestAuditInterceptor_SpecialCharactersInMetadata(t *testing.T) {
 	logEntry := logs.All()[0]
 
 	// zap stores the field value as-is (escaping happens during JSON marshaling)
-	userField := logEntry.ContextMap()["user"].(string)
+	userField := logEntry.ContextMap()["cluster_user"].(string)
 	assert.Equal(t, maliciousUser, userField, "User field should be stored as-is, escaping happens at marshaling time")
 
 	// Verify the log entry is valid (no panic, no corruption)
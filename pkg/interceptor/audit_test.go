@@ -43,6 +43,7 @@ func TestResultStatus(t *testing.T) {
 	}{
 		{"no error", nil, "success"},
 		{"gRPC error", status.Error(codes.NotFound, "not found"), "error"},
+		{"permission denied", status.Error(codes.PermissionDenied, "denied"), "denied"},
 		{"generic error", errors.New("generic error"), "error"},
 	}
 
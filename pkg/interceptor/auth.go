@@ -0,0 +1,125 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TokenValidator resolves the caller behind an access token.
+// It is implemented by the gateway's AccessServer.
+type TokenValidator interface {
+	Identify(ctx context.Context, token string) (auth.Identity, error)
+}
+
+// Methods callable without a token: login, health checks and token refresh.
+// UpdateToken accepts an expired access token, the refresh token is its credential.
+var publicMethods = map[string]bool{
+	"/access.service.TokenService/GetToken":    true,
+	"/access.service.TokenService/UpdateToken": true,
+	"/grpc.health.v1.Health/Check":             true,
+	"/grpc.health.v1.Health/Watch":             true,
+}
+
+// AuthInterceptor rejects calls without a valid bearer token in the
+// "authorization" metadata and stores the caller identity in the context,
+// see auth.FromContext.
+//
+// Place it first in the chain, so later interceptors see the identity.
+// Rejected calls never reach the audit interceptor and are logged here.
+func AuthInterceptor(logger *zap.Logger, validator TokenValidator) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, logger, validator, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthStreamInterceptor is the streaming version of AuthInterceptor.
+func AuthStreamInterceptor(logger *zap.Logger, validator TokenValidator) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), logger, validator, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream overrides the context of a stream
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, logger *zap.Logger, validator TokenValidator, fullMethod string) (context.Context, error) {
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		logger.Warn("gRPC authentication failed",
+			zap.String("operation", fullMethod),
+			zap.String("reason", "missing bearer token"),
+		)
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token in authorization metadata")
+	}
+
+	id, err := validator.Identify(ctx, token)
+	if err != nil {
+		logger.Warn("gRPC authentication failed",
+			zap.String("operation", fullMethod),
+			zap.String("reason", err.Error()),
+		)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %v", err)
+	}
+
+	return auth.NewContext(ctx, id), nil
+}
+
+// bearerToken extracts the token of the "authorization: Bearer <token>" metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") && strings.TrimSpace(token) != "" {
+			return strings.TrimSpace(token), true
+		}
+	}
+
+	return "", false
+}
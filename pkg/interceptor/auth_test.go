@@ -0,0 +1,161 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// staticValidator accepts a single token
+type staticValidator struct {
+	token string
+	id    auth.Identity
+}
+
+func (v staticValidator) Identify(_ context.Context, token string) (auth.Identity, error) {
+	if token != v.token {
+		return auth.Identity{}, auth.ErrInvalidToken
+	}
+	return v.id, nil
+}
+
+var testValidator = staticValidator{token: "good", id: auth.Identity{Subject: "admin", Roles: []string{"admin"}}}
+
+func withAuthorization(value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", value))
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	logger, logs := createTestLogger()
+	interceptor := AuthInterceptor(logger, testValidator)
+	info := mockServerInfo("/ras.service.api.v1.ClustersService/GetClusters")
+
+	var caller auth.Identity
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, _ = auth.FromContext(ctx)
+		return "response", nil
+	}
+
+	resp, err := interceptor(withAuthorization("Bearer good"), "req", info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "response", resp)
+	assert.Equal(t, "admin", caller.Subject)
+
+	_, err = interceptor(withAuthorization("bearer good"), "req", info, handler)
+	assert.NoError(t, err, "scheme is case insensitive")
+	assert.Equal(t, 0, logs.Len())
+
+	for name, ctx := range map[string]context.Context{
+		"no metadata":  context.Background(),
+		"wrong token":  withAuthorization("Bearer bad"),
+		"basic scheme": withAuthorization("Basic good"),
+		"empty token":  withAuthorization("Bearer "),
+	} {
+		_, err := interceptor(ctx, "req", info, mockHandler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err), name)
+	}
+	assert.Equal(t, 4, logs.Len(), "rejected calls are logged")
+}
+
+func TestAuthInterceptor_PublicMethods(t *testing.T) {
+	logger, _ := createTestLogger()
+	interceptor := AuthInterceptor(logger, testValidator)
+
+	_, err := interceptor(context.Background(), "req", mockServerInfo("/access.service.TokenService/GetToken"), mockHandler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), "req", mockServerInfo("/grpc.health.v1.Health/Check"), mockHandler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), "req", mockServerInfo("/access.service.TokenService/UpdateToken"), mockHandler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(context.Background(), "req", mockServerInfo("/access.service.ClientService/GetClients"), mockHandler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// managerValidator validates tokens of the token manager
+type managerValidator struct {
+	*auth.Manager
+}
+
+func (v managerValidator) Identify(ctx context.Context, token string) (auth.Identity, error) {
+	return v.Validate(ctx, token)
+}
+
+func TestAuthInterceptor_RefreshWithExpiredToken(t *testing.T) {
+	logger, _ := createTestLogger()
+	// Expiry is kept in whole seconds, the access token expires at once
+	tokens, err := auth.NewRandomManager(auth.WithAccessTTL(time.Nanosecond))
+	require.NoError(t, err)
+	defer tokens.Close()
+
+	pair, err := tokens.Issue(context.Background(), auth.User{Name: "admin", Roles: []string{"admin"}})
+	require.NoError(t, err)
+
+	interceptor := AuthInterceptor(logger, managerValidator{tokens})
+	ctx := withAuthorization("Bearer " + pair.AccessToken)
+
+	_, err = interceptor(ctx, "req", mockServerInfo("/ras.service.api.v1.ClustersService/GetClusters"), mockHandler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "the access token has expired")
+
+	resp, err := interceptor(ctx, "req", mockServerInfo("/access.service.TokenService/UpdateToken"),
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return tokens.Refresh(ctx, pair.RefreshToken, pair.AccessToken)
+		})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.(auth.TokenPair).RefreshToken)
+}
+
+// contextStream is a server stream with a fixed context
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	logger, _ := createTestLogger()
+	interceptor := AuthStreamInterceptor(logger, testValidator)
+	info := &grpc.StreamServerInfo{FullMethod: "/ras.service.api.v1.SessionsService/WatchSessions", IsServerStream: true}
+
+	var caller auth.Identity
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		caller, _ = auth.FromContext(ss.Context())
+		return nil
+	}
+
+	err := interceptor(nil, contextStream{ctx: withAuthorization("Bearer good")}, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", caller.Subject)
+
+	err = interceptor(nil, contextStream{ctx: context.Background()}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuditInterceptor_LogsPrincipal(t *testing.T) {
+	logger, logs := createTestLogger()
+	interceptor := AuditInterceptor(logger)
+
+	ctx := auth.NewContext(context.Background(), auth.Identity{Subject: "operator"})
+	req := createAuditTestMessage("cluster-123", "", "ras-admin")
+
+	_, err := interceptor(ctx, req, mockServerInfo("/test.Service/Method"), mockHandler)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "operator", fields["user"])
+	assert.Equal(t, "ras-admin", fields["cluster_user"])
+}
@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Authorizer decides whether the roles may make a call, see auth.Policy
+type Authorizer interface {
+	Allowed(roles []string, req auth.Request) bool
+}
+
+// AuthorizeInterceptor checks the caller identity stored by AuthInterceptor
+// against the policy. The cluster_id and infobase_id of the request are
+// extracted the same way as for the audit log.
+//
+// Place it after AuditInterceptor, so denied calls are audited.
+func AuthorizeInterceptor(authorizer Authorizer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := authorize(ctx, authorizer, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// AuthorizeStreamInterceptor is the streaming version of AuthorizeInterceptor.
+// Every message received from the client is checked.
+func AuthorizeStreamInterceptor(authorizer Authorizer) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			authorizer:   authorizer,
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
+
+// authorizedStream checks the received messages against the policy
+type authorizedStream struct {
+	grpc.ServerStream
+	authorizer Authorizer
+	fullMethod string
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorize(s.Context(), s.authorizer, s.fullMethod, m)
+}
+
+func authorize(ctx context.Context, authorizer Authorizer, fullMethod string, req interface{}) error {
+
+	if publicMethods[fullMethod] {
+		return nil
+	}
+
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+
+	var metadata auditMetadata
+	if protoMsg, ok := req.(proto.Message); ok {
+		metadata = extractAuditMetadata(protoMsg)
+	}
+
+	if !authorizer.Allowed(id.Roles, auth.Request{
+		Method:     fullMethod,
+		ClusterID:  metadata.ClusterID,
+		InfobaseID: metadata.InfobaseID,
+	}) {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id.Subject, fullMethod)
+	}
+
+	return nil
+}
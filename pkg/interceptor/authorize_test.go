@@ -0,0 +1,104 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var testPolicy = &auth.Policy{Roles: map[string]auth.Role{
+	"viewer": {Rules: []auth.Rule{{Methods: []string{"*/Get*"}}}},
+	"operator": {Rules: []auth.Rule{{
+		Methods:  []string{"/test.Service/Drop"},
+		Clusters: []string{"test-*"},
+	}}},
+}}
+
+func asCaller(roles ...string) context.Context {
+	return auth.NewContext(context.Background(), auth.Identity{Subject: "user", Roles: roles})
+}
+
+func TestAuthorizeInterceptor(t *testing.T) {
+	interceptor := AuthorizeInterceptor(testPolicy)
+	req := createAuditTestMessage("test-1", "ib-1", "")
+
+	_, err := interceptor(asCaller("viewer"), req, mockServerInfo("/test.Service/GetThing"), mockHandler)
+	assert.NoError(t, err)
+
+	_, err = interceptor(asCaller("viewer"), req, mockServerInfo("/test.Service/Drop"), mockHandler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = interceptor(asCaller("operator"), req, mockServerInfo("/test.Service/Drop"), mockHandler)
+	assert.NoError(t, err)
+
+	prod := createAuditTestMessage("prod-1", "ib-1", "")
+	_, err = interceptor(asCaller("operator"), prod, mockServerInfo("/test.Service/Drop"), mockHandler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "rule scoped to test clusters")
+}
+
+func TestAuthorizeInterceptor_Unauthenticated(t *testing.T) {
+	interceptor := AuthorizeInterceptor(testPolicy)
+
+	_, err := interceptor(context.Background(), "req", mockServerInfo("/test.Service/GetThing"), mockHandler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(context.Background(), "req", mockServerInfo("/access.service.TokenService/GetToken"), mockHandler)
+	assert.NoError(t, err, "public methods need no identity")
+
+	_, err = interceptor(context.Background(), "req", mockServerInfo("/access.service.TokenService/UpdateToken"), mockHandler)
+	assert.NoError(t, err, "any caller may refresh its token")
+}
+
+func TestAuthorizeInterceptor_DeniedIsAudited(t *testing.T) {
+	logger, logs := createTestLogger()
+	audit := AuditInterceptor(logger)
+	authorize := AuthorizeInterceptor(testPolicy)
+	info := mockServerInfo("/test.Service/Drop")
+
+	_, err := audit(asCaller("viewer"), createAuditTestMessage("test-1", "", ""), info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return authorize(ctx, req, info, mockHandler)
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Equal(t, zapcore.WarnLevel, entry.Level)
+	assert.Equal(t, "denied", entry.ContextMap()["result"])
+	assert.Equal(t, "user", entry.ContextMap()["user"])
+	assert.Equal(t, "PermissionDenied", entry.ContextMap()["grpc_code"])
+}
+
+// recvStream is a server stream returning a fixed request
+type recvStream struct {
+	contextStream
+	req proto.Message
+}
+
+func (s recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestAuthorizeStreamInterceptor(t *testing.T) {
+	interceptor := AuthorizeStreamInterceptor(testPolicy)
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Drop", IsServerStream: true}
+
+	call := func(clusterID string) error {
+		req := createAuditTestMessage(clusterID, "", "")
+		ss := recvStream{contextStream{ctx: asCaller("operator")}, req}
+		return interceptor(nil, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return ss.RecvMsg(req.ProtoReflect().New().Interface())
+		})
+	}
+
+	assert.NoError(t, call("test-1"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call("prod-1")))
+}
@@ -5,6 +5,8 @@
 // This package contains production-ready interceptors for gRPC servers:
 //   - Password Sanitization: Automatically redacts password fields in logs
 //   - Audit Logging: Structured JSON logging of all gRPC operations
+//   - Authentication: Bearer access tokens required on every call
+//   - Authorization: Role policy per method, cluster and infobase
 //
 // # Password Sanitization
 //
@@ -33,11 +35,12 @@
 //
 // The audit logging interceptor records all gRPC operations in structured JSON format
 // using go.uber.org/zap. It automatically extracts metadata from requests
-// (cluster_id, infobase_id, user) and measures operation duration.
+// (cluster_id, infobase_id, cluster_user) and measures operation duration.
+// The "user" field is the gateway user authenticated by AuthInterceptor.
 //
 // Log levels:
 //   - INFO: Successful operations
-//   - WARN: Destructive operations (e.g., DropInfobase)
+//   - WARN: Destructive operations (e.g., DropInfobase), PermissionDenied calls
 //   - ERROR: Failed operations with gRPC error codes
 //
 // Example audit log entry:
@@ -49,19 +52,38 @@
 //	  "cluster_id": "uuid-123",
 //	  "infobase_id": "uuid-456",
 //	  "user": "admin",
+//	  "cluster_user": "ras-admin",
 //	  "result": "success",
 //	  "duration_ms": 1234
 //	}
 //
+// # Authentication
+//
+// AuthInterceptor and AuthStreamInterceptor require the header
+// "authorization: Bearer <access token>" issued by TokenService/GetToken.
+// The token is resolved by a TokenValidator and the caller identity is
+// stored in the context (auth.FromContext). Only TokenService/GetToken and
+// the gRPC health service are callable without a token.
+//
+// # Authorization
+//
+// AuthorizeInterceptor checks the roles of the authenticated caller against
+// an Authorizer such as auth.Policy, using the cluster_id and infobase_id
+// fields of the request. Violations fail with PermissionDenied; placed after
+// AuditInterceptor they are logged with result "denied".
+//
 // # Interceptor Chain Order
 //
 // IMPORTANT: The order of interceptors matters for security!
 //
-// Always place SanitizePasswordsInterceptor BEFORE AuditInterceptor:
+// Always place SanitizePasswordsInterceptor BEFORE AuditInterceptor,
+// and AuthInterceptor before both so the audit log names the caller:
 //
 //	grpc.ChainUnaryInterceptor(
-//	    interceptor.SanitizePasswordsInterceptor(logger),  // 1. Sanitize first
-//	    interceptor.AuditInterceptor(logger),              // 2. Audit second
+//	    interceptor.AuthInterceptor(logger, accessServer), // 1. Authenticate
+//	    interceptor.SanitizePasswordsInterceptor(logger),  // 2. Sanitize
+//	    interceptor.AuditInterceptor(logger),              // 3. Audit
+//	    interceptor.AuthorizeInterceptor(policy),          // 4. Authorize
 //	)
 //
 // This ensures that the audit log sees sanitized passwords, while the handler
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/domain"
+	bolt "go.etcd.io/bbolt"
+)
+
+// TokenDBFile is the name of the token database inside the data dir
+const TokenDBFile = "tokens.db"
+
+var tokensBucket = []byte("tokens")
+
+// BoltTokenRepository keeps tokens in a bbolt database file,
+// so they survive restarts of the gateway
+type BoltTokenRepository struct {
+	db *bolt.DB
+}
+
+var _ domain.TokenRepository = (*BoltTokenRepository)(nil)
+
+// OpenBoltTokenRepository opens or creates the token database in dataDir.
+// The database is locked by the process until Close.
+func OpenBoltTokenRepository(dataDir string) (*BoltTokenRepository, error) {
+
+	if err := os.MkdirAll(dataDir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create data dir: %w", err)
+	}
+
+	path := filepath.Join(dataDir, TokenDBFile)
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open token database %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(tokensBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to init token database: %w", err)
+	}
+
+	return &BoltTokenRepository{db: db}, nil
+}
+
+// Close releases the database file
+func (r *BoltTokenRepository) Close() error {
+	return r.db.Close()
+}
+
+func (r *BoltTokenRepository) Fetch(ctx context.Context, cursor string, num int64) ([]domain.Token, string, error) {
+
+	var (
+		res  []domain.Token
+		next string
+	)
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(tokensBucket).Cursor()
+
+		k, v := c.Seek([]byte(cursor))
+		if k != nil && bytes.Equal(k, []byte(cursor)) {
+			k, v = c.Next()
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			if num > 0 && int64(len(res)) == num {
+				next = res[len(res)-1].ID
+				break
+			}
+			t, err := decodeToken(v)
+			if err != nil {
+				return err
+			}
+			res = append(res, t)
+		}
+
+		return nil
+	})
+
+	return res, next, err
+}
+
+func (r *BoltTokenRepository) GetByID(ctx context.Context, id string) (domain.Token, error) {
+
+	var t domain.Token
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(tokensBucket).Get([]byte(id))
+		if v == nil {
+			return domain.ErrTokenNotFound
+		}
+		var err error
+		t, err = decodeToken(v)
+		return err
+	})
+
+	return t, err
+}
+
+func (r *BoltTokenRepository) Update(ctx context.Context, t *domain.Token) error {
+	return r.put(t, true)
+}
+
+func (r *BoltTokenRepository) Store(ctx context.Context, t *domain.Token) error {
+	return r.put(t, false)
+}
+
+func (r *BoltTokenRepository) Delete(ctx context.Context, id string) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tokensBucket)
+		if b.Get([]byte(id)) == nil {
+			return domain.ErrTokenNotFound
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+// put writes the token, exists tells whether it must already be stored
+func (r *BoltTokenRepository) put(t *domain.Token, exists bool) error {
+
+	if t.ID == "" {
+		return fmt.Errorf("token ID is required")
+	}
+
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tokensBucket)
+		stored := b.Get([]byte(t.ID)) != nil
+		if exists && !stored {
+			return domain.ErrTokenNotFound
+		}
+		if !exists && stored {
+			return domain.ErrTokenExists
+		}
+		return b.Put([]byte(t.ID), data)
+	})
+}
+
+func decodeToken(data []byte) (domain.Token, error) {
+	var t domain.Token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return domain.Token{}, fmt.Errorf("failed to decode token: %w", err)
+	}
+	return t, nil
+}
@@ -0,0 +1,110 @@
+// Package repository implements the domain repositories
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/domain"
+)
+
+// MemoryTokenRepository keeps tokens in memory, they are lost on restart
+type MemoryTokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[string]domain.Token
+}
+
+var _ domain.TokenRepository = (*MemoryTokenRepository)(nil)
+
+// NewMemoryTokenRepository creates an empty in-memory repository
+func NewMemoryTokenRepository() *MemoryTokenRepository {
+	return &MemoryTokenRepository{tokens: make(map[string]domain.Token)}
+}
+
+func (r *MemoryTokenRepository) Fetch(ctx context.Context, cursor string, num int64) ([]domain.Token, string, error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.tokens))
+	for id := range r.tokens {
+		if id > cursor {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var next string
+	if num > 0 && int64(len(ids)) > num {
+		ids = ids[:num]
+		next = ids[len(ids)-1]
+	}
+
+	res := make([]domain.Token, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, cloneToken(r.tokens[id]))
+	}
+
+	return res, next, nil
+}
+
+func (r *MemoryTokenRepository) GetByID(ctx context.Context, id string) (domain.Token, error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.tokens[id]
+	if !ok {
+		return domain.Token{}, domain.ErrTokenNotFound
+	}
+
+	return cloneToken(t), nil
+}
+
+func (r *MemoryTokenRepository) Update(ctx context.Context, t *domain.Token) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[t.ID]; !ok {
+		return domain.ErrTokenNotFound
+	}
+	r.tokens[t.ID] = cloneToken(*t)
+
+	return nil
+}
+
+func (r *MemoryTokenRepository) Store(ctx context.Context, t *domain.Token) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[t.ID]; ok {
+		return domain.ErrTokenExists
+	}
+	r.tokens[t.ID] = cloneToken(*t)
+
+	return nil
+}
+
+func (r *MemoryTokenRepository) Delete(ctx context.Context, id string) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[id]; !ok {
+		return domain.ErrTokenNotFound
+	}
+	delete(r.tokens, id)
+
+	return nil
+}
+
+// cloneToken copies the scopes, so callers cannot change stored tokens
+func cloneToken(t domain.Token) domain.Token {
+	if t.Scopes != nil {
+		t.Scopes = append([]string(nil), t.Scopes...)
+	}
+	return t
+}
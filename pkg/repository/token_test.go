@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/v8platform/ras-grpc-gw/pkg/domain"
+)
+
+func openRepositories(t *testing.T) map[string]domain.TokenRepository {
+	t.Helper()
+
+	bolt, err := OpenBoltTokenRepository(t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = bolt.Close() })
+
+	return map[string]domain.TokenRepository{
+		"memory": NewMemoryTokenRepository(),
+		"bolt":   bolt,
+	}
+}
+
+func testToken(id string) *domain.Token {
+	now := time.Now().UTC().Truncate(time.Second)
+	return &domain.Token{
+		ID:        id,
+		Kind:      domain.RefreshTokenKind,
+		Subject:   "admin",
+		Scopes:    []string{"admin"},
+		IssuedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+		Family:    "family-1",
+	}
+}
+
+func TestTokenRepository_CRUD(t *testing.T) {
+	for name, repo := range openRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			token := testToken("a")
+			require.NoError(t, repo.Store(ctx, token))
+			assert.ErrorIs(t, repo.Store(ctx, token), domain.ErrTokenExists)
+
+			got, err := repo.GetByID(ctx, "a")
+			require.NoError(t, err)
+			assert.Equal(t, *token, got)
+
+			got.Revoked = true
+			got.Scopes[0] = "changed"
+			require.NoError(t, repo.Update(ctx, &got))
+
+			got, err = repo.GetByID(ctx, "a")
+			require.NoError(t, err)
+			assert.True(t, got.Revoked)
+			assert.Equal(t, []string{"changed"}, got.Scopes)
+
+			assert.ErrorIs(t, repo.Update(ctx, testToken("missing")), domain.ErrTokenNotFound)
+
+			require.NoError(t, repo.Delete(ctx, "a"))
+			_, err = repo.GetByID(ctx, "a")
+			assert.ErrorIs(t, err, domain.ErrTokenNotFound)
+			assert.ErrorIs(t, repo.Delete(ctx, "a"), domain.ErrTokenNotFound)
+		})
+	}
+}
+
+func TestTokenRepository_Fetch(t *testing.T) {
+	for name, repo := range openRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, id := range []string{"c", "a", "e", "b", "d"} {
+				require.NoError(t, repo.Store(ctx, testToken(id)))
+			}
+
+			var ids []string
+			cursor, pages := "", 0
+			for {
+				tokens, next, err := repo.Fetch(ctx, cursor, 2)
+				require.NoError(t, err)
+				for _, token := range tokens {
+					ids = append(ids, token.ID)
+				}
+				pages++
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+			assert.Equal(t, []string{"a", "b", "c", "d", "e"}, ids)
+			assert.Equal(t, 3, pages)
+
+			all, next, err := repo.Fetch(ctx, "", 0)
+			require.NoError(t, err)
+			assert.Len(t, all, 5)
+			assert.Empty(t, next)
+		})
+	}
+}
+
+func TestBoltTokenRepository_Persists(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	repo, err := OpenBoltTokenRepository(dir)
+	require.NoError(t, err)
+	require.NoError(t, repo.Store(ctx, testToken("a")))
+	require.NoError(t, repo.Close())
+
+	repo, err = OpenBoltTokenRepository(dir)
+	require.NoError(t, err)
+	defer repo.Close()
+
+	got, err := repo.GetByID(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, *testToken("a"), got)
+}
@@ -0,0 +1,35 @@
+package schedule
+
+import (
+	"os"
+
+	"go.uber.org/zap"
+)
+
+// Config holds the scheduled lock settings
+type Config struct {
+	File string // Scheduled locks file, scheduled locks are disabled if empty
+}
+
+// LoadConfig loads the scheduled lock settings from environment variables.
+//
+// Environment variables:
+//
+//	SCHEDULED_LOCKS_FILE=/path  - File of the lock windows and maintenance tracked by the gateway
+func LoadConfig(logger *zap.Logger) *Config {
+
+	cfg := &Config{File: os.Getenv("SCHEDULED_LOCKS_FILE")}
+	if cfg.File == "" {
+		logger.Info("SCHEDULED_LOCKS_FILE not set, lock windows are left to RAS")
+	}
+
+	return cfg
+}
+
+// Open opens the scheduled locks of the config, nil if they are disabled
+func (c *Config) Open(applier Applier, logger *zap.Logger) (*Manager, error) {
+	if c.File == "" {
+		return nil, nil
+	}
+	return Open(c.File, applier, logger)
+}
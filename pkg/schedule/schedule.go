@@ -0,0 +1,669 @@
+// Package schedule keeps the scheduled infobase locks of the gateway.
+//
+// RAS stores the time window of the session lock but has no end time for
+// the scheduled jobs lock, so a lock window planned through the gateway is
+// tracked here: the manager applies the locks when the window opens and
+// restores the lock state it replaced when the window closes.
+//
+// Windows are kept in a JSON file and survive restarts of the gateway.
+// A window opened while the gateway was down is applied on start, a window
+// that closed meanwhile is released.
+//
+// The file also keeps the maintenance of infobases started through the
+// gateway, so the lock state it replaced is restored after a restart too.
+// Windows of an infobase in maintenance are neither applied nor released
+// until the maintenance ends, the maintenance restores the state before
+// an active window and sets the locks of a window still open.
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+	"go.uber.org/zap"
+)
+
+const (
+	fileVersion = 1
+
+	// DefaultRetryInterval is the delay before a failed apply or restore
+	// of a lock is tried again
+	DefaultRetryInterval = time.Minute
+)
+
+var (
+	ErrNotFound = errors.New("scheduled lock not found")
+	// ErrInvalidLock is returned for a lock window that cannot be scheduled
+	ErrInvalidLock = errors.New("invalid scheduled lock")
+	// ErrOverlap is returned when the window intersects another window
+	// of the same infobase, the lock state to restore would be ambiguous
+	ErrOverlap = errors.New("scheduled lock overlaps another lock of the infobase")
+	// ErrMaintenance is returned when the infobase already is in maintenance
+	ErrMaintenance = errors.New("infobase maintenance is already in progress")
+	// ErrNoMaintenance is returned when the infobase is not in maintenance
+	ErrNoMaintenance = errors.New("no maintenance of the infobase in progress")
+)
+
+// Status of a scheduled lock
+type Status string
+
+const (
+	StatusPending Status = "pending" // The window has not opened yet
+	StatusActive  Status = "active"  // The locks are applied
+)
+
+// LockState is the lock settings of an infobase
+type LockState struct {
+	SessionsDeny      bool      `json:"sessions_deny"`
+	DeniedFrom        time.Time `json:"denied_from,omitzero"`
+	DeniedTo          time.Time `json:"denied_to,omitzero"`
+	DeniedMessage     string    `json:"denied_message,omitempty"`
+	PermissionCode    string    `json:"permission_code,omitempty"`
+	ScheduledJobsDeny bool      `json:"scheduled_jobs_deny"`
+}
+
+// Lock is a lock window of an infobase
+type Lock struct {
+	ID         string `json:"id"`
+	ClusterID  string `json:"cluster_id"`
+	InfobaseID string `json:"infobase_id"`
+	RASHost    string `json:"ras_host,omitempty"` // RAS service of the cluster, empty for the default one
+
+	From              time.Time `json:"from"`
+	To                time.Time `json:"to"`
+	SessionsDeny      bool      `json:"sessions_deny"`
+	DeniedMessage     string    `json:"denied_message,omitempty"`
+	PermissionCode    string    `json:"permission_code,omitempty"`
+	ScheduledJobsDeny bool      `json:"scheduled_jobs_deny"`
+
+	Status    Status     `json:"status"`
+	Previous  *LockState `json:"previous,omitempty"` // State replaced by the active lock
+	LastError string     `json:"last_error,omitempty"`
+	RetryAt   time.Time  `json:"retry_at,omitzero"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Maintenance is the maintenance of an infobase started through the gateway
+type Maintenance struct {
+	ClusterID  string    `json:"cluster_id"`
+	InfobaseID string    `json:"infobase_id"`
+	Previous   LockState `json:"previous"` // State restored when the maintenance ends
+	StartedAt  time.Time `json:"started_at"`
+}
+
+func maintenanceKey(clusterID, infobaseID string) string {
+	return clusterID + "/" + infobaseID
+}
+
+// Applied returns previous with the locks of the window set,
+// the settings the window does not lock are left as they were
+func (l Lock) Applied(previous LockState) LockState {
+	state := previous
+	if l.SessionsDeny {
+		state.SessionsDeny = true
+		state.DeniedFrom = l.From
+		state.DeniedTo = l.To
+		state.DeniedMessage = l.DeniedMessage
+		state.PermissionCode = l.PermissionCode
+	}
+	if l.ScheduledJobsDeny {
+		state.ScheduledJobsDeny = true
+	}
+	return state
+}
+
+// due is the time of the next change of the lock
+func (l Lock) due() time.Time {
+	at := l.From
+	if l.Status == StatusActive {
+		at = l.To
+	}
+	if l.RetryAt.After(at) {
+		return l.RetryAt
+	}
+	return at
+}
+
+// Applier changes the locks of an infobase in RAS
+type Applier interface {
+	// Apply sets the locks of the window and returns the state they replaced
+	Apply(ctx context.Context, l Lock) (LockState, error)
+	// Restore sets the lock state replaced by Apply back
+	Restore(ctx context.Context, l Lock, state LockState) error
+}
+
+// file is the on-disk format of the scheduled locks
+type file struct {
+	Version     int           `json:"version"`
+	Locks       []Lock        `json:"locks"`
+	Maintenance []Maintenance `json:"maintenance,omitempty"`
+}
+
+// Manager applies and releases scheduled locks on time. It is safe for
+// concurrent use. A nil *Manager means scheduled locks are disabled.
+type Manager struct {
+	path    string
+	applier Applier
+	logger  *zap.Logger
+	now     func() time.Time
+	retry   time.Duration
+	wake    chan struct{}
+
+	op sync.Mutex // Serializes the changes made in RAS
+
+	mu          sync.Mutex
+	locks       map[string]Lock
+	maintenance map[string]Maintenance
+}
+
+// Open loads the scheduled locks file at path, a missing file is created
+// on the first change. Locks are applied once Run is started.
+func Open(path string, applier Applier, logger *zap.Logger) (*Manager, error) {
+
+	m := &Manager{
+		path:        path,
+		applier:     applier,
+		logger:      logger,
+		now:         time.Now,
+		retry:       DefaultRetryInterval,
+		wake:        make(chan struct{}, 1),
+		locks:       map[string]Lock{},
+		maintenance: map[string]Maintenance{},
+	}
+
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Schedule adds the lock window l. A window that is already open is
+// applied before Schedule returns, so RAS errors reach the caller,
+// unless the infobase is in maintenance.
+func (m *Manager) Schedule(ctx context.Context, l Lock) (Lock, error) {
+
+	now := m.now()
+	switch {
+	case l.ClusterID == "" || l.InfobaseID == "":
+		return Lock{}, fmt.Errorf("%w: cluster and infobase are required", ErrInvalidLock)
+	case !l.To.After(l.From):
+		return Lock{}, fmt.Errorf("%w: window must end after it starts", ErrInvalidLock)
+	case !l.To.After(now):
+		return Lock{}, fmt.Errorf("%w: window has already ended", ErrInvalidLock)
+	case !l.SessionsDeny && !l.ScheduledJobsDeny:
+		return Lock{}, fmt.Errorf("%w: nothing to lock", ErrInvalidLock)
+	}
+
+	m.op.Lock()
+	defer m.op.Unlock()
+
+	for _, other := range m.List(l.ClusterID, l.InfobaseID) {
+		if l.From.Before(other.To) && other.From.Before(l.To) {
+			return Lock{}, fmt.Errorf("%w: %s", ErrOverlap, other.ID)
+		}
+	}
+
+	l.ID = shortuuid.New()
+	l.Status = StatusPending
+	l.Previous = nil
+	l.LastError = ""
+	l.RetryAt = time.Time{}
+	l.CreatedAt = now.UTC()
+
+	if _, maintenance := m.Maintenance(l.ClusterID, l.InfobaseID); !l.From.After(now) && !maintenance {
+		previous, err := m.applier.Apply(ctx, l)
+		if err != nil {
+			return Lock{}, err
+		}
+		l.Status = StatusActive
+		l.Previous = &previous
+	}
+
+	if err := m.put(l); err != nil {
+		if l.Status == StatusActive {
+			// Untracked locks would never be released
+			if restoreErr := m.applier.Restore(ctx, l, *l.Previous); restoreErr != nil {
+				m.logger.Error("Failed to restore lock of unsaved window",
+					zap.String("infobase_id", l.InfobaseID),
+					zap.Error(restoreErr),
+				)
+			}
+		}
+		return Lock{}, err
+	}
+	m.notify()
+
+	return l, nil
+}
+
+// Cancel removes the lock, the lock state replaced by an active
+// lock is restored first unless the maintenance of the infobase will
+func (m *Manager) Cancel(ctx context.Context, id string) (Lock, error) {
+
+	m.op.Lock()
+	defer m.op.Unlock()
+
+	l, ok := m.Get(id)
+	if !ok {
+		return Lock{}, ErrNotFound
+	}
+	if _, maintenance := m.Maintenance(l.ClusterID, l.InfobaseID); l.Status == StatusActive && !maintenance {
+		if err := m.applier.Restore(ctx, l, *l.Previous); err != nil {
+			return l, err
+		}
+	}
+	if err := m.remove(id); err != nil {
+		return l, err
+	}
+	m.notify()
+
+	return l, nil
+}
+
+// Get returns the lock with the ID
+func (m *Manager) Get(id string) (Lock, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	l, ok := m.locks[id]
+	return l, ok
+}
+
+// List returns the locks of the infobase, of the cluster if infobaseID is
+// empty, or all locks if clusterID is empty too, ordered by window start
+func (m *Manager) List(clusterID, infobaseID string) []Lock {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var res []Lock
+	for _, l := range m.locks {
+		if clusterID != "" && l.ClusterID != clusterID {
+			continue
+		}
+		if infobaseID != "" && l.InfobaseID != infobaseID {
+			continue
+		}
+		res = append(res, l)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if !res[i].From.Equal(res[j].From) {
+			return res[i].From.Before(res[j].From)
+		}
+		return res[i].ID < res[j].ID
+	})
+
+	return res
+}
+
+// BeginMaintenance records the maintenance of the infobase. current reads
+// the lock state of the infobase, EndMaintenance restores it. If a window
+// of the infobase is active, the state before the window is restored.
+func (m *Manager) BeginMaintenance(
+	ctx context.Context,
+	clusterID, infobaseID string,
+	current func(context.Context) (LockState, error),
+) (Maintenance, error) {
+
+	m.op.Lock()
+	defer m.op.Unlock()
+
+	if _, ok := m.Maintenance(clusterID, infobaseID); ok {
+		return Maintenance{}, ErrMaintenance
+	}
+
+	var previous LockState
+	if l, ok := m.activeLock(clusterID, infobaseID); ok {
+		previous = *l.Previous
+	} else {
+		state, err := current(ctx)
+		if err != nil {
+			return Maintenance{}, err
+		}
+		previous = state
+	}
+
+	mt := Maintenance{
+		ClusterID:  clusterID,
+		InfobaseID: infobaseID,
+		Previous:   previous,
+		StartedAt:  m.now().UTC(),
+	}
+	if err := m.putMaintenance(mt); err != nil {
+		return Maintenance{}, err
+	}
+
+	return mt, nil
+}
+
+// Maintenance returns the maintenance of the infobase
+func (m *Manager) Maintenance(clusterID, infobaseID string) (Maintenance, bool) {
+	if m == nil {
+		return Maintenance{}, false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mt, ok := m.maintenance[maintenanceKey(clusterID, infobaseID)]
+	return mt, ok
+}
+
+// EndMaintenance sets the lock state saved by BeginMaintenance back with
+// restore and removes the maintenance, it returns the state restored.
+// The locks of an active window still open are kept, an active window
+// that closed during the maintenance is released by Run afterwards to the
+// same state, BeginMaintenance took it from the window. A nil restore
+// only removes the maintenance, for one that failed before changing the locks.
+func (m *Manager) EndMaintenance(
+	ctx context.Context,
+	clusterID, infobaseID string,
+	restore func(context.Context, LockState) error,
+) (LockState, error) {
+
+	m.op.Lock()
+	defer m.op.Unlock()
+
+	mt, ok := m.Maintenance(clusterID, infobaseID)
+	if !ok {
+		return LockState{}, ErrNoMaintenance
+	}
+	defer m.notify() // Windows deferred by the maintenance are due
+
+	if restore == nil {
+		return mt.Previous, m.removeMaintenance(clusterID, infobaseID)
+	}
+
+	state := mt.Previous
+	active, ok := m.activeLock(clusterID, infobaseID)
+	if ok && active.To.After(m.now()) {
+		state = active.Applied(state)
+	}
+	if err := restore(ctx, state); err != nil {
+		return LockState{}, err
+	}
+	if err := m.removeMaintenance(clusterID, infobaseID); err != nil {
+		return LockState{}, err
+	}
+
+	return state, nil
+}
+
+// activeLock returns the active window of the infobase, windows of an
+// infobase never overlap so there is one at most
+func (m *Manager) activeLock(clusterID, infobaseID string) (Lock, bool) {
+	for _, l := range m.List(clusterID, infobaseID) {
+		if l.Status == StatusActive {
+			return l, true
+		}
+	}
+	return Lock{}, false
+}
+
+// Run applies and releases the locks on time until ctx is done
+func (m *Manager) Run(ctx context.Context) {
+
+	for {
+		next := m.process(ctx)
+
+		var timer *time.Timer
+		var fire <-chan time.Time
+		if !next.IsZero() {
+			timer = time.NewTimer(next.Sub(m.now()))
+			fire = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-m.wake:
+		case <-fire:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// process applies the opened windows and releases the closed ones,
+// it returns the time of the next change or zero if there is none
+func (m *Manager) process(ctx context.Context) time.Time {
+
+	m.op.Lock()
+	defer m.op.Unlock()
+
+	now := m.now()
+	var next time.Time
+	for _, l := range m.List("", "") {
+		if ctx.Err() != nil {
+			return time.Time{}
+		}
+		if _, maintenance := m.Maintenance(l.ClusterID, l.InfobaseID); maintenance {
+			continue // EndMaintenance wakes Run up
+		}
+		if l.due().After(now) {
+			if next.IsZero() || l.due().Before(next) {
+				next = l.due()
+			}
+			continue
+		}
+
+		log := m.logger.With(
+			zap.String("lock_id", l.ID),
+			zap.String("cluster_id", l.ClusterID),
+			zap.String("infobase_id", l.InfobaseID),
+		)
+
+		var err error
+		switch {
+		case l.Status == StatusPending && !l.To.After(now):
+			log.Warn("Scheduled lock window passed while the gateway was down, skipped",
+				zap.Time("from", l.From), zap.Time("to", l.To))
+			err = m.remove(l.ID)
+
+		case l.Status == StatusPending:
+			previous, applyErr := m.applier.Apply(ctx, l)
+			if applyErr != nil {
+				log.Error("Failed to apply scheduled lock", zap.Error(applyErr))
+				err = m.failed(l, applyErr, now)
+				break
+			}
+			log.Info("Scheduled lock applied", zap.Time("to", l.To))
+			l.Status = StatusActive
+			l.Previous = &previous
+			l.LastError = ""
+			l.RetryAt = time.Time{}
+			err = m.put(l)
+
+		default:
+			if restoreErr := m.applier.Restore(ctx, l, *l.Previous); restoreErr != nil {
+				log.Error("Failed to release scheduled lock", zap.Error(restoreErr))
+				err = m.failed(l, restoreErr, now)
+				break
+			}
+			log.Info("Scheduled lock released")
+			err = m.remove(l.ID)
+		}
+		if err != nil {
+			log.Error("Failed to save scheduled locks", zap.Error(err))
+		}
+
+		// The lock may be due again after a failure
+		if l, ok := m.Get(l.ID); ok && (next.IsZero() || l.due().Before(next)) {
+			next = l.due()
+		}
+	}
+
+	return next
+}
+
+// failed records the error of the lock and postpones it by the retry interval
+func (m *Manager) failed(l Lock, err error, now time.Time) error {
+	l.LastError = err.Error()
+	l.RetryAt = now.Add(m.retry)
+	return m.put(l)
+}
+
+// notify wakes Run up to reconsider the next change
+func (m *Manager) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) put(l Lock) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.update(func(locks map[string]Lock, _ map[string]Maintenance) {
+		locks[l.ID] = l
+	})
+}
+
+func (m *Manager) remove(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.update(func(locks map[string]Lock, _ map[string]Maintenance) {
+		delete(locks, id)
+	})
+}
+
+func (m *Manager) putMaintenance(mt Maintenance) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.update(func(_ map[string]Lock, maintenance map[string]Maintenance) {
+		maintenance[maintenanceKey(mt.ClusterID, mt.InfobaseID)] = mt
+	})
+}
+
+func (m *Manager) removeMaintenance(clusterID, infobaseID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.update(func(_ map[string]Lock, maintenance map[string]Maintenance) {
+		delete(maintenance, maintenanceKey(clusterID, infobaseID))
+	})
+}
+
+// update applies fn to a copy of the locks and maintenances and saves the
+// result, the manager is left unchanged if saving fails. Callers must hold m.mu.
+func (m *Manager) update(fn func(map[string]Lock, map[string]Maintenance)) error {
+
+	locks := make(map[string]Lock, len(m.locks))
+	for id, l := range m.locks {
+		locks[id] = l
+	}
+	maintenance := make(map[string]Maintenance, len(m.maintenance))
+	for key, mt := range m.maintenance {
+		maintenance[key] = mt
+	}
+
+	fn(locks, maintenance)
+	if err := m.save(locks, maintenance); err != nil {
+		return err
+	}
+
+	m.locks = locks
+	m.maintenance = maintenance
+	return nil
+}
+
+func (m *Manager) load() error {
+
+	data, err := os.ReadFile(m.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read scheduled locks: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return fmt.Errorf("invalid scheduled locks file %s: %w", m.path, err)
+	}
+	if f.Version != fileVersion {
+		return fmt.Errorf("unsupported scheduled locks file version %d", f.Version)
+	}
+	for _, l := range f.Locks {
+		if l.Status == StatusActive && l.Previous == nil {
+			return fmt.Errorf("invalid scheduled locks file %s: active lock %s has no previous state", m.path, l.ID)
+		}
+		m.locks[l.ID] = l
+	}
+	for _, mt := range f.Maintenance {
+		m.maintenance[maintenanceKey(mt.ClusterID, mt.InfobaseID)] = mt
+	}
+
+	return nil
+}
+
+// save writes the locks into a temporary file and renames it over the
+// locks file, so a crash never leaves a partially written file
+func (m *Manager) save(locks map[string]Lock, maintenance map[string]Maintenance) error {
+
+	f := file{Version: fileVersion, Locks: make([]Lock, 0, len(locks))}
+	for _, l := range locks {
+		f.Locks = append(f.Locks, l)
+	}
+	sort.Slice(f.Locks, func(i, j int) bool { return f.Locks[i].ID < f.Locks[j].ID })
+	for _, mt := range maintenance {
+		f.Maintenance = append(f.Maintenance, mt)
+	}
+	sort.Slice(f.Maintenance, func(i, j int) bool {
+		return maintenanceKey(f.Maintenance[i].ClusterID, f.Maintenance[i].InfobaseID) <
+			maintenanceKey(f.Maintenance[j].ClusterID, f.Maintenance[j].InfobaseID)
+	})
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(m.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create scheduled locks dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(m.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write scheduled locks: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write scheduled locks: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write scheduled locks: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write scheduled locks: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), m.path); err != nil {
+		return fmt.Errorf("failed to write scheduled locks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// fakeApplier keeps the lock state of one infobase
+type fakeApplier struct {
+	state LockState
+	err   error
+	calls []string
+}
+
+func (a *fakeApplier) Apply(ctx context.Context, l Lock) (LockState, error) {
+	a.calls = append(a.calls, "apply "+l.ID)
+	if a.err != nil {
+		return LockState{}, a.err
+	}
+	previous := a.state
+	if l.SessionsDeny {
+		a.state.SessionsDeny = true
+		a.state.DeniedMessage = l.DeniedMessage
+	}
+	if l.ScheduledJobsDeny {
+		a.state.ScheduledJobsDeny = true
+	}
+	return previous, nil
+}
+
+func (a *fakeApplier) Restore(ctx context.Context, l Lock, state LockState) error {
+	a.calls = append(a.calls, "restore "+l.ID)
+	if a.err != nil {
+		return a.err
+	}
+	a.state = state
+	return nil
+}
+
+func openTestManager(t *testing.T, path string, applier Applier, now *time.Time) *Manager {
+	t.Helper()
+	m, err := Open(path, applier, zap.NewNop())
+	require.NoError(t, err)
+	m.now = func() time.Time { return *now }
+	return m
+}
+
+func TestManager_WindowSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks.json")
+	now := time.Date(2026, 3, 1, 20, 0, 0, 0, time.UTC)
+	applier := &fakeApplier{state: LockState{DeniedMessage: "old"}}
+	ctx := context.Background()
+
+	m := openTestManager(t, path, applier, &now)
+	l, err := m.Schedule(ctx, Lock{
+		ClusterID:         "cluster-1",
+		InfobaseID:        "ib-1",
+		From:              now.Add(2 * time.Hour),
+		To:                now.Add(4 * time.Hour),
+		ScheduledJobsDeny: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StatusPending, l.Status)
+	assert.Empty(t, applier.calls, "the window has not opened yet")
+	assert.Equal(t, l.From, m.process(ctx))
+
+	// Restart during the window
+	now = now.Add(3 * time.Hour)
+	m = openTestManager(t, path, applier, &now)
+	assert.Equal(t, l.To, m.process(ctx))
+	assert.True(t, applier.state.ScheduledJobsDeny)
+
+	got, ok := m.Get(l.ID)
+	require.True(t, ok)
+	assert.Equal(t, StatusActive, got.Status)
+	assert.Equal(t, &LockState{DeniedMessage: "old"}, got.Previous)
+
+	// Restart after the window
+	now = now.Add(2 * time.Hour)
+	m = openTestManager(t, path, applier, &now)
+	assert.True(t, m.process(ctx).IsZero())
+	assert.Equal(t, LockState{DeniedMessage: "old"}, applier.state, "the previous state is restored")
+	assert.Empty(t, m.List("", ""))
+	assert.Equal(t, []string{"apply " + l.ID, "restore " + l.ID}, applier.calls)
+}
+
+func TestManager_ScheduleOpenWindow(t *testing.T) {
+	now := time.Now()
+	applier := &fakeApplier{}
+	m := openTestManager(t, filepath.Join(t.TempDir(), "locks.json"), applier, &now)
+
+	l, err := m.Schedule(context.Background(), Lock{
+		ClusterID:     "cluster-1",
+		InfobaseID:    "ib-1",
+		From:          now.Add(-time.Minute),
+		To:            now.Add(time.Hour),
+		SessionsDeny:  true,
+		DeniedMessage: "Обновление",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StatusActive, l.Status, "an open window is applied at once")
+	assert.True(t, applier.state.SessionsDeny)
+
+	_, err = m.Schedule(context.Background(), Lock{
+		ClusterID:    "cluster-1",
+		InfobaseID:   "ib-1",
+		From:         now.Add(30 * time.Minute),
+		To:           now.Add(2 * time.Hour),
+		SessionsDeny: true,
+	})
+	assert.ErrorIs(t, err, ErrOverlap)
+
+	_, err = m.Cancel(context.Background(), l.ID)
+	require.NoError(t, err)
+	assert.False(t, applier.state.SessionsDeny)
+
+	_, err = m.Cancel(context.Background(), l.ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestManager_Validation(t *testing.T) {
+	now := time.Now()
+	applier := &fakeApplier{err: errors.New("permission denied")}
+	m := openTestManager(t, filepath.Join(t.TempDir(), "locks.json"), applier, &now)
+	ctx := context.Background()
+
+	for _, l := range []Lock{
+		{InfobaseID: "ib-1", From: now, To: now.Add(time.Hour), SessionsDeny: true},
+		{ClusterID: "cluster-1", InfobaseID: "ib-1", From: now, To: now, SessionsDeny: true},
+		{ClusterID: "cluster-1", InfobaseID: "ib-1", From: now.Add(-2 * time.Hour), To: now.Add(-time.Hour), SessionsDeny: true},
+		{ClusterID: "cluster-1", InfobaseID: "ib-1", From: now, To: now.Add(time.Hour)},
+	} {
+		_, err := m.Schedule(ctx, l)
+		assert.ErrorIs(t, err, ErrInvalidLock)
+	}
+
+	_, err := m.Schedule(ctx, Lock{ClusterID: "cluster-1", InfobaseID: "ib-1", From: now, To: now.Add(time.Hour), SessionsDeny: true})
+	assert.EqualError(t, err, "permission denied")
+	assert.Empty(t, m.List("", ""), "a window failing to open is not kept")
+}
+
+func TestManager_RetriesFailedRelease(t *testing.T) {
+	now := time.Now()
+	applier := &fakeApplier{}
+	m := openTestManager(t, filepath.Join(t.TempDir(), "locks.json"), applier, &now)
+	ctx := context.Background()
+
+	l, err := m.Schedule(ctx, Lock{
+		ClusterID:         "cluster-1",
+		InfobaseID:        "ib-1",
+		From:              now,
+		To:                now.Add(time.Hour),
+		ScheduledJobsDeny: true,
+	})
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	applier.err = errors.New("connection refused")
+	assert.Equal(t, now.Add(DefaultRetryInterval), m.process(ctx))
+
+	got, _ := m.Get(l.ID)
+	assert.Equal(t, "connection refused", got.LastError)
+	assert.Equal(t, StatusActive, got.Status)
+
+	applier.err = nil
+	now = now.Add(DefaultRetryInterval)
+	assert.True(t, m.process(ctx).IsZero())
+	assert.False(t, applier.state.ScheduledJobsDeny)
+	assert.Empty(t, m.List("cluster-1", "ib-1"))
+}
+
+func TestManager_SkipsMissedWindow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks.json")
+	now := time.Now()
+	applier := &fakeApplier{}
+
+	m := openTestManager(t, path, applier, &now)
+	_, err := m.Schedule(context.Background(), Lock{
+		ClusterID:    "cluster-1",
+		InfobaseID:   "ib-1",
+		From:         now.Add(time.Hour),
+		To:           now.Add(2 * time.Hour),
+		SessionsDeny: true,
+	})
+	require.NoError(t, err)
+
+	now = now.Add(3 * time.Hour)
+	m = openTestManager(t, path, applier, &now)
+	m.process(context.Background())
+	assert.Empty(t, applier.calls, "a window that passed while the gateway was down is never applied")
+	assert.Empty(t, m.List("", ""))
+}
+
+func TestManager_MaintenanceSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks.json")
+	now := time.Now()
+	applier := &fakeApplier{}
+	ctx := context.Background()
+	current := func(context.Context) (LockState, error) {
+		return LockState{SessionsDeny: true, DeniedMessage: "Ночной регламент"}, nil
+	}
+
+	m := openTestManager(t, path, applier, &now)
+	mt, err := m.BeginMaintenance(ctx, "cluster-1", "ib-1", current)
+	require.NoError(t, err)
+	assert.Equal(t, "Ночной регламент", mt.Previous.DeniedMessage)
+
+	_, err = m.BeginMaintenance(ctx, "cluster-1", "ib-1", current)
+	assert.ErrorIs(t, err, ErrMaintenance)
+
+	m = openTestManager(t, path, applier, &now)
+	got, ok := m.Maintenance("cluster-1", "ib-1")
+	require.True(t, ok, "the maintenance survives the restart")
+	assert.Equal(t, mt.Previous, got.Previous)
+
+	var restored LockState
+	state, err := m.EndMaintenance(ctx, "cluster-1", "ib-1", func(ctx context.Context, state LockState) error {
+		restored = state
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mt.Previous, state)
+	assert.Equal(t, mt.Previous, restored)
+
+	_, err = m.EndMaintenance(ctx, "cluster-1", "ib-1", nil)
+	assert.ErrorIs(t, err, ErrNoMaintenance)
+
+	m = openTestManager(t, path, applier, &now)
+	_, ok = m.Maintenance("cluster-1", "ib-1")
+	assert.False(t, ok)
+}
+
+func TestManager_WindowsWaitForMaintenance(t *testing.T) {
+	now := time.Now()
+	applier := &fakeApplier{}
+	m := openTestManager(t, filepath.Join(t.TempDir(), "locks.json"), applier, &now)
+	ctx := context.Background()
+	current := func(context.Context) (LockState, error) { return applier.state, nil }
+	restore := func(ctx context.Context, state LockState) error {
+		applier.state = state
+		return nil
+	}
+
+	first, err := m.Schedule(ctx, Lock{
+		ClusterID:     "cluster-1",
+		InfobaseID:    "ib-1",
+		From:          now,
+		To:            now.Add(time.Hour),
+		SessionsDeny:  true,
+		DeniedMessage: "Закрытие месяца",
+	})
+	require.NoError(t, err)
+
+	mt, err := m.BeginMaintenance(ctx, "cluster-1", "ib-1", current)
+	require.NoError(t, err)
+	assert.Equal(t, LockState{}, mt.Previous, "the state before the active window is restored")
+	applier.state = LockState{SessionsDeny: true, DeniedMessage: "Обновление", ScheduledJobsDeny: true}
+
+	second, err := m.Schedule(ctx, Lock{
+		ClusterID:         "cluster-1",
+		InfobaseID:        "ib-1",
+		From:              now.Add(time.Hour),
+		To:                now.Add(2 * time.Hour),
+		ScheduledJobsDeny: true,
+	})
+	require.NoError(t, err)
+
+	// Both windows are due during the maintenance
+	now = now.Add(90 * time.Minute)
+	assert.True(t, m.process(ctx).IsZero())
+	assert.Equal(t, []string{"apply " + first.ID}, applier.calls, "the maintenance lock is left alone")
+	assert.Equal(t, "Обновление", applier.state.DeniedMessage)
+
+	state, err := m.EndMaintenance(ctx, "cluster-1", "ib-1", restore)
+	require.NoError(t, err)
+	assert.Equal(t, LockState{}, state)
+	assert.Equal(t, LockState{}, applier.state)
+
+	assert.Equal(t, second.To, m.process(ctx))
+	assert.Equal(t, []string{"apply " + first.ID, "restore " + first.ID, "apply " + second.ID}, applier.calls)
+	assert.True(t, applier.state.ScheduledJobsDeny, "the window opened during the maintenance is applied after it")
+	got, _ := m.Get(second.ID)
+	assert.Equal(t, LockState{}, *got.Previous)
+	_, ok := m.Get(first.ID)
+	assert.False(t, ok)
+}
+
+func TestManager_MaintenanceKeepsOpenWindow(t *testing.T) {
+	now := time.Now()
+	applier := &fakeApplier{state: LockState{DeniedMessage: "old"}}
+	m := openTestManager(t, filepath.Join(t.TempDir(), "locks.json"), applier, &now)
+	ctx := context.Background()
+
+	l, err := m.Schedule(ctx, Lock{
+		ClusterID:         "cluster-1",
+		InfobaseID:        "ib-1",
+		From:              now,
+		To:                now.Add(time.Hour),
+		ScheduledJobsDeny: true,
+	})
+	require.NoError(t, err)
+
+	_, err = m.BeginMaintenance(ctx, "cluster-1", "ib-1", func(context.Context) (LockState, error) {
+		return applier.state, nil
+	})
+	require.NoError(t, err)
+	applier.state = LockState{SessionsDeny: true, ScheduledJobsDeny: true}
+
+	state, err := m.EndMaintenance(ctx, "cluster-1", "ib-1", func(ctx context.Context, state LockState) error {
+		applier.state = state
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, LockState{DeniedMessage: "old", ScheduledJobsDeny: true}, state, "the window is still open")
+	assert.Equal(t, state, applier.state)
+
+	now = now.Add(time.Hour)
+	m.process(ctx)
+	assert.Equal(t, LockState{DeniedMessage: "old"}, applier.state)
+	assert.Empty(t, m.List("", ""))
+	assert.Equal(t, []string{"apply " + l.ID, "restore " + l.ID}, applier.calls)
+}
@@ -1,27 +1,202 @@
 package server
 
-import "github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 type AccessServer interface {
 	service.TokenServiceServer
 	service.ClientServiceServer
 	ValidateToken(token string) (bool, error)
 	ValidateHash(token string, hash string) (bool, error)
+	// Identify returns the caller an access token was issued to
+	Identify(ctx context.Context, token string) (auth.Identity, error)
+}
+
+// AccessOption configures the access server
+type AccessOption func(*accessServer)
+
+// WithClientRegistry makes ClientService manage the clients of registry,
+// usually the RASServer routing the requests
+func WithClientRegistry(registry ClientRegistry) AccessOption {
+	return func(a *accessServer) {
+		a.clients = registry
+	}
+}
+
+// WithTokens makes TokenService issue tokens of manager
+// to the users authenticated by users
+func WithTokens(manager *auth.Manager, users auth.UserStore) AccessOption {
+	return func(a *accessServer) {
+		a.tokens = manager
+		a.users = users
+	}
 }
 
 type accessServer struct {
 	service.UnimplementedTokenServiceServer
 	service.UnimplementedClientServiceServer
+
+	clients ClientRegistry
+	tokens  *auth.Manager
+	users   auth.UserStore
+}
+
+// GetToken exchanges user credentials for an access and a refresh token
+func (a *accessServer) GetToken(ctx context.Context, req *service.GetTokenRequest) (*service.GetTokenResponse, error) {
+
+	if strings.TrimSpace(req.GetUser()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+
+	user, err := a.users.Authenticate(ctx, req.GetUser(), req.GetPassword())
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to authenticate user: %v", err)
+	}
+
+	pair, err := a.tokens.Issue(ctx, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to issue token: %v", err)
+	}
+
+	return a.tokenResponse(pair), nil
+}
+
+// UpdateToken rotates the refresh token, the old one becomes unusable
+func (a *accessServer) UpdateToken(ctx context.Context, req *service.UpdateTokenRequest) (*service.GetTokenResponse, error) {
+
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	if req.GetHash() != "" && !a.tokens.CheckHash(req.GetAccessToken(), req.GetHash()) {
+		return nil, status.Error(codes.Unauthenticated, "hash does not match access_token")
+	}
+
+	pair, err := a.tokens.Refresh(ctx, req.GetRefreshToken(), req.GetAccessToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return a.tokenResponse(pair), nil
 }
 
-func (a accessServer) ValidateToken(token string) (bool, error) {
-	panic("implement me")
+// ValidateToken reports whether token is a valid access token,
+// the error tells why it is not
+func (a *accessServer) ValidateToken(token string) (bool, error) {
+	if _, err := a.Identify(context.Background(), token); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (a accessServer) ValidateHash(token string, hash string) (bool, error) {
-	panic("implement me")
+// Identify validates an access token and returns its owner
+func (a *accessServer) Identify(ctx context.Context, token string) (auth.Identity, error) {
+	return a.tokens.Validate(ctx, token)
 }
 
-func NewAccessServer() AccessServer {
-	return &accessServer{}
+// ValidateHash reports whether hash is the signature the gateway issued for token
+func (a *accessServer) ValidateHash(token string, hash string) (bool, error) {
+	if token == "" || hash == "" {
+		return false, errors.New("token and hash are required")
+	}
+	return a.tokens.CheckHash(token, hash), nil
+}
+
+func (a *accessServer) tokenResponse(pair auth.TokenPair) *service.GetTokenResponse {
+	return &service.GetTokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		Hash:         a.tokens.Hash(pair.AccessToken),
+	}
+}
+
+// Register creates a RAS client for the host, the returned uuid
+// is passed as client_id metadata to route requests to it
+func (a *accessServer) Register(ctx context.Context, req *service.RegisterRequest) (*service.RegisterResponse, error) {
+
+	info, err := a.clients.RegisterClient(req.GetHost(), time.Duration(req.GetIdleTimeout())*time.Second, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.RegisterResponse{
+		Uuid: info.UUID(),
+	}, nil
+}
+
+// GetClients lists the RAS clients with their usage
+func (a *accessServer) GetClients(ctx context.Context, req *service.GetClientsRequest) (*service.GetClientsResponse, error) {
+
+	now := time.Now()
+	resp := &service.GetClientsResponse{}
+
+	for _, info := range a.clients.Clients() {
+		client := &service.ClientInfo{
+			Host:        info.Host(),
+			IdleTimeout: int32(info.IdleTimeout / time.Second),
+			Uuid:        info.UUID(),
+			Active:      info.Active(now),
+		}
+		if usedAt := info.UsedAt(); !usedAt.IsZero() {
+			client.LastUsed = timestamppb.New(usedAt)
+			client.IdleAt = timestamppb.New(info.IdleAt())
+		}
+		if stats, ok := info.PoolStats(); ok {
+			client.Pool = &service.PoolStats{
+				Conns:        int32(stats.Conns),
+				Connected:    int32(stats.Connected),
+				Endpoints:    int32(stats.Endpoints),
+				Evicted:      stats.Evicted,
+				Disconnected: stats.Disconnected,
+			}
+		}
+		resp.Clients = append(resp.Clients, client)
+	}
+
+	return resp, nil
+}
+
+// ResetClients closes all RAS clients
+func (a *accessServer) ResetClients(ctx context.Context, req *service.ResetClientsRequest) (*emptypb.Empty, error) {
+
+	if err := a.clients.ResetClients(); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func NewAccessServer(opts ...AccessOption) AccessServer {
+
+	a := &accessServer{}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.clients == nil {
+		a.clients = &RASServer{}
+	}
+	if a.tokens == nil {
+		// Tokens of a random secret are valid only while the process runs
+		a.tokens, _ = auth.NewRandomManager()
+	}
+	if a.users == nil {
+		a.users = auth.NoUsers
+	}
+
+	return a
 }
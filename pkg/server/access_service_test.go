@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"github.com/v8platform/ras-grpc-gw/pkg/client"
+	"github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// usedClient is a RAS client with a fixed usage time
+type usedClient struct {
+	MockRASClient
+	usedAt time.Time
+	closed bool
+}
+
+func (c *usedClient) UsedAt() time.Time {
+	return c.usedAt
+}
+
+func (c *usedClient) Stats() client.PoolStats {
+	return client.PoolStats{Conns: 2, Connected: 1, Endpoints: 3, Evicted: 4}
+}
+
+func (c *usedClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func newAccessTestServer(usedAt time.Time) (*RASServer, map[string]*usedClient, AccessServer) {
+	ras := NewRASServer("ras-a:1545")
+	ras.AllowHosts("ras-b:1545", "ras-c:1545")
+	created := make(map[string]*usedClient)
+	ras.newClient = func(host string, _ time.Duration) RASClient {
+		c := &usedClient{usedAt: usedAt}
+		created[host] = c
+		return c
+	}
+	return ras, created, NewAccessServer(WithClientRegistry(ras))
+}
+
+func TestAccessServer_Register(t *testing.T) {
+	ras, _, srv := newAccessTestServer(time.Time{})
+	ctx := context.Background()
+
+	resp, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545", IdleTimeout: 60})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetUuid())
+
+	info, ok := ras.clientByID(resp.GetUuid())
+	require.True(t, ok)
+	assert.Equal(t, "ras-b:1545", info.Host())
+	assert.Equal(t, time.Minute, info.IdleTimeout)
+
+	// Registered hosts are routable by ras_host
+	_, err = ras.clientForHost("ras-b:1545")
+	assert.NoError(t, err)
+
+	again, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545"})
+	require.NoError(t, err)
+	assert.Equal(t, resp.GetUuid(), again.GetUuid(), "a host keeps its client")
+}
+
+func TestAccessServer_RegisterWithUUID(t *testing.T) {
+	_, _, srv := newAccessTestServer(time.Time{})
+	ctx := context.Background()
+
+	uuid := "my-client"
+	resp, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545", Uuid: &uuid})
+	require.NoError(t, err)
+	assert.Equal(t, uuid, resp.GetUuid())
+
+	_, err = srv.Register(ctx, &service.RegisterRequest{Host: "ras-c:1545", Uuid: &uuid})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	other := "other-client"
+	_, err = srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545", Uuid: &other})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err), "a host keeps the uuid it was registered with")
+
+	again, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545", Uuid: &uuid})
+	require.NoError(t, err)
+	assert.Equal(t, uuid, again.GetUuid())
+}
+
+func TestAccessServer_RegisterValidation(t *testing.T) {
+	_, _, srv := newAccessTestServer(time.Time{})
+	ctx := context.Background()
+
+	_, err := srv.Register(ctx, &service.RegisterRequest{Host: " "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545", IdleTimeout: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.Register(ctx, &service.RegisterRequest{Host: "evil:1545"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "hosts outside the allow-list")
+}
+
+func TestAccessServer_GetClients(t *testing.T) {
+	usedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+	_, _, srv := newAccessTestServer(usedAt)
+	ctx := context.Background()
+
+	_, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545", IdleTimeout: 30})
+	require.NoError(t, err)
+	_, err = srv.Register(ctx, &service.RegisterRequest{Host: "ras-a:1545", IdleTimeout: 3600})
+	require.NoError(t, err)
+
+	resp, err := srv.GetClients(ctx, &service.GetClientsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetClients(), 2)
+
+	a, b := resp.GetClients()[0], resp.GetClients()[1]
+	assert.Equal(t, "ras-a:1545", a.GetHost())
+	assert.True(t, a.GetActive())
+	assert.Equal(t, usedAt, a.GetLastUsed().AsTime().Local())
+	assert.Equal(t, usedAt.Add(time.Hour), a.GetIdleAt().AsTime().Local())
+	assert.Equal(t, int32(2), a.GetPool().GetConns())
+	assert.Equal(t, int32(1), a.GetPool().GetConnected())
+	assert.Equal(t, int32(3), a.GetPool().GetEndpoints())
+	assert.Equal(t, uint64(4), a.GetPool().GetEvicted())
+
+	assert.Equal(t, "ras-b:1545", b.GetHost())
+	assert.Equal(t, int32(30), b.GetIdleTimeout())
+	assert.False(t, b.GetActive(), "client unused for longer than its idle timeout")
+}
+
+func TestAccessServer_GetClientsUnused(t *testing.T) {
+	_, _, srv := newAccessTestServer(time.Time{})
+	ctx := context.Background()
+
+	_, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545"})
+	require.NoError(t, err)
+
+	resp, err := srv.GetClients(ctx, &service.GetClientsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetClients(), 1)
+	assert.False(t, resp.GetClients()[0].GetActive())
+	assert.Nil(t, resp.GetClients()[0].GetLastUsed())
+	assert.Nil(t, resp.GetClients()[0].GetIdleAt())
+}
+
+func TestAccessServer_ResetClients(t *testing.T) {
+	ras, created, srv := newAccessTestServer(time.Time{})
+	ctx := context.Background()
+
+	_, err := srv.Register(ctx, &service.RegisterRequest{Host: "ras-b:1545"})
+	require.NoError(t, err)
+	ras.Clusters().Register("cluster-b", "ras-b:1545")
+
+	_, err = srv.ResetClients(ctx, &service.ResetClientsRequest{})
+	require.NoError(t, err)
+
+	assert.True(t, created["ras-b:1545"].closed)
+	assert.Empty(t, ras.Clients())
+
+	_, ok := ras.Clusters().Lookup("cluster-b")
+	assert.False(t, ok, "routes to unregistered hosts must be dropped")
+
+	_, err = ras.clientForHost("ras-b:1545")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ras.clientForHost("ras-a:1545")
+	assert.NoError(t, err, "configured hosts stay routable")
+}
+
+// newTokenTestServer issues tokens to admin and to a user named after
+// each of roles, all with the password "secret"
+func newTokenTestServer(t *testing.T, roles ...string) AccessServer {
+	t.Helper()
+
+	hash, err := auth.HashPassword("secret")
+	require.NoError(t, err)
+	entries := []auth.UserEntry{{Name: "admin", PasswordHash: hash, Roles: []string{"admin"}}}
+	for _, role := range roles {
+		entries = append(entries, auth.UserEntry{Name: role, PasswordHash: hash, Roles: []string{role}})
+	}
+	users, err := auth.NewFileUserStore(entries...)
+	require.NoError(t, err)
+	tokens, err := auth.NewRandomManager()
+	require.NoError(t, err)
+
+	return NewAccessServer(WithTokens(tokens, users))
+}
+
+func TestAccessServer_GetToken(t *testing.T) {
+	srv := newTokenTestServer(t)
+	ctx := context.Background()
+
+	resp, err := srv.GetToken(ctx, &service.GetTokenRequest{User: "admin", Password: "secret"})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetAccessToken())
+	require.NotEmpty(t, resp.GetRefreshToken())
+
+	ok, err := srv.ValidateToken(resp.GetAccessToken())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	id, err := srv.Identify(ctx, resp.GetAccessToken())
+	require.NoError(t, err)
+	assert.Equal(t, "admin", id.Subject)
+	assert.Equal(t, []string{"admin"}, id.Roles)
+
+	ok, err = srv.ValidateHash(resp.GetAccessToken(), resp.GetHash())
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = srv.GetToken(ctx, &service.GetTokenRequest{User: "admin", Password: "wrong"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = srv.GetToken(ctx, &service.GetTokenRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAccessServer_UpdateToken(t *testing.T) {
+	srv := newTokenTestServer(t)
+	ctx := context.Background()
+
+	first, err := srv.GetToken(ctx, &service.GetTokenRequest{User: "admin", Password: "secret"})
+	require.NoError(t, err)
+
+	second, err := srv.UpdateToken(ctx, &service.UpdateTokenRequest{
+		AccessToken:  first.GetAccessToken(),
+		RefreshToken: first.GetRefreshToken(),
+		Hash:         first.GetHash(),
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, first.GetRefreshToken(), second.GetRefreshToken())
+
+	_, err = srv.UpdateToken(ctx, &service.UpdateTokenRequest{RefreshToken: first.GetRefreshToken()})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "refresh tokens are single use")
+
+	_, err = srv.UpdateToken(ctx, &service.UpdateTokenRequest{
+		AccessToken:  second.GetAccessToken(),
+		RefreshToken: second.GetRefreshToken(),
+		Hash:         first.GetHash(),
+	})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAccessServer_ValidateDoesNotPanic(t *testing.T) {
+	srv := NewAccessServer()
+
+	ok, err := srv.ValidateToken("garbage")
+	assert.False(t, ok)
+	assert.Error(t, err)
+
+	ok, err = srv.ValidateHash("", "")
+	assert.False(t, ok)
+	assert.Error(t, err)
+
+	_, err = srv.GetToken(context.Background(), &service.GetTokenRequest{User: "admin", Password: "admin"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "no users are configured by default")
+}
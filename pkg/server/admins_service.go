@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ cluster_service.AdminsServiceServer = (*rasClientServiceServer)(nil)
+
+// GetAgentAdmins lists the administrators of the central server agent
+func (s *rasClientServiceServer) GetAgentAdmins(ctx context.Context, request *cluster_service.ListAgentAdminsRequest) (*cluster_service.GetAgentAdminsResponse, error) {
+
+	resp := &cluster_service.GetAgentAdminsResponse{}
+	err := s.agentRequest(ctx, request.GetAgentUser(), request.GetAgentPassword(),
+		&cluster_service.GetAgentAdminsRequest{}, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetClusterAdmins lists the administrators of a cluster
+func (s *rasClientServiceServer) GetClusterAdmins(ctx context.Context, request *cluster_service.GetClusterAdminsRequest) (*cluster_service.GetClusterAdminsResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetClusterAdminsResponse{}
+	if err := s.clusterRequest(ctx, request.GetClusterId(), request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RegAgentAdmin adds an administrator of the central server agent
+func (s *rasClientServiceServer) RegAgentAdmin(ctx context.Context, request *cluster_service.AddAdminRequest) (*emptypb.Empty, error) {
+
+	info, err := adminInfo(request)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("RegAgentAdmin request",
+		zap.String("name", info.GetName()),
+		zap.Bool("os_auth", info.GetOsAuthAllowed()),
+	)
+
+	err = s.agentRequest(ctx, request.GetAgentUser(), request.GetAgentPassword(),
+		&cluster_service.RegAgentAdminRequest{Info: info}, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RegClusterAdmin adds an administrator of a cluster
+func (s *rasClientServiceServer) RegClusterAdmin(ctx context.Context, request *cluster_service.AddAdminRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+	info, err := adminInfo(request)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("RegClusterAdmin request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("name", info.GetName()),
+		zap.Bool("os_auth", info.GetOsAuthAllowed()),
+	)
+
+	err = s.clusterRequest(ctx, request.GetClusterId(), &cluster_service.RegClusterAdminRequest{
+		ClusterId: request.GetClusterId(),
+		Info:      info,
+	}, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnregAgentAdmin removes an administrator of the central server agent
+func (s *rasClientServiceServer) UnregAgentAdmin(ctx context.Context, request *cluster_service.RemoveAgentAdminRequest) (*emptypb.Empty, error) {
+
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	logger.Log.Warn("UnregAgentAdmin request", zap.String("name", request.GetName()))
+
+	err := s.agentRequest(ctx, request.GetAgentUser(), request.GetAgentPassword(),
+		&cluster_service.UnregAgentAdminRequest{Name: request.GetName()}, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UnregClusterAdmin removes an administrator of a cluster
+func (s *rasClientServiceServer) UnregClusterAdmin(ctx context.Context, request *cluster_service.UnregClusterAdminRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "name", request.GetName()); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Warn("UnregClusterAdmin request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("name", request.GetName()),
+	)
+
+	if err := s.clusterRequest(ctx, request.GetClusterId(), request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// agentRequest sends request on an endpoint authenticated as the agent
+// administrator, without a user the agent is expected to have none
+func (s *rasClientServiceServer) agentRequest(ctx context.Context, user, password string, request, resp proto.Message) error {
+
+	return s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+		if user != "" {
+			err := sendAuthentication(ctx, endpoint, &messagesv1.AuthenticateAgentRequest{
+				User:     user,
+				Password: password,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return sendEndpointRequest(ctx, endpoint, request, resp)
+	})
+}
+
+// clusterRequest sends request on an endpoint authenticated with the
+// stored cluster administrator
+func (s *rasClientServiceServer) clusterRequest(ctx context.Context, clusterID string, request, resp proto.Message) error {
+
+	return s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+		if err := s.authenticateCluster(ctx, endpoint, clusterID); err != nil {
+			return err
+		}
+		return sendEndpointRequest(ctx, endpoint, request, resp)
+	})
+}
+
+// adminInfo builds the RAS administrator from request, the password and
+// the OS user enable the matching authentication methods
+func adminInfo(request *cluster_service.AddAdminRequest) (*cluster_service.AdminInfo, error) {
+
+	if request.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if request.GetAdminPassword() == "" && request.GetOsUser() == "" {
+		return nil, status.Error(codes.InvalidArgument, "admin_password or os_user is required")
+	}
+
+	return &cluster_service.AdminInfo{
+		Name:                request.GetName(),
+		Descr:               request.GetDescr(),
+		Password:            request.GetAdminPassword(),
+		PasswordAuthAllowed: request.GetAdminPassword() != "",
+		OsAuthAllowed:       request.GetOsUser() != "",
+		OsUser:              request.GetOsUser(),
+	}, nil
+}
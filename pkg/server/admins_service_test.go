@@ -0,0 +1,103 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRegAgentAdmin_AuthMethods(t *testing.T) {
+	var sent []proto.Message
+	srv := connectionsEndpoint(nil, &sent)
+	ctx := context.Background()
+
+	_, err := srv.RegAgentAdmin(ctx, &cluster_service.AddAdminRequest{Name: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "password or OS user is required")
+
+	_, err = srv.RegAgentAdmin(ctx, &cluster_service.AddAdminRequest{
+		Name:          "ops",
+		OsUser:        `CORP\ops`,
+		AgentUser:     "root",
+		AgentPassword: "secret",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2)
+	assert.Equal(t, "root", sent[0].(*messagesv1.AuthenticateAgentRequest).GetUser())
+	info := sent[1].(*cluster_service.RegAgentAdminRequest).GetInfo()
+	assert.True(t, info.GetOsAuthAllowed())
+	assert.False(t, info.GetPasswordAuthAllowed())
+	assert.Equal(t, `CORP\ops`, info.GetOsUser())
+}
+
+func TestRegClusterAdmin_StoredCredentials(t *testing.T) {
+	var sent []proto.Message
+	srv := connectionsEndpoint(nil, &sent)
+	srv.vault = newTestVault(t)
+	require.NoError(t, srv.vault.Set(testClusterID, "", vault.Credentials{User: "admin"}))
+
+	_, err := srv.RegClusterAdmin(context.Background(), &cluster_service.AddAdminRequest{Name: "dev", AdminPassword: "pass"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.RegClusterAdmin(context.Background(), &cluster_service.AddAdminRequest{
+		ClusterId:     testClusterID,
+		Name:          "dev",
+		AdminPassword: "pass",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2)
+	assert.Equal(t, "admin", sent[0].(*messagesv1.ClusterAuthenticateRequest).GetUser())
+	reg := sent[1].(*cluster_service.RegClusterAdminRequest)
+	assert.Equal(t, testClusterID, reg.GetClusterId())
+	assert.True(t, reg.GetInfo().GetPasswordAuthAllowed())
+	assert.False(t, reg.GetInfo().GetOsAuthAllowed())
+}
+
+func TestUnregAdmins(t *testing.T) {
+	var sent []proto.Message
+	srv := connectionsEndpoint(nil, &sent)
+	ctx := context.Background()
+
+	_, err := srv.UnregAgentAdmin(ctx, &cluster_service.RemoveAgentAdminRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.UnregAgentAdmin(ctx, &cluster_service.RemoveAgentAdminRequest{Name: "ops"})
+	require.NoError(t, err)
+	_, err = srv.UnregClusterAdmin(ctx, &cluster_service.UnregClusterAdminRequest{ClusterId: testClusterID, Name: "dev"})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2, "no credentials, no authentication")
+	assert.Equal(t, "ops", sent[0].(*cluster_service.UnregAgentAdminRequest).GetName())
+	assert.Equal(t, "dev", sent[1].(*cluster_service.UnregClusterAdminRequest).GetName())
+}
+
+func TestAdminInfo_Encoding(t *testing.T) {
+	req := &cluster_service.RegClusterAdminRequest{
+		ClusterId: testClusterID,
+		Info: &cluster_service.AdminInfo{
+			Name:                "dev",
+			Descr:               "Разработчик",
+			Password:            "pass",
+			PasswordAuthAllowed: true,
+			OsAuthAllowed:       true,
+			OsUser:              `CORP\dev`,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, req.Formatter(&buf, 10))
+
+	parsed := &cluster_service.RegClusterAdminRequest{}
+	require.NoError(t, parsed.Parse(&buf, 10))
+	assert.True(t, proto.Equal(req, parsed), "got %v", parsed)
+}
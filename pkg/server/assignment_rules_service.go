@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ cluster_service.AssignmentRulesServiceServer = (*rasClientServiceServer)(nil)
+
+// GetClusterManagers lists the managers of a cluster
+func (s *rasClientServiceServer) GetClusterManagers(ctx context.Context, request *cluster_service.GetClusterManagersRequest) (*cluster_service.GetClusterManagersResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetClusterManagersResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	for _, manager := range resp.GetManagers() {
+		manager.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetClusterManagerInfo returns a single cluster manager
+func (s *rasClientServiceServer) GetClusterManagerInfo(ctx context.Context, request *cluster_service.GetClusterManagerInfoRequest) (*cluster_service.GetClusterManagerInfoResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "manager_id", request.GetManagerId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetClusterManagerInfoResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	if resp.GetInfo() != nil {
+		resp.Info.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetAssignmentRules lists the assignment rules of a working server in priority order
+func (s *rasClientServiceServer) GetAssignmentRules(ctx context.Context, request *cluster_service.GetAssignmentRulesRequest) (*cluster_service.GetAssignmentRulesResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetAssignmentRulesResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAssignmentRuleInfo returns a single assignment rule
+func (s *rasClientServiceServer) GetAssignmentRuleInfo(ctx context.Context, request *cluster_service.GetAssignmentRuleInfoRequest) (*cluster_service.GetAssignmentRuleInfoResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId(),
+		"rule_id", request.GetRuleId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetAssignmentRuleInfoResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RegAssignmentRule adds an assignment rule to a working server
+func (s *rasClientServiceServer) RegAssignmentRule(ctx context.Context, request *cluster_service.RegAssignmentRuleRequest) (*cluster_service.RegAssignmentRuleResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId()); err != nil {
+		return nil, err
+	}
+	if request.GetInfo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "info is required")
+	}
+	if request.GetInfo().GetUuid() != "" {
+		return nil, status.Error(codes.InvalidArgument, "info.uuid must be empty for a new rule, use UpdateAssignmentRule")
+	}
+
+	return s.regAssignmentRule(ctx, "RegAssignmentRule", request)
+}
+
+// UpdateAssignmentRule replaces an assignment rule of a working server
+func (s *rasClientServiceServer) UpdateAssignmentRule(ctx context.Context, request *cluster_service.RegAssignmentRuleRequest) (*cluster_service.RegAssignmentRuleResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId(),
+		"info.uuid", request.GetInfo().GetUuid()); err != nil {
+		return nil, err
+	}
+
+	return s.regAssignmentRule(ctx, "UpdateAssignmentRule", request)
+}
+
+// regAssignmentRule sends REG_ASSIGNMENT_RULE, RAS updates the rule
+// with the given uuid or adds a new one
+func (s *rasClientServiceServer) regAssignmentRule(ctx context.Context, operation string, request *cluster_service.RegAssignmentRuleRequest) (*cluster_service.RegAssignmentRuleResponse, error) {
+
+	logger.Log.Info(operation+" request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("server_id", request.GetServerId()),
+		zap.String("rule_id", request.GetInfo().GetUuid()),
+		zap.String("infobase_name", request.GetInfo().GetInfobaseName()),
+	)
+
+	resp := &cluster_service.RegAssignmentRuleResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UnregAssignmentRule removes an assignment rule from a working server
+func (s *rasClientServiceServer) UnregAssignmentRule(ctx context.Context, request *cluster_service.UnregAssignmentRuleRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId(),
+		"rule_id", request.GetRuleId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ApplyAssignmentRules redistributes the cluster objects by the current rules
+func (s *rasClientServiceServer) ApplyAssignmentRules(ctx context.Context, request *cluster_service.ApplyAssignmentRulesRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("ApplyAssignmentRules request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.Stringer("mode", request.GetMode()),
+	)
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const testRuleID = "6b5a4c3d-2e1f-4a0b-9c8d-7e6f5a4b3c2d"
+
+func TestAssignmentRule_RegAndUpdate(t *testing.T) {
+	var requests []*anypb.Any
+	srv := respondingServer(&cluster_service.RegAssignmentRuleResponse{RuleId: testRuleID}, &requests)
+	ctx := context.Background()
+
+	rule := &cluster_service.AssignmentRuleInfo{
+		InfobaseName: "accounting",
+		RuleType:     cluster_service.AssignmentRuleType_ASSIGNMENT_RULE_TYPE_ALWAYS,
+		Priority:     1000,
+	}
+
+	resp, err := srv.RegAssignmentRule(ctx, &cluster_service.RegAssignmentRuleRequest{ClusterId: testClusterID, ServerId: testServerID, Info: rule})
+	require.NoError(t, err)
+	assert.Equal(t, testRuleID, resp.GetRuleId())
+
+	_, err = srv.UpdateAssignmentRule(ctx, &cluster_service.RegAssignmentRuleRequest{ClusterId: testClusterID, ServerId: testServerID, Info: rule})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "update needs the rule uuid")
+
+	updated := proto.Clone(rule).(*cluster_service.AssignmentRuleInfo)
+	updated.Uuid = testRuleID
+	_, err = srv.RegAssignmentRule(ctx, &cluster_service.RegAssignmentRuleRequest{ClusterId: testClusterID, ServerId: testServerID, Info: updated})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "registration creates new rules only")
+
+	_, err = srv.UpdateAssignmentRule(ctx, &cluster_service.RegAssignmentRuleRequest{ClusterId: testClusterID, ServerId: testServerID, Info: updated})
+	require.NoError(t, err)
+
+	require.Len(t, requests, 2)
+	var sent cluster_service.RegAssignmentRuleRequest
+	require.NoError(t, requests[1].UnmarshalTo(&sent))
+	assert.Equal(t, testRuleID, sent.GetInfo().GetUuid())
+}
+
+func TestAssignmentRules_Encoding(t *testing.T) {
+	req := &cluster_service.RegAssignmentRuleRequest{
+		ClusterId: testClusterID,
+		ServerId:  testServerID,
+		Info: &cluster_service.AssignmentRuleInfo{
+			Uuid:           testRuleID,
+			ObjectType:     1,
+			InfobaseName:   "accounting",
+			RuleType:       cluster_service.AssignmentRuleType_ASSIGNMENT_RULE_TYPE_NEVER,
+			ApplicationExt: "BackgroundJob",
+			Priority:       10,
+		},
+		Position: 2,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, req.Formatter(&buf, 10))
+
+	parsed := &cluster_service.RegAssignmentRuleRequest{}
+	require.NoError(t, parsed.Parse(&buf, 10))
+	assert.True(t, proto.Equal(req, parsed), "got %v", parsed)
+
+	apply := &cluster_service.ApplyAssignmentRulesRequest{ClusterId: testClusterID, Mode: cluster_service.ApplyAssignmentMode_APPLY_ASSIGNMENT_MODE_FULL}
+	buf.Reset()
+	require.NoError(t, apply.Formatter(&buf, 10))
+	parsedApply := &cluster_service.ApplyAssignmentRulesRequest{}
+	require.NoError(t, parsedApply.Parse(&buf, 10))
+	assert.Equal(t, apply.GetMode(), parsedApply.GetMode())
+}
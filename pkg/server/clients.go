@@ -0,0 +1,162 @@
+package server
+
+import (
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClientRegistry keeps the RAS clients served by the gateway
+type ClientRegistry interface {
+	// RegisterClient adds a client for the RAS service at host.
+	// An empty uuid lets the registry choose one.
+	RegisterClient(host string, idleTimeout time.Duration, uuid string) (*ClientInfo, error)
+	// Clients returns all registered clients ordered by host
+	Clients() []*ClientInfo
+	// ResetClients closes and forgets all clients
+	ResetClients() error
+}
+
+var _ ClientRegistry = (*RASServer)(nil)
+
+// UUID is the client_id used to route requests to the client
+func (c *ClientInfo) UUID() string {
+	return c.uuid
+}
+
+// Host is the address of the RAS service
+func (c *ClientInfo) Host() string {
+	return c.host
+}
+
+// UsedAt returns the last time the client talked to RAS,
+// zero if the client has not been used yet
+func (c *ClientInfo) UsedAt() time.Time {
+	if used, ok := c.client.(interface{ UsedAt() time.Time }); ok {
+		return used.UsedAt()
+	}
+	return time.Time{}
+}
+
+// PoolStats returns the connection pool counts of the client,
+// false if its RAS client is not a pool
+func (c *ClientInfo) PoolStats() (client.PoolStats, bool) {
+	if pool, ok := c.client.(interface{ Stats() client.PoolStats }); ok {
+		return pool.Stats(), true
+	}
+	return client.PoolStats{}, false
+}
+
+// IdleAt returns the time the client becomes idle unless used again
+func (c *ClientInfo) IdleAt() time.Time {
+	usedAt := c.UsedAt()
+	if usedAt.IsZero() {
+		return time.Time{}
+	}
+	return usedAt.Add(c.IdleTimeout)
+}
+
+// Active reports whether the client has been used within its idle timeout
+func (c *ClientInfo) Active(now time.Time) bool {
+	idleAt := c.IdleAt()
+	return !idleAt.IsZero() && now.Before(idleAt)
+}
+
+// AllowHosts lets clients register the RAS services at hosts in addition
+// to the configured ones
+func (s *RASServer) AllowHosts(hosts ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.allowedHosts = append(s.allowedHosts, hosts...)
+}
+
+// RegisterClient adds a client for host, the host becomes routable by ras_host.
+// Only configured and allowed hosts may be registered. A host that already
+// has a client keeps it and its uuid.
+func (s *RASServer) RegisterClient(host string, idleTimeout time.Duration, uuid string) (*ClientInfo, error) {
+
+	host = strings.TrimSpace(host)
+	if host == "" {
+		return nil, status.Error(codes.InvalidArgument, "host is required")
+	}
+	if idleTimeout < 0 {
+		return nil, status.Error(codes.InvalidArgument, "idle_timeout must not be negative")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.knownHost(host) && !slices.Contains(s.allowedHosts, host) {
+		return nil, status.Errorf(codes.PermissionDenied, "RAS host %q is not allowed", host)
+	}
+
+	for _, info := range s.idxClients {
+		if info.host == host {
+			if uuid != "" && uuid != info.uuid {
+				return nil, status.Errorf(codes.AlreadyExists, "host %q already registered as client %q", host, info.uuid)
+			}
+			return info, nil
+		}
+	}
+
+	if _, exists := s.idxClients[uuid]; exists {
+		return nil, status.Errorf(codes.AlreadyExists, "client %q already registered", uuid)
+	}
+
+	return s.addClient(host, uuid, idleTimeout), nil
+}
+
+// Clients returns all registered clients ordered by host
+func (s *RASServer) Clients() []*ClientInfo {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clients := make([]*ClientInfo, 0, len(s.idxClients))
+	for _, info := range s.idxClients {
+		clients = append(clients, info)
+	}
+
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].host < clients[j].host
+	})
+
+	return clients
+}
+
+// ResetClients closes the RAS connections of all clients and forgets them.
+// Clients of the configured hosts are created again on the next request.
+func (s *RASServer) ResetClients() error {
+
+	s.mu.Lock()
+	clients := s.idxClients
+	s.idxClients = nil
+	s.idxEndpoints = nil
+	s.mu.Unlock()
+
+	var err error
+	for _, info := range clients {
+		if !s.isConfiguredHost(info.host) {
+			s.Clusters().Forget(info.host)
+		}
+		if closer, ok := info.client.(io.Closer); ok {
+			if cErr := closer.Close(); cErr != nil && err == nil {
+				err = cErr
+			}
+		}
+	}
+
+	return err
+}
+
+func (s *RASServer) isConfiguredHost(host string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.knownHost(host)
+}
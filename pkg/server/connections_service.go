@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ cluster_service.ConnectionsServiceServer = (*rasClientServiceServer)(nil)
+
+// GetConnections lists the connections of a cluster
+func (s *rasClientServiceServer) GetConnections(ctx context.Context, request *cluster_service.GetConnectionsShortRequest) (*cluster_service.GetConnectionsShortResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetConnectionsShortResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	for _, conn := range resp.GetConnections() {
+		conn.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetProcessConnections lists the connections served by a working process.
+// RAS lists connections per cluster, the gateway filters them by process.
+func (s *rasClientServiceServer) GetProcessConnections(ctx context.Context, request *cluster_service.GetProcessConnectionsRequest) (*cluster_service.GetConnectionsShortResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "process_id", request.GetProcessId()); err != nil {
+		return nil, err
+	}
+
+	all, err := s.GetConnections(ctx, &cluster_service.GetConnectionsShortRequest{ClusterId: request.GetClusterId()})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetConnectionsShortResponse{}
+	for _, conn := range all.GetConnections() {
+		if conn.GetProcessId() == request.GetProcessId() {
+			resp.Connections = append(resp.Connections, conn)
+		}
+	}
+
+	return resp, nil
+}
+
+// GetInfobaseConnections lists the connections of an infobase
+func (s *rasClientServiceServer) GetInfobaseConnections(ctx context.Context, request *cluster_service.GetInfobaseConnectionsShortRequest) (*cluster_service.GetInfobaseConnectionsShortResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "infobase_id", request.GetInfobaseId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetInfobaseConnectionsShortResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	for _, conn := range resp.GetConnections() {
+		conn.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetConnectionInfo returns a single connection
+func (s *rasClientServiceServer) GetConnectionInfo(ctx context.Context, request *cluster_service.GetConnectionInfoShortRequest) (*cluster_service.GetConnectionInfoShortResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "connection_id", request.GetConnectionId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetConnectionInfoShortResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	if resp.GetInfo() != nil {
+		resp.Info.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// Disconnect closes a connection. RAS requires the endpoint to be
+// authenticated in the infobase of the connection, credentials missing
+// in the request are taken from the vault.
+func (s *rasClientServiceServer) Disconnect(ctx context.Context, request *cluster_service.DisconnectConnectionRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "connection_id", request.GetConnectionId()); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("Disconnect request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("connection_id", request.GetConnectionId()),
+	)
+
+	err := s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+
+		processID, infobaseID := request.GetProcessId(), request.GetInfobaseId()
+		if processID == "" || infobaseID == "" {
+			info := &cluster_service.GetConnectionInfoShortResponse{}
+			err := sendEndpointRequest(ctx, endpoint, &cluster_service.GetConnectionInfoShortRequest{
+				ClusterId:    request.GetClusterId(),
+				ConnectionId: request.GetConnectionId(),
+			}, info)
+			if err != nil {
+				return err
+			}
+			if info.GetInfo() == nil {
+				return status.Errorf(codes.NotFound, "connection %s not found", request.GetConnectionId())
+			}
+			if processID == "" {
+				processID = info.GetInfo().GetProcessId()
+			}
+			if infobaseID == "" {
+				infobaseID = info.GetInfo().GetInfobaseId()
+			}
+		}
+
+		if err := s.authenticateInfobase(ctx, endpoint, request.GetClusterId(), infobaseID,
+			request.GetInfobaseUser(), request.GetInfobasePassword()); err != nil {
+			return err
+		}
+
+		return sendEndpointRequest(ctx, endpoint, &cluster_service.DisconnectRequest{
+			ClusterId:    request.GetClusterId(),
+			ProcessId:    processID,
+			ConnectionId: request.GetConnectionId(),
+		}, &emptypb.Empty{})
+	})
+	if err != nil {
+		logger.Log.Error("Failed to disconnect via RAS",
+			zap.String("cluster_id", request.GetClusterId()),
+			zap.String("connection_id", request.GetConnectionId()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// authenticateCluster authenticates endpoint with the stored cluster
+// administrator. Nothing is sent without stored credentials, the endpoint
+// may already be authenticated by AuthService calls of the client.
+func (s *rasClientServiceServer) authenticateCluster(ctx context.Context, endpoint clientv1.EndpointServiceImpl, clusterID string) error {
+
+	stored, ok := s.vault.Cluster(clusterID)
+	if !ok {
+		return nil
+	}
+
+	return sendAuthentication(ctx, endpoint, &messagesv1.ClusterAuthenticateRequest{
+		ClusterId: clusterID,
+		User:      stored.User,
+		Password:  stored.Password,
+	})
+}
+
+// authenticateInfobase authenticates endpoint as authenticateCluster does
+// and with the infobase user, user falls back to the vault
+func (s *rasClientServiceServer) authenticateInfobase(ctx context.Context, endpoint clientv1.EndpointServiceImpl, clusterID, infobaseID, user, password string) error {
+
+	if err := s.authenticateCluster(ctx, endpoint, clusterID); err != nil {
+		return err
+	}
+
+	if user == "" {
+		stored, ok := s.vault.Infobase(clusterID, infobaseID)
+		if !ok {
+			return nil
+		}
+		user, password = stored.User, stored.Password
+	}
+
+	return sendAuthentication(ctx, endpoint, &messagesv1.AuthenticateInfobaseRequest{
+		ClusterId: clusterID,
+		User:      user,
+		Password:  password,
+	})
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	testConnectionID = "9e8d7c6b-5a49-4382-a1b0-c9d8e7f6a5b4"
+	testInfobaseID   = "550e8400-e29b-41d4-a716-446655440000"
+)
+
+// connectionsEndpoint serves connections and records every sent message
+func connectionsEndpoint(connections []*cluster_service.ConnectionShortInfo, sent *[]proto.Message) *rasClientServiceServer {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			*sent = append(*sent, msg)
+			switch msg := msg.(type) {
+			case *cluster_service.GetConnectionsShortRequest:
+				return anypb.New(&cluster_service.GetConnectionsShortResponse{Connections: connections})
+			case *cluster_service.GetConnectionInfoShortRequest:
+				for _, c := range connections {
+					if c.GetUuid() == msg.GetConnectionId() {
+						return anypb.New(&cluster_service.GetConnectionInfoShortResponse{Info: c})
+					}
+				}
+				return nil, status.Error(codes.NotFound, "connection not found")
+			}
+			return anypb.New(&emptypb.Empty{})
+		},
+	}
+	return newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+}
+
+func TestGetProcessConnections_FiltersByProcess(t *testing.T) {
+	var sent []proto.Message
+	srv := connectionsEndpoint([]*cluster_service.ConnectionShortInfo{
+		{Uuid: testConnectionID, ProcessId: testProcessID, Application: "Designer"},
+		{Uuid: testServerID, ProcessId: testServerID, Application: "COMConnection"},
+	}, &sent)
+
+	resp, err := srv.GetProcessConnections(context.Background(), &cluster_service.GetProcessConnectionsRequest{
+		ClusterId: testClusterID,
+		ProcessId: testProcessID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetConnections(), 1)
+	assert.Equal(t, "Designer", resp.GetConnections()[0].GetApplication())
+	assert.Equal(t, testClusterID, resp.GetConnections()[0].GetClusterId())
+}
+
+func TestDisconnect_AuthenticatesInfobase(t *testing.T) {
+	var sent []proto.Message
+	srv := connectionsEndpoint([]*cluster_service.ConnectionShortInfo{
+		{Uuid: testConnectionID, ProcessId: testProcessID, InfobaseId: testInfobaseID},
+	}, &sent)
+	srv.vault = newTestVault(t)
+	require.NoError(t, srv.vault.Set(testClusterID, "", vault.Credentials{User: "admin"}))
+	require.NoError(t, srv.vault.Set(testClusterID, testInfobaseID, vault.Credentials{User: "ib-admin", Password: "secret"}))
+
+	_, err := srv.Disconnect(context.Background(), &cluster_service.DisconnectConnectionRequest{
+		ClusterId:    testClusterID,
+		ConnectionId: testConnectionID,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 4)
+	assert.IsType(t, &cluster_service.GetConnectionInfoShortRequest{}, sent[0])
+	assert.Equal(t, "admin", sent[1].(*messagesv1.ClusterAuthenticateRequest).GetUser())
+	assert.Equal(t, "ib-admin", sent[2].(*messagesv1.AuthenticateInfobaseRequest).GetUser())
+
+	disconnect := sent[3].(*cluster_service.DisconnectRequest)
+	assert.Equal(t, testProcessID, disconnect.GetProcessId())
+	assert.Equal(t, testConnectionID, disconnect.GetConnectionId())
+}
+
+func TestDisconnect_RequestCredentials(t *testing.T) {
+	var sent []proto.Message
+	srv := connectionsEndpoint(nil, &sent)
+
+	_, err := srv.Disconnect(context.Background(), &cluster_service.DisconnectConnectionRequest{
+		ClusterId:        testClusterID,
+		ConnectionId:     testConnectionID,
+		ProcessId:        testProcessID,
+		InfobaseId:       testInfobaseID,
+		InfobaseUser:     "operator",
+		InfobasePassword: "pass",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sent, 2, "known process needs no lookup")
+	assert.Equal(t, "operator", sent[0].(*messagesv1.AuthenticateInfobaseRequest).GetUser())
+	assert.IsType(t, &cluster_service.DisconnectRequest{}, sent[1])
+
+	_, err = srv.Disconnect(context.Background(), &cluster_service.DisconnectConnectionRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
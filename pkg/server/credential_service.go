@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NewCredentialServer creates the CredentialService managing the
+// RAS credentials of v. Without a vault every call fails with
+// FailedPrecondition.
+func NewCredentialServer(v *vault.Vault, opts ...CredentialOption) service.CredentialServiceServer {
+	c := &credentialServer{vault: v}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// CredentialOption configures the credential server
+type CredentialOption func(*credentialServer)
+
+// WithScheduledLocks keeps the credentials the lock windows of locks
+// are applied and released with from being deleted
+func WithScheduledLocks(locks *schedule.Manager) CredentialOption {
+	return func(c *credentialServer) {
+		c.scheduledLocks = locks
+	}
+}
+
+type credentialServer struct {
+	service.UnimplementedCredentialServiceServer
+
+	vault          *vault.Vault
+	scheduledLocks *schedule.Manager
+}
+
+// SetCredentials stores the credentials of a cluster administrator or infobase user
+func (c *credentialServer) SetCredentials(ctx context.Context, req *service.SetCredentialsRequest) (*emptypb.Empty, error) {
+
+	if err := c.check(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.GetUser()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "user is required")
+	}
+
+	err := c.vault.Set(req.GetClusterId(), req.GetInfobaseId(), vault.Credentials{
+		User:     req.GetUser(),
+		Password: req.GetUserPassword(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store credentials: %v", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RotateCredentials replaces the password of stored credentials
+func (c *credentialServer) RotateCredentials(ctx context.Context, req *service.RotateCredentialsRequest) (*emptypb.Empty, error) {
+
+	if err := c.check(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	err := c.vault.Rotate(req.GetClusterId(), req.GetInfobaseId(), req.GetUserPassword())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteCredentials removes stored credentials
+func (c *credentialServer) DeleteCredentials(ctx context.Context, req *service.DeleteCredentialsRequest) (*emptypb.Empty, error) {
+
+	if err := c.check(req.GetClusterId()); err != nil {
+		return nil, err
+	}
+	if l, ok := c.neededByLock(req.GetClusterId(), req.GetInfobaseId()); ok {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"credentials are needed by scheduled lock %s of infobase '%s', cancel the lock first", l.ID, l.InfobaseID)
+	}
+
+	if err := c.vault.Delete(req.GetClusterId(), req.GetInfobaseId()); err != nil {
+		return nil, vaultError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListCredentials describes the stored credentials, passwords are never returned
+func (c *credentialServer) ListCredentials(ctx context.Context, req *service.ListCredentialsRequest) (*service.ListCredentialsResponse, error) {
+
+	if c.vault == nil {
+		return nil, errVaultDisabled
+	}
+
+	resp := &service.ListCredentialsResponse{}
+	for _, e := range c.vault.List(req.GetClusterId()) {
+		resp.Credentials = append(resp.Credentials, &service.StoredCredentials{
+			ClusterId:  e.ClusterID,
+			InfobaseId: e.InfobaseID,
+			User:       e.User,
+			UpdatedAt:  timestamppb.New(e.UpdatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// neededByLock returns a scheduled lock the gateway would apply or release
+// with the stored credentials of the cluster or infobase, see
+// InfobaseManagementServer.scheduledLockCredentials
+func (c *credentialServer) neededByLock(clusterID, infobaseID string) (schedule.Lock, bool) {
+	for _, l := range c.scheduledLocks.List(clusterID, "") {
+		switch infobaseID {
+		case "":
+			return l, true
+		case vault.AnyInfobase:
+			// Shared credentials serve infobases without their own
+			if _, ok := c.vault.Get(clusterID, l.InfobaseID); !ok {
+				return l, true
+			}
+		case l.InfobaseID:
+			if _, ok := c.vault.Get(clusterID, vault.AnyInfobase); !ok {
+				return l, true
+			}
+		}
+	}
+	return schedule.Lock{}, false
+}
+
+var errVaultDisabled = status.Error(codes.FailedPrecondition, "credential vault is not configured, set VAULT_FILE")
+
+func (c *credentialServer) check(clusterID string) error {
+	if c.vault == nil {
+		return errVaultDisabled
+	}
+	if strings.TrimSpace(clusterID) == "" {
+		return status.Error(codes.InvalidArgument, "cluster_id is required")
+	}
+	return nil
+}
+
+func vaultError(err error) error {
+	if errors.Is(err, vault.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to update credentials: %v", err)
+}
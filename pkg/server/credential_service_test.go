@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	"github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func newTestVault(t *testing.T) *vault.Vault {
+	t.Helper()
+	v, err := vault.Open(filepath.Join(t.TempDir(), "vault.json"), []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	return v
+}
+
+func TestCredentialServer(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	srv := NewCredentialServer(v)
+
+	_, err := srv.SetCredentials(ctx, &service.SetCredentialsRequest{User: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.SetCredentials(ctx, &service.SetCredentialsRequest{ClusterId: "cluster-1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.SetCredentials(ctx, &service.SetCredentialsRequest{ClusterId: "cluster-1", User: "admin", UserPassword: "old"})
+	require.NoError(t, err)
+
+	_, err = srv.RotateCredentials(ctx, &service.RotateCredentialsRequest{ClusterId: "cluster-1", UserPassword: "new"})
+	require.NoError(t, err)
+	c, ok := v.Cluster("cluster-1")
+	require.True(t, ok)
+	assert.Equal(t, "new", c.Password)
+
+	list, err := srv.ListCredentials(ctx, &service.ListCredentialsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.GetCredentials(), 1)
+	assert.Equal(t, "admin", list.GetCredentials()[0].GetUser())
+
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: "cluster-1"})
+	require.NoError(t, err)
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: "cluster-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.RotateCredentials(ctx, &service.RotateCredentialsRequest{ClusterId: "cluster-1", UserPassword: "new"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCredentialServer_VaultDisabled(t *testing.T) {
+	srv := NewCredentialServer(nil)
+
+	_, err := srv.SetCredentials(context.Background(), &service.SetCredentialsRequest{ClusterId: "cluster-1", User: "admin"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.ListCredentials(context.Background(), &service.ListCredentialsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCredentialServer_KeepsScheduledLockCredentials(t *testing.T) {
+	ctx := context.Background()
+	v := newTestVault(t)
+	server := newRecordingServer(&maintenanceEndpoint{info: &serializev1.InfobaseInfo{Uuid: testInfobaseID}})
+	locks, err := schedule.Open(filepath.Join(t.TempDir(), "locks.json"), scheduledLockApplier{s: server}, zap.NewNop())
+	require.NoError(t, err)
+	srv := NewCredentialServer(v, WithScheduledLocks(locks))
+
+	require.NoError(t, v.Set(testClusterID, "", vault.Credentials{User: "admin"}))
+	require.NoError(t, v.Set(testClusterID, testInfobaseID, vault.Credentials{User: "ib-admin"}))
+	require.NoError(t, v.Set(testClusterID, vault.AnyInfobase, vault.Credentials{User: "any-admin"}))
+
+	now := time.Now()
+	l, err := locks.Schedule(ctx, schedule.Lock{
+		ClusterID:    testClusterID,
+		InfobaseID:   testInfobaseID,
+		From:         now.Add(time.Hour),
+		To:           now.Add(2 * time.Hour),
+		SessionsDeny: true,
+	})
+	require.NoError(t, err)
+
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the window needs the cluster administrator")
+
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: testClusterID, InfobaseId: vault.AnyInfobase})
+	require.NoError(t, err, "the infobase has credentials of its own")
+
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: testClusterID, InfobaseId: testInfobaseID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the window needs the infobase user")
+
+	_, err = locks.Cancel(ctx, l.ID)
+	require.NoError(t, err)
+
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: testClusterID, InfobaseId: testInfobaseID})
+	assert.NoError(t, err)
+	_, err = srv.DeleteCredentials(ctx, &service.DeleteCredentialsRequest{ClusterId: testClusterID})
+	assert.NoError(t, err)
+}
+
+// authCapture is a RAS client recording the authentication requests
+func authCapture(requests *[]*anypb.Any) *MockRASClient {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			*requests = append(*requests, req.Request)
+			return req.Respond, nil
+		},
+	}
+	return &MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	}
+}
+
+func TestAuthenticate_UsesStoredCredentials(t *testing.T) {
+	v := newTestVault(t)
+	require.NoError(t, v.Set("cluster-1", "", vault.Credentials{User: "admin", Password: "secret"}))
+	require.NoError(t, v.Set("cluster-1", "ib-1", vault.Credentials{User: "ib-user", Password: "ib-secret"}))
+
+	var requests []*anypb.Any
+	srv := newRasClientServiceServer(authCapture(&requests))
+	srv.vault = v
+
+	ctx := context.Background()
+	_, err := srv.AuthenticateCluster(ctx, &messagesv1.ClusterAuthenticateRequest{ClusterId: "cluster-1"})
+	require.NoError(t, err)
+	_, err = srv.AuthenticateCluster(ctx, &messagesv1.ClusterAuthenticateRequest{ClusterId: "cluster-1", User: "other"})
+	require.NoError(t, err)
+
+	ibCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("infobase_id", "ib-1"))
+	_, err = srv.AuthenticateInfobase(ibCtx, &messagesv1.AuthenticateInfobaseRequest{ClusterId: "cluster-1"})
+	require.NoError(t, err)
+
+	require.Len(t, requests, 3)
+
+	var cluster messagesv1.ClusterAuthenticateRequest
+	require.NoError(t, requests[0].UnmarshalTo(&cluster))
+	assert.Equal(t, "admin", cluster.GetUser())
+	assert.Equal(t, "secret", cluster.GetPassword())
+
+	require.NoError(t, requests[1].UnmarshalTo(&cluster))
+	assert.Equal(t, "other", cluster.GetUser(), "credentials of the request win")
+
+	var infobase messagesv1.AuthenticateInfobaseRequest
+	require.NoError(t, requests[2].UnmarshalTo(&infobase))
+	assert.Equal(t, "ib-user", infobase.GetUser())
+}
+
+func TestInfobaseManagement_UsesStoredCredentials(t *testing.T) {
+	v := newTestVault(t)
+	require.NoError(t, v.Set("cluster-1", "", vault.Credentials{User: "admin", Password: "secret"}))
+
+	var requests []*anypb.Any
+	srv := NewInfobaseManagementServer(authCapture(&requests), WithCredentialVault(v))
+
+	req := &pb.UpdateInfobaseRequest{
+		ClusterId:  "cluster-1",
+		InfobaseId: "550e8400-e29b-41d4-a716-446655440000",
+	}
+	_, err := srv.UpdateInfobase(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, "admin", req.GetClusterUser())
+	assert.Equal(t, "secret", req.GetClusterPassword())
+
+	require.Len(t, requests, 2)
+	var cluster messagesv1.ClusterAuthenticateRequest
+	require.NoError(t, requests[0].UnmarshalTo(&cluster))
+	assert.Equal(t, "admin", cluster.GetUser())
+}
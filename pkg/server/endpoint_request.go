@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// endpointRequest sends a RAS message through the endpoint of ctx and
+// decodes the answer into resp, the same way the generated clientv1
+// services do for the upstream messages
+func (s *rasClientServiceServer) endpointRequest(ctx context.Context, request, resp proto.Message) error {
+	return s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+		return sendEndpointRequest(ctx, endpoint, request, resp)
+	})
+}
+
+// sendEndpointRequest sends request through endpoint, resp receives the answer
+func sendEndpointRequest(ctx context.Context, endpoint clientv1.EndpointServiceImpl, request, resp proto.Message) error {
+
+	anyRequest, err := anypb.New(request)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal request: %v", err)
+	}
+	anyRespond, err := anypb.New(resp)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to create response template: %v", err)
+	}
+
+	response, err := endpoint.Request(ctx, &clientv1.EndpointRequest{
+		Request: anyRequest,
+		Respond: anyRespond,
+	})
+	if err != nil {
+		return err
+	}
+	return anypb.UnmarshalTo(response, resp, proto.UnmarshalOptions{})
+}
+
+// requireIDs checks the pairs of field name and value, all values are required
+func requireIDs(pairs ...string) error {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if pairs[i+1] == "" {
+			return status.Errorf(codes.InvalidArgument, "%s is required", pairs[i])
+		}
+	}
+	return nil
+}
+
+// authenticatedEndpoint is an endpoint remembering its authentication, see client.Endpoint
+type authenticatedEndpoint interface {
+	Authenticated(request *anypb.Any) bool
+}
+
+// sendAuthentication sends a cluster or infobase authentication message
+// through endpoint unless the endpoint is already authenticated with it
+func sendAuthentication(ctx context.Context, endpoint clientv1.EndpointServiceImpl, msg proto.Message) error {
+
+	anyRequest, err := anypb.New(msg)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to marshal authentication request")
+	}
+	if cache, ok := endpoint.(authenticatedEndpoint); ok && cache.Authenticated(anyRequest) {
+		return nil
+	}
+
+	anyRespond, err := anypb.New(&emptypb.Empty{})
+	if err != nil {
+		return status.Error(codes.Internal, "failed to create response template")
+	}
+
+	_, err = endpoint.Request(ctx, &clientv1.EndpointRequest{
+		Request: anyRequest,
+		Respond: anyRespond,
+	})
+	return err
+}
@@ -3,17 +3,32 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/interceptor"
 	"github.com/v8platform/ras-grpc-gw/pkg/logger"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// terminateSessionMethod is the gRPC method the HTTP terminate route
+// stands for in the policy and the audit log
+const terminateSessionMethod = "/ras.service.api.v1.SessionsService/TerminateSession"
+
 // TerminateSessionHTTPRequest represents HTTP request for terminating a session
 type TerminateSessionHTTPRequest struct {
 	ClusterID string `json:"cluster_id"`
 	SessionID string `json:"session_id"`
+	// InfobaseID optionally names the infobase of the session,
+	// required by policies scoped to infobases
+	InfobaseID string `json:"infobase_id,omitempty"`
 }
 
 // TerminateSessionHTTPResponse represents HTTP response
@@ -25,13 +40,29 @@ type TerminateSessionHTTPResponse struct {
 
 // HandleTerminateSession обрабатывает HTTP запрос на завершение сессии
 // POST /api/v1/sessions/terminate
-// Body: {"cluster_id": "uuid", "session_id": "uuid"}
+// Body: {"cluster_id": "uuid", "session_id": "uuid", "infobase_id": "uuid"}
+// Header: Authorization: Bearer <access token>, when authentication is enabled
 func (s *rasClientServiceServer) HandleTerminateSession(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
+	// HTTP requests bypass the gRPC interceptors, authenticate here
+	authCtx, err := s.authenticateHTTP(r)
+	if err != nil {
+		logger.Log.Warn("HTTP authentication failed",
+			zap.String("operation", r.URL.Path),
+			zap.String("reason", err.Error()),
+		)
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		respondJSON(w, http.StatusUnauthorized, TerminateSessionHTTPResponse{
+			Success: false,
+			Error:   err.Error(),
+		})
+		return
+	}
+
 	// Parse request body
 	var req TerminateSessionHTTPRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -53,23 +84,35 @@ func (s *rasClientServiceServer) HandleTerminateSession(w http.ResponseWriter, r
 	}
 
 	// Create context with timeout
-	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(authCtx, 10*time.Second)
 	defer cancel()
 
+	// Route by cluster the way RouteInterceptor does for gRPC
+	ctx = withRouteCluster(ctx, req.ClusterID)
+
 	// Call gRPC method
 	terminateReq := &TerminateSessionRequest{
 		ClusterId: req.ClusterID,
 		SessionId: req.SessionID,
 	}
 
-	_, err := s.TerminateSession(ctx, terminateReq)
+	// Check the policy and audit the way the gRPC interceptors do
+	start := time.Now()
+	err = s.authorizeHTTP(ctx, req)
+	if err == nil {
+		_, err = s.TerminateSession(ctx, terminateReq)
+	}
+	interceptor.AuditOperation(ctx, logger.Log, terminateSessionMethod, req.ClusterID, req.InfobaseID, err, time.Since(start))
+
 	if err != nil {
-		logger.Log.Error("TerminateSession HTTP handler failed",
-			zap.String("cluster_id", req.ClusterID),
-			zap.String("session_id", req.SessionID),
-			zap.Error(err))
+		if status.Code(err) != codes.PermissionDenied {
+			logger.Log.Error("TerminateSession HTTP handler failed",
+				zap.String("cluster_id", req.ClusterID),
+				zap.String("session_id", req.SessionID),
+				zap.Error(err))
+		}
 
-		respondJSON(w, http.StatusInternalServerError, TerminateSessionHTTPResponse{
+		respondJSON(w, httpStatusCode(err), TerminateSessionHTTPResponse{
 			Success: false,
 			Error:   err.Error(),
 		})
@@ -83,6 +126,82 @@ func (s *rasClientServiceServer) HandleTerminateSession(w http.ResponseWriter, r
 	})
 }
 
+// authenticateHTTP resolves the caller by the "Authorization: Bearer <token>"
+// header and stores the identity in the context, see interceptor.AuthInterceptor.
+// Without a token validator authentication is disabled.
+func (s *rasClientServiceServer) authenticateHTTP(r *http.Request) (context.Context, error) {
+	if s.tokens == nil {
+		return r.Context(), nil
+	}
+
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	token = strings.TrimSpace(token)
+	if !found || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, errors.New("missing bearer token in Authorization header")
+	}
+
+	id, err := s.tokens.Identify(r.Context(), token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token: %w", err)
+	}
+
+	return auth.NewContext(r.Context(), id), nil
+}
+
+// authorizeHTTP checks the caller stored by authenticateHTTP against the
+// policy, see interceptor.AuthorizeInterceptor. A named infobase must be
+// the one of the session, policies scoped to infobases rely on it.
+func (s *rasClientServiceServer) authorizeHTTP(ctx context.Context, req TerminateSessionHTTPRequest) error {
+	if s.policy == nil {
+		return nil
+	}
+
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "caller is not authenticated")
+	}
+
+	if !s.policy.Allowed(id.Roles, auth.Request{
+		Method:     terminateSessionMethod,
+		ClusterID:  req.ClusterID,
+		InfobaseID: req.InfobaseID,
+	}) {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id.Subject, terminateSessionMethod)
+	}
+
+	if req.InfobaseID == "" {
+		return nil
+	}
+
+	resp, err := s.GetSession(ctx, &cluster_service.GetSessionRequest{
+		ClusterId: req.ClusterID,
+		SessionId: req.SessionID,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetSession().GetInfobaseId() != req.InfobaseID {
+		return status.Errorf(codes.PermissionDenied, "session %s does not belong to infobase %s", req.SessionID, req.InfobaseID)
+	}
+
+	return nil
+}
+
+// httpStatusCode converts the gRPC status of err to an HTTP status code
+func httpStatusCode(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
 // respondJSON writes JSON response
 func respondJSON(w http.ResponseWriter, statusCode int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
+	"github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// newTerminateHTTPServer serves HandleTerminateSession over the sessions
+// of testSessions, counting the terminated ones
+func newTerminateHTTPServer(terminated *int) *rasClientServiceServer {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			if info, ok := msg.(*cluster_service.GetSessionInfoRequest); ok {
+				for _, session := range testSessions(time.Now()) {
+					if session.GetUuid() == info.GetSessionId() {
+						return anypb.New(&cluster_service.GetSessionInfoResponse{Info: session})
+					}
+				}
+				return anypb.New(&cluster_service.GetSessionInfoResponse{})
+			}
+			*terminated++
+			return req.Respond, nil
+		},
+	}
+	return newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+}
+
+func terminateHTTP(srv *rasClientServiceServer, token string, req TerminateSessionHTTPRequest) (*httptest.ResponseRecorder, TerminateSessionHTTPResponse) {
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/sessions/terminate", strings.NewReader(string(body)))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	srv.HandleTerminateSession(w, r)
+
+	var resp TerminateSessionHTTPResponse
+	_ = json.Unmarshal(w.Body.Bytes(), &resp)
+	return w, resp
+}
+
+func accessToken(t *testing.T, access AccessServer, user string) string {
+	t.Helper()
+	resp, err := access.GetToken(context.Background(), &service.GetTokenRequest{User: user, Password: "secret"})
+	require.NoError(t, err)
+	return resp.GetAccessToken()
+}
+
+func TestHandleTerminateSession_Authentication(t *testing.T) {
+	var terminated int
+	srv := newTerminateHTTPServer(&terminated)
+	access := newTokenTestServer(t)
+	srv.tokens = access
+	req := TerminateSessionHTTPRequest{ClusterID: testClusterID, SessionID: "s-1"}
+
+	w, resp := terminateHTTP(srv, "", req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+	assert.False(t, resp.Success)
+
+	w, _ = terminateHTTP(srv, "forged", req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Zero(t, terminated, "unauthenticated requests never reach RAS")
+
+	w, resp = terminateHTTP(srv, accessToken(t, access, "admin"), req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, resp.Success)
+	assert.Equal(t, 1, terminated)
+}
+
+func TestHandleTerminateSession_AuthenticationDisabled(t *testing.T) {
+	var terminated int
+	srv := newTerminateHTTPServer(&terminated)
+
+	w, _ := terminateHTTP(srv, "", TerminateSessionHTTPRequest{ClusterID: testClusterID, SessionID: "s-1"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, terminated)
+}
+
+func TestHandleTerminateSession_Authorization(t *testing.T) {
+	defer func(l *zap.Logger) { logger.Log = l }(logger.Log)
+	var logs *observer.ObservedLogs
+	logger.Log, logs = createTestLogger()
+
+	var terminated int
+	srv := newTerminateHTTPServer(&terminated)
+	access := newTokenTestServer(t, auth.RoleViewer, auth.RoleOperator, "infobase-operator")
+	srv.tokens = access
+	policy := auth.DefaultPolicy()
+	policy.Roles["infobase-operator"] = auth.Role{Rules: []auth.Rule{{
+		Methods:   []string{"*/TerminateSession"},
+		Infobases: []string{testInfobaseID},
+	}}}
+	srv.policy = policy
+
+	req := TerminateSessionHTTPRequest{ClusterID: testClusterID, SessionID: "s-1"}
+
+	w, resp := terminateHTTP(srv, accessToken(t, access, auth.RoleViewer), req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.False(t, resp.Success)
+	assert.Zero(t, terminated)
+
+	denied := logs.FilterMessage("gRPC operation denied").All()
+	require.Len(t, denied, 1, "denied requests are audited")
+	assert.Equal(t, terminateSessionMethod, denied[0].ContextMap()["operation"])
+	assert.Equal(t, auth.RoleViewer, denied[0].ContextMap()["user"])
+	assert.Equal(t, testClusterID, denied[0].ContextMap()["cluster_id"])
+
+	w, _ = terminateHTTP(srv, accessToken(t, access, auth.RoleOperator), req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, terminated)
+
+	// Roles scoped to infobases need the infobase of the session
+	ibOperator := accessToken(t, access, "infobase-operator")
+	w, _ = terminateHTTP(srv, ibOperator, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w, _ = terminateHTTP(srv, ibOperator, TerminateSessionHTTPRequest{ClusterID: testClusterID, SessionID: "s-3", InfobaseID: testInfobaseID})
+	assert.Equal(t, http.StatusForbidden, w.Code, "s-3 belongs to another infobase")
+
+	w, _ = terminateHTTP(srv, ibOperator, TerminateSessionHTTPRequest{ClusterID: testClusterID, SessionID: "s-1", InfobaseID: testInfobaseID})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 2, terminated)
+	assert.Equal(t, 3, logs.FilterMessage("gRPC operation denied").Len())
+}
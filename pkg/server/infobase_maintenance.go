@@ -0,0 +1,466 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultMaintenanceGracePeriod   = time.Minute
+	maxMaintenanceGracePeriod       = time.Hour
+	defaultMaintenanceDrainInterval = 2 * time.Second
+)
+
+// ==================== MAINTENANCE OPERATIONS ====================
+
+// BeginMaintenance переводит информационную базу в режим обслуживания:
+// запрещает новые сеансы, ждет завершения сеансов grace_period, завершает
+// оставшиеся (кроме исключенных) и блокирует регламентные задания.
+//
+// Состояние блокировки до обслуживания хранится шлюзом до EndMaintenance,
+// если задан SCHEDULED_LOCKS_FILE - в этом файле, и переживает перезапуск.
+// При ошибке после блокировки база остается заблокированной, снять
+// блокировку с восстановлением состояния можно через EndMaintenance.
+func (s *InfobaseManagementServer) BeginMaintenance(
+	req *pb.BeginMaintenanceRequest,
+	stream pb.InfobaseManagementService_BeginMaintenanceServer,
+) error {
+	if err := s.validateClusterId(req.ClusterId); err != nil {
+		return err
+	}
+	if err := s.validateInfobaseId(req.InfobaseId); err != nil {
+		return err
+	}
+
+	grace := defaultMaintenanceGracePeriod
+	if req.GracePeriod != nil {
+		if err := req.GracePeriod.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid grace_period: %v", err)
+		}
+		grace = req.GracePeriod.AsDuration()
+		if grace < 0 || grace > maxMaintenanceGracePeriod {
+			return status.Errorf(codes.InvalidArgument, "grace_period must be between 0 and %s", maxMaintenanceGracePeriod)
+		}
+	}
+
+	req.ClusterUser, req.ClusterPassword = s.clusterCredentials(req.ClusterId, req.ClusterUser, req.ClusterPassword)
+	req.InfobaseUser, req.InfobasePassword = s.infobaseCredentials(req.ClusterId, req.InfobaseId, req.InfobaseUser, req.InfobasePassword)
+
+	s.logger.Info("BeginMaintenance request",
+		zap.String("cluster_id", req.ClusterId),
+		zap.String("infobase_id", req.InfobaseId),
+		zap.Duration("grace_period", grace),
+		zap.Strings("exclude_user_names", req.ExcludeUserNames),
+		zap.Strings("exclude_app_ids", req.ExcludeAppIds),
+	)
+
+	key := maintenanceKey(req.ClusterId, req.InfobaseId)
+	if !s.reserveMaintenance(key) {
+		return status.Errorf(codes.FailedPrecondition, "maintenance of infobase '%s' is already in progress", req.InfobaseId)
+	}
+	// До конца BeginMaintenance EndMaintenance отклоняется, состояние для него
+	// сохраняется, когда база готова или при ошибке после блокировки
+	var previous *pb.InfobaseLockState
+	locked := false
+	defer func() {
+		if locked {
+			s.saveMaintenance(key, previous)
+		} else {
+			s.releaseMaintenance(key)
+		}
+	}()
+
+	ctx := stream.Context()
+	send := func(progress *pb.MaintenanceProgress) error {
+		progress.At = timestamppb.Now()
+		return stream.Send(progress)
+	}
+
+	// 1. Сохранить текущее состояние блокировки
+	previous, err := s.beginMaintenanceState(ctx, req)
+	if err != nil {
+		return err
+	}
+	if s.scheduledLocks != nil {
+		defer func() {
+			if locked {
+				return
+			}
+			if _, err := s.scheduledLocks.EndMaintenance(context.WithoutCancel(ctx), req.ClusterId, req.InfobaseId, nil); err != nil {
+				s.logger.Error("Failed to remove saved maintenance state",
+					zap.String("infobase_id", req.InfobaseId),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+	if err := send(&pb.MaintenanceProgress{
+		Stage:         pb.MaintenanceStage_MAINTENANCE_STAGE_STATE_SAVED,
+		Message:       "Infobase lock state saved",
+		PreviousState: previous,
+	}); err != nil {
+		return err
+	}
+
+	// 2. Запретить новые сеансы, регламентные задания пока как были
+	if _, err := s.UpdateInfobase(ctx, s.maintenanceLock(req, previous.GetScheduledJobsDeny())); err != nil {
+		return err
+	}
+	locked = true
+	if err := send(&pb.MaintenanceProgress{
+		Stage:   pb.MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_LOCKED,
+		Message: "New sessions are denied",
+	}); err != nil {
+		return err
+	}
+
+	endpoint, release, err := s.getEndpoint(ctx)
+	if err != nil {
+		return s.mapRASError(err)
+	}
+	defer release()
+	if err := s.authenticate(ctx, endpoint, req.ClusterId,
+		req.GetClusterUser(), req.GetClusterPassword(), "", "",
+	); err != nil {
+		return err
+	}
+
+	// 3. Дождаться завершения сеансов
+	remaining, err := s.drainSessions(ctx, endpoint, req, grace, send)
+	if err != nil {
+		return err
+	}
+
+	// 4. Завершить оставшиеся сеансы
+	terminated := s.terminateMaintenanceSessions(ctx, endpoint, req, remaining)
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	if err := send(terminated); err != nil {
+		return err
+	}
+
+	// 5. Заблокировать регламентные задания
+	if _, err := s.UpdateInfobase(ctx, s.maintenanceLock(req, true)); err != nil {
+		return err
+	}
+	if err := send(&pb.MaintenanceProgress{
+		Stage:   pb.MaintenanceStage_MAINTENANCE_STAGE_SCHEDULED_JOBS_LOCKED,
+		Message: "Scheduled jobs are denied",
+	}); err != nil {
+		return err
+	}
+
+	message := "Infobase is ready for maintenance"
+	if terminated.FailedSessions > 0 {
+		message = fmt.Sprintf("Infobase is locked, %d sessions could not be terminated", terminated.FailedSessions)
+	}
+
+	s.logger.Info("Infobase maintenance started",
+		zap.String("cluster_id", req.ClusterId),
+		zap.String("infobase_id", req.InfobaseId),
+		zap.Int32("terminated_sessions", terminated.TerminatedSessions),
+		zap.Int32("failed_sessions", terminated.FailedSessions),
+	)
+
+	return send(&pb.MaintenanceProgress{
+		Stage:             pb.MaintenanceStage_MAINTENANCE_STAGE_READY,
+		Message:           message,
+		RemainingSessions: terminated.FailedSessions,
+		PreviousState:     previous,
+	})
+}
+
+// EndMaintenance восстанавливает состояние блокировки информационной базы,
+// сохраненное BeginMaintenance. Если шлюз его не хранит (например, после
+// перезапуска без SCHEDULED_LOCKS_FILE), восстанавливается переданное restore_state.
+func (s *InfobaseManagementServer) EndMaintenance(
+	ctx context.Context,
+	req *pb.EndMaintenanceRequest,
+) (*pb.EndMaintenanceResponse, error) {
+	if err := s.validateClusterId(req.ClusterId); err != nil {
+		return nil, err
+	}
+	if err := s.validateInfobaseId(req.InfobaseId); err != nil {
+		return nil, err
+	}
+
+	key := maintenanceKey(req.ClusterId, req.InfobaseId)
+	s.mu.Lock()
+	state, inProgress := s.maintenance[key]
+	s.mu.Unlock()
+
+	if inProgress && state == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "maintenance of infobase '%s' is still starting, BeginMaintenance is running", req.InfobaseId)
+	}
+	saved, persisted := s.scheduledLocks.Maintenance(req.ClusterId, req.InfobaseId)
+	if persisted {
+		state = infobaseLockStateOf(saved.Previous)
+	}
+	if state == nil {
+		state = req.RestoreState
+	}
+	if state == nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"no maintenance of infobase '%s' in progress, restore_state is required", req.InfobaseId)
+	}
+
+	s.logger.Info("EndMaintenance request",
+		zap.String("cluster_id", req.ClusterId),
+		zap.String("infobase_id", req.InfobaseId),
+		zap.Bool("stored_state", inProgress || persisted),
+		zap.Bool("sessions_deny", state.GetSessionsDeny()),
+		zap.Bool("scheduled_jobs_deny", state.GetScheduledJobsDeny()),
+	)
+
+	restore := func(ctx context.Context, state *pb.InfobaseLockState) error {
+		update := lockStateUpdate(req.ClusterId, req.InfobaseId, state)
+		update.ClusterUser, update.ClusterPassword = req.ClusterUser, req.ClusterPassword
+		update.InfobaseUser, update.InfobasePassword = req.InfobaseUser, req.InfobasePassword
+
+		_, err := s.UpdateInfobase(ctx, update)
+		return err
+	}
+
+	if persisted {
+		restored, err := s.scheduledLocks.EndMaintenance(ctx, req.ClusterId, req.InfobaseId,
+			func(ctx context.Context, state schedule.LockState) error {
+				return restore(ctx, infobaseLockStateOf(state))
+			})
+		if err != nil {
+			return nil, scheduleError(err)
+		}
+		state = infobaseLockStateOf(restored)
+	} else if err := restore(ctx, state); err != nil {
+		return nil, err
+	}
+
+	s.releaseMaintenance(key)
+
+	return &pb.EndMaintenanceResponse{
+		InfobaseId:    req.InfobaseId,
+		RestoredState: state,
+		Message:       "Infobase lock state restored",
+		Success:       true,
+	}, nil
+}
+
+// beginMaintenanceState reads the lock state restored by EndMaintenance,
+// it is saved with the scheduled locks if they are enabled
+func (s *InfobaseManagementServer) beginMaintenanceState(
+	ctx context.Context,
+	req *pb.BeginMaintenanceRequest,
+) (*pb.InfobaseLockState, error) {
+	current := func(ctx context.Context) (*pb.InfobaseLockState, error) {
+		info, err := s.GetInfobase(ctx, &pb.GetInfobaseRequest{
+			ClusterId:        req.ClusterId,
+			InfobaseId:       req.InfobaseId,
+			ClusterUser:      req.ClusterUser,
+			ClusterPassword:  req.ClusterPassword,
+			InfobaseUser:     req.InfobaseUser,
+			InfobasePassword: req.InfobasePassword,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return infobaseLockState(info.GetInfobase()), nil
+	}
+
+	if s.scheduledLocks == nil {
+		return current(ctx)
+	}
+
+	mt, err := s.scheduledLocks.BeginMaintenance(ctx, req.ClusterId, req.InfobaseId,
+		func(ctx context.Context) (schedule.LockState, error) {
+			state, err := current(ctx)
+			if err != nil {
+				return schedule.LockState{}, err
+			}
+			return scheduleLockState(state), nil
+		})
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	return infobaseLockStateOf(mt.Previous), nil
+}
+
+// maintenanceLock builds the update denying new sessions with the
+// message and permission code of the maintenance request
+func (s *InfobaseManagementServer) maintenanceLock(req *pb.BeginMaintenanceRequest, scheduledJobsDeny bool) *pb.UpdateInfobaseRequest {
+	return &pb.UpdateInfobaseRequest{
+		ClusterId:         req.ClusterId,
+		InfobaseId:        req.InfobaseId,
+		SessionsDeny:      proto.Bool(true),
+		DeniedMessage:     proto.String(req.DeniedMessage),
+		PermissionCode:    proto.String(req.PermissionCode),
+		ScheduledJobsDeny: proto.Bool(scheduledJobsDeny),
+		ClusterUser:       req.ClusterUser,
+		ClusterPassword:   req.ClusterPassword,
+		InfobaseUser:      req.InfobaseUser,
+		InfobasePassword:  req.InfobasePassword,
+	}
+}
+
+// drainSessions polls the infobase sessions until none but the excluded
+// are left or the grace period ends, and returns the sessions left.
+// Progress is sent whenever the number of sessions changes.
+func (s *InfobaseManagementServer) drainSessions(
+	ctx context.Context,
+	endpoint clientv1.EndpointServiceImpl,
+	req *pb.BeginMaintenanceRequest,
+	grace time.Duration,
+	send func(*pb.MaintenanceProgress) error,
+) ([]*serializev1.SessionInfo, error) {
+	interval := s.drainInterval
+	if interval <= 0 {
+		interval = defaultMaintenanceDrainInterval
+	}
+	deadline := time.Now().Add(grace)
+
+	reported := -1
+	for {
+		resp, err := clientv1.NewInfobasesService(endpoint).GetSessions(ctx, &messagesv1.GetInfobaseSessionsRequest{
+			ClusterId:  req.ClusterId,
+			InfobaseId: req.InfobaseId,
+		})
+		if err != nil {
+			s.logger.Error("Failed to get infobase sessions via RAS",
+				zap.String("cluster_id", req.ClusterId),
+				zap.String("infobase_id", req.InfobaseId),
+				zap.Error(err),
+			)
+			return nil, s.mapRASError(err)
+		}
+
+		// Сеансы базы в ответе RAS лежат в поле infobases
+		var sessions []*serializev1.SessionInfo
+		for _, session := range resp.GetInfobases() {
+			if !containsFold(req.ExcludeUserNames, session.GetUserName()) && !containsFold(req.ExcludeAppIds, session.GetAppId()) {
+				sessions = append(sessions, session)
+			}
+		}
+
+		if len(sessions) != reported {
+			reported = len(sessions)
+			if err := send(&pb.MaintenanceProgress{
+				Stage:             pb.MaintenanceStage_MAINTENANCE_STAGE_DRAINING,
+				Message:           fmt.Sprintf("Waiting for %d sessions to end", len(sessions)),
+				RemainingSessions: int32(len(sessions)),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		wait := time.Until(deadline)
+		if len(sessions) == 0 || wait <= 0 {
+			return sessions, nil
+		}
+		if wait > interval {
+			wait = interval
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// terminateMaintenanceSessions terminates the sessions left after the
+// grace period, a failed session does not stop the others
+func (s *InfobaseManagementServer) terminateMaintenanceSessions(
+	ctx context.Context,
+	endpoint clientv1.EndpointServiceImpl,
+	req *pb.BeginMaintenanceRequest,
+	sessions []*serializev1.SessionInfo,
+) *pb.MaintenanceProgress {
+	message := req.TerminateMessage
+	if message == "" {
+		message = req.DeniedMessage
+	}
+
+	progress := &pb.MaintenanceProgress{Stage: pb.MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_TERMINATED}
+	for _, session := range sessions {
+		err := sendEndpointRequest(ctx, endpoint, &cluster_service.TerminateSessionRequest{
+			ClusterId: req.ClusterId,
+			SessionId: session.GetUuid(),
+			Message:   message,
+		}, &emptypb.Empty{})
+		if err != nil {
+			s.logger.Error("Failed to terminate session via RAS",
+				zap.String("cluster_id", req.ClusterId),
+				zap.String("session_id", session.GetUuid()),
+				zap.Error(err),
+			)
+			progress.FailedSessions++
+			progress.Errors = append(progress.Errors, fmt.Sprintf("session %s (%s): %v", session.GetUuid(), session.GetUserName(), err))
+			continue
+		}
+		progress.TerminatedSessions++
+	}
+	progress.Message = fmt.Sprintf("Terminated %d of %d sessions", progress.TerminatedSessions, len(sessions))
+
+	return progress
+}
+
+func maintenanceKey(clusterID, infobaseID string) string {
+	return clusterID + "/" + infobaseID
+}
+
+// reserveMaintenance marks the maintenance of key as starting until
+// saveMaintenance, false if it is already in progress
+func (s *InfobaseManagementServer) reserveMaintenance(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.maintenance[key]; ok {
+		return false
+	}
+	if s.maintenance == nil {
+		s.maintenance = make(map[string]*pb.InfobaseLockState)
+	}
+	s.maintenance[key] = nil
+	return true
+}
+
+func (s *InfobaseManagementServer) saveMaintenance(key string, state *pb.InfobaseLockState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maintenance[key] = state
+}
+
+func (s *InfobaseManagementServer) releaseMaintenance(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.maintenance, key)
+}
+
+// infobaseLockState extracts the lock settings restored by EndMaintenance
+func infobaseLockState(info *pb.InfobaseDetails) *pb.InfobaseLockState {
+	return &pb.InfobaseLockState{
+		SessionsDeny:      info.GetSessionsDeny(),
+		DeniedFrom:        info.GetDeniedFrom(),
+		DeniedTo:          info.GetDeniedTo(),
+		DeniedMessage:     info.GetDeniedMessage(),
+		PermissionCode:    info.GetPermissionCode(),
+		ScheduledJobsDeny: info.GetScheduledJobsDeny(),
+	}
+}
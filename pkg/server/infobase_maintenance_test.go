@@ -0,0 +1,351 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// maintenanceEndpoint serves an infobase whose sessions follow the
+// snapshots in order, repeating the last one
+type maintenanceEndpoint struct {
+	recordingEndpoint
+	mu         sync.Mutex
+	info       *serializev1.InfobaseInfo
+	snapshots  [][]*serializev1.SessionInfo
+	polls      int
+	terminated []*cluster_service.TerminateSessionRequest
+	updates    []*serializev1.InfobaseInfo
+	failed     map[string]bool // sessions failing to terminate
+}
+
+func (e *maintenanceEndpoint) Request(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if _, err := e.recordingEndpoint.Request(ctx, req); err != nil {
+		return nil, err
+	}
+	msg, err := req.Request.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	switch msg := msg.(type) {
+	case *cluster_service.GetInfobaseInfoRequest:
+		return anypb.New(&cluster_service.GetInfobaseInfoResponse{Info: e.info})
+	case *messagesv1.GetInfobaseSessionsRequest:
+		i := e.polls
+		if i >= len(e.snapshots) {
+			i = len(e.snapshots) - 1
+		}
+		e.polls++
+		return anypb.New(&messagesv1.GetInfobaseSessionsResponse{Infobases: e.snapshots[i]})
+	case *cluster_service.TerminateSessionRequest:
+		if e.failed[msg.GetSessionId()] {
+			return nil, errors.New("session is busy")
+		}
+		e.terminated = append(e.terminated, msg)
+	case *serializev1.InfobaseInfo:
+		e.updates = append(e.updates, msg)
+	}
+	return req.Respond, nil
+}
+
+// maintenanceStream collects the progress of a BeginMaintenance stream
+type maintenanceStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	progress []*pb.MaintenanceProgress
+	onSend   func(*pb.MaintenanceProgress) // Called for every progress sent, if set
+}
+
+func (s *maintenanceStream) Context() context.Context { return s.ctx }
+
+func (s *maintenanceStream) Send(progress *pb.MaintenanceProgress) error {
+	s.progress = append(s.progress, progress)
+	if s.onSend != nil {
+		s.onSend(progress)
+	}
+	return nil
+}
+
+func (s *maintenanceStream) stages() []pb.MaintenanceStage {
+	var stages []pb.MaintenanceStage
+	for _, p := range s.progress {
+		stages = append(stages, p.GetStage())
+	}
+	return stages
+}
+
+func TestBeginEndMaintenance(t *testing.T) {
+	endpoint := &maintenanceEndpoint{
+		info: &serializev1.InfobaseInfo{
+			Uuid:           testInfobaseID,
+			SessionsDeny:   true,
+			DeniedMessage:  "Ночной регламент",
+			PermissionCode: "night",
+		},
+		snapshots: [][]*serializev1.SessionInfo{
+			{
+				{Uuid: "s-1", UserName: "Иванов", AppId: "1CV8C"},
+				{Uuid: "s-2", UserName: "svc-update", AppId: "1CV8C"},
+				{Uuid: "s-3", UserName: "Петров", AppId: "Designer"},
+				{Uuid: "s-4", UserName: "Сидоров", AppId: "WebClient"},
+			},
+			{
+				{Uuid: "s-1", UserName: "Иванов", AppId: "1CV8C"},
+				{Uuid: "s-2", UserName: "svc-update", AppId: "1CV8C"},
+				{Uuid: "s-3", UserName: "Петров", AppId: "Designer"},
+			},
+		},
+	}
+	server := newRecordingServer(endpoint)
+	server.drainInterval = 5 * time.Millisecond
+
+	stream := &maintenanceStream{ctx: context.Background()}
+	err := server.BeginMaintenance(&pb.BeginMaintenanceRequest{
+		ClusterId:        testClusterID,
+		InfobaseId:       testInfobaseID,
+		DeniedMessage:    "Обновление конфигурации",
+		PermissionCode:   "update",
+		GracePeriod:      durationpb.New(30 * time.Millisecond),
+		ExcludeUserNames: []string{"SVC-UPDATE"},
+		ExcludeAppIds:    []string{"Designer"},
+		TerminateMessage: "Сеанс завершен для обновления",
+	}, stream)
+	require.NoError(t, err)
+
+	assert.Equal(t, []pb.MaintenanceStage{
+		pb.MaintenanceStage_MAINTENANCE_STAGE_STATE_SAVED,
+		pb.MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_LOCKED,
+		pb.MaintenanceStage_MAINTENANCE_STAGE_DRAINING,
+		pb.MaintenanceStage_MAINTENANCE_STAGE_DRAINING,
+		pb.MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_TERMINATED,
+		pb.MaintenanceStage_MAINTENANCE_STAGE_SCHEDULED_JOBS_LOCKED,
+		pb.MaintenanceStage_MAINTENANCE_STAGE_READY,
+	}, stream.stages())
+	assert.Equal(t, int32(2), stream.progress[2].GetRemainingSessions())
+	assert.Equal(t, int32(1), stream.progress[3].GetRemainingSessions())
+	assert.Equal(t, int32(1), stream.progress[4].GetTerminatedSessions())
+
+	require.Len(t, endpoint.terminated, 1, "only the session outliving the grace period is terminated")
+	assert.Equal(t, "s-1", endpoint.terminated[0].GetSessionId())
+	assert.Equal(t, "Сеанс завершен для обновления", endpoint.terminated[0].GetMessage())
+
+	require.Len(t, endpoint.updates, 2)
+	assert.True(t, endpoint.updates[0].GetSessionsDeny())
+	assert.False(t, endpoint.updates[0].GetScheduledJobsDeny(), "scheduled jobs run until the sessions are gone")
+	assert.Equal(t, "update", endpoint.updates[0].GetPermissionCode())
+	assert.True(t, endpoint.updates[1].GetSessionsDeny())
+	assert.True(t, endpoint.updates[1].GetScheduledJobsDeny())
+
+	previous := stream.progress[len(stream.progress)-1].GetPreviousState()
+	assert.True(t, previous.GetSessionsDeny())
+	assert.Equal(t, "night", previous.GetPermissionCode())
+
+	err = server.BeginMaintenance(&pb.BeginMaintenanceRequest{ClusterId: testClusterID, InfobaseId: testInfobaseID}, stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err := server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+		ClusterId:  testClusterID,
+		InfobaseId: testInfobaseID,
+	})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(previous, resp.GetRestoredState()))
+
+	restored := endpoint.updates[2]
+	assert.True(t, restored.GetSessionsDeny(), "the lock set before maintenance stays")
+	assert.Equal(t, "Ночной регламент", restored.GetDeniedMessage())
+	assert.Equal(t, "night", restored.GetPermissionCode())
+	assert.False(t, restored.GetScheduledJobsDeny())
+
+	_, err = server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+		ClusterId:  testClusterID,
+		InfobaseId: testInfobaseID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the state is restored once")
+
+	_, err = server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+		ClusterId:    testClusterID,
+		InfobaseId:   testInfobaseID,
+		RestoreState: &pb.InfobaseLockState{},
+	})
+	require.NoError(t, err)
+	assert.False(t, endpoint.updates[3].GetSessionsDeny())
+}
+
+func TestEndMaintenance_DuringDrain(t *testing.T) {
+	endpoint := &maintenanceEndpoint{
+		info:      &serializev1.InfobaseInfo{Uuid: testInfobaseID},
+		snapshots: [][]*serializev1.SessionInfo{{{Uuid: "s-1", UserName: "Иванов"}}},
+	}
+	server := newRecordingServer(endpoint)
+	server.drainInterval = 5 * time.Millisecond
+
+	var endErrs []error
+	stream := &maintenanceStream{ctx: context.Background()}
+	stream.onSend = func(progress *pb.MaintenanceProgress) {
+		if progress.GetStage() == pb.MaintenanceStage_MAINTENANCE_STAGE_DRAINING {
+			_, err := server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+				ClusterId:    testClusterID,
+				InfobaseId:   testInfobaseID,
+				RestoreState: &pb.InfobaseLockState{},
+			})
+			endErrs = append(endErrs, err)
+		}
+	}
+	err := server.BeginMaintenance(&pb.BeginMaintenanceRequest{
+		ClusterId:   testClusterID,
+		InfobaseId:  testInfobaseID,
+		GracePeriod: durationpb.New(10 * time.Millisecond),
+	}, stream)
+	require.NoError(t, err)
+
+	require.Len(t, endErrs, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(endErrs[0]), "EndMaintenance waits for BeginMaintenance")
+	require.Len(t, endpoint.updates, 2, "nothing is restored during the drain")
+
+	resp, err := server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+		ClusterId:  testClusterID,
+		InfobaseId: testInfobaseID,
+	})
+	require.NoError(t, err, "the state is kept for EndMaintenance after READY")
+	assert.False(t, resp.GetRestoredState().GetScheduledJobsDeny())
+	require.Len(t, endpoint.updates, 3)
+	assert.False(t, endpoint.updates[2].GetSessionsDeny())
+}
+
+func TestEndMaintenance_AfterRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "locks.json")
+	endpoint := &maintenanceEndpoint{
+		info: &serializev1.InfobaseInfo{
+			Uuid:              testInfobaseID,
+			ScheduledJobsDeny: true,
+		},
+		snapshots: [][]*serializev1.SessionInfo{{}},
+	}
+
+	// newServer starts the gateway over the same scheduled locks file
+	newServer := func() *InfobaseManagementServer {
+		server := newRecordingServer(endpoint)
+		locks, err := schedule.Open(path, scheduledLockApplier{s: server}, zap.NewNop())
+		require.NoError(t, err)
+		server.scheduledLocks = locks
+		return server
+	}
+
+	server := newServer()
+	stream := &maintenanceStream{ctx: context.Background()}
+	err := server.BeginMaintenance(&pb.BeginMaintenanceRequest{
+		ClusterId:   testClusterID,
+		InfobaseId:  testInfobaseID,
+		GracePeriod: durationpb.New(0),
+	}, stream)
+	require.NoError(t, err)
+	require.Len(t, endpoint.updates, 2)
+
+	server = newServer()
+	err = server.BeginMaintenance(&pb.BeginMaintenanceRequest{ClusterId: testClusterID, InfobaseId: testInfobaseID}, stream)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the maintenance survives the restart")
+	require.Len(t, endpoint.updates, 2)
+
+	resp, err := server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+		ClusterId:  testClusterID,
+		InfobaseId: testInfobaseID,
+	})
+	require.NoError(t, err, "restore_state is not needed after a restart")
+	assert.True(t, resp.GetRestoredState().GetScheduledJobsDeny())
+
+	require.Len(t, endpoint.updates, 3)
+	assert.False(t, endpoint.updates[2].GetSessionsDeny())
+	assert.True(t, endpoint.updates[2].GetScheduledJobsDeny(), "the state before maintenance is restored")
+
+	_, err = newServer().EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{
+		ClusterId:  testClusterID,
+		InfobaseId: testInfobaseID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestBeginMaintenance_TerminationFailure(t *testing.T) {
+	endpoint := &maintenanceEndpoint{
+		info: &serializev1.InfobaseInfo{Uuid: testInfobaseID},
+		snapshots: [][]*serializev1.SessionInfo{{
+			{Uuid: "s-1", UserName: "Иванов"},
+			{Uuid: "s-2", UserName: "Петров"},
+		}},
+		failed: map[string]bool{"s-2": true},
+	}
+	server := newRecordingServer(endpoint)
+
+	stream := &maintenanceStream{ctx: context.Background()}
+	err := server.BeginMaintenance(&pb.BeginMaintenanceRequest{
+		ClusterId:     testClusterID,
+		InfobaseId:    testInfobaseID,
+		DeniedMessage: "Обновление",
+		GracePeriod:   durationpb.New(0),
+	}, stream)
+	require.NoError(t, err)
+
+	terminated := stream.progress[3]
+	assert.Equal(t, pb.MaintenanceStage_MAINTENANCE_STAGE_SESSIONS_TERMINATED, terminated.GetStage())
+	assert.Equal(t, int32(1), terminated.GetTerminatedSessions())
+	assert.Equal(t, int32(1), terminated.GetFailedSessions())
+	require.Len(t, terminated.GetErrors(), 1)
+	assert.Contains(t, terminated.GetErrors()[0], "s-2")
+	assert.Equal(t, "Обновление", endpoint.terminated[0].GetMessage(), "denied_message is the default termination message")
+
+	ready := stream.progress[len(stream.progress)-1]
+	assert.Equal(t, pb.MaintenanceStage_MAINTENANCE_STAGE_READY, ready.GetStage())
+	assert.Equal(t, int32(1), ready.GetRemainingSessions())
+}
+
+func TestBeginMaintenance_Validation(t *testing.T) {
+	endpoint := &maintenanceEndpoint{recordingEndpoint: recordingEndpoint{authErr: errors.New("permission denied")}}
+	server := newRecordingServer(endpoint)
+	stream := &maintenanceStream{ctx: context.Background()}
+
+	err := server.BeginMaintenance(&pb.BeginMaintenanceRequest{ClusterId: testClusterID}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = server.BeginMaintenance(&pb.BeginMaintenanceRequest{
+		ClusterId:   testClusterID,
+		InfobaseId:  testInfobaseID,
+		GracePeriod: durationpb.New(2 * time.Hour),
+	}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req := &pb.BeginMaintenanceRequest{
+		ClusterId:   testClusterID,
+		InfobaseId:  testInfobaseID,
+		ClusterUser: proto.String("admin"),
+	}
+	err = server.BeginMaintenance(req, stream)
+	assert.Error(t, err)
+	assert.Empty(t, stream.progress)
+
+	_, err = server.EndMaintenance(context.Background(), &pb.EndMaintenanceRequest{ClusterId: testClusterID, InfobaseId: testInfobaseID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "a failed start keeps no state")
+
+	err = server.BeginMaintenance(req, stream)
+	assert.NotEqual(t, codes.FailedPrecondition, status.Code(err), "a failed start does not block the next one")
+}
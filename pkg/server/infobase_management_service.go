@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
 messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
 	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	"github.com/v8platform/ras-grpc-gw/pkg/client"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
 	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
 	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -25,14 +32,37 @@ type InfobaseManagementServer struct {
 	pb.UnimplementedInfobaseManagementServiceServer
 	logger *zap.Logger
 	client RASClient
+	vault  *vault.Vault // Учетные данные для запросов без них
+
+	drainInterval time.Duration // Интервал опроса сеансов в BeginMaintenance
+
+	mu          sync.Mutex
+	maintenance map[string]*pb.InfobaseLockState // Состояние блокировки до BeginMaintenance
+
+	scheduledLocks *schedule.Manager // Окна блокировки, nil - окна отслеживает только RAS
+}
+
+// InfobaseManagementOption configures the infobase management server
+type InfobaseManagementOption func(*InfobaseManagementServer)
+
+// WithCredentialVault подставляет учетные данные администратора кластера
+// из хранилища, если запрос их не содержит
+func WithCredentialVault(v *vault.Vault) InfobaseManagementOption {
+	return func(s *InfobaseManagementServer) {
+		s.vault = v
+	}
 }
 
 // NewInfobaseManagementServer creates new server instance
-func NewInfobaseManagementServer(client RASClient) *InfobaseManagementServer {
-	return &InfobaseManagementServer{
+func NewInfobaseManagementServer(client RASClient, opts ...InfobaseManagementOption) *InfobaseManagementServer {
+	s := &InfobaseManagementServer{
 		logger: logger.Log,
 		client: client,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // ==================== HELPER METHODS ====================
@@ -233,6 +263,104 @@ func (s *InfobaseManagementServer) mapRASError(err error) error {
 	return status.Error(codes.Internal, fmt.Sprintf("RAS error: %v", err))
 }
 
+// clusterCredentials возвращает учетные данные администратора кластера из запроса,
+// а если пользователь не указан - сохраненные в хранилище
+func (s *InfobaseManagementServer) clusterCredentials(clusterID string, user, password *string) (*string, *string) {
+	if user != nil && *user != "" {
+		return user, password
+	}
+
+	stored, ok := s.vault.Cluster(clusterID)
+	if !ok {
+		return user, password
+	}
+
+	return proto.String(stored.User), proto.String(stored.Password)
+}
+
+// infobaseCredentials возвращает учетные данные администратора информационной базы
+// из запроса, а если пользователь не указан - сохраненные в хранилище
+func (s *InfobaseManagementServer) infobaseCredentials(clusterID, infobaseID string, user, password *string) (*string, *string) {
+	if user != nil && *user != "" {
+		return user, password
+	}
+
+	stored, ok := s.vault.Infobase(clusterID, infobaseID)
+	if !ok {
+		return user, password
+	}
+
+	return proto.String(stored.User), proto.String(stored.Password)
+}
+
+// getEndpoint returns the endpoint of one call, release closes it when the
+// call is done. An endpoint selected by the endpoint_id of the request
+// stays open for the caller.
+func (s *InfobaseManagementServer) getEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, func(), error) {
+	endpoint, err := s.client.GetEndpoint(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := client.EndpointID(endpoint)
+	md, _ := metadata.FromIncomingContext(ctx)
+	closer, ok := s.client.(endpointCloser)
+	if !ok || id == "" || slices.Contains(md["endpoint_id"], id) {
+		return endpoint, func() {}, nil
+	}
+
+	return endpoint, func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+		defer cancel()
+		if err := closer.CloseEndpoint(ctx, id); err != nil {
+			s.logger.Warn("Failed to close RAS endpoint",
+				zap.String("endpoint_id", id),
+				zap.Error(err),
+			)
+		}
+	}, nil
+}
+
+// authenticate аутентифицирует endpoint администратором кластера и, если указан,
+// администратором информационной базы. Пустой пользователь пропускается.
+//
+// Endpoint пула запоминает успешную аутентификацию и повторяет ее сам после
+// переподключения, поэтому повторно те же учетные данные в RAS не отправляются.
+func (s *InfobaseManagementServer) authenticate(
+	ctx context.Context,
+	endpoint clientv1.EndpointServiceImpl,
+	clusterID, clusterUser, clusterPassword, infobaseUser, infobasePassword string,
+) error {
+	var requests []proto.Message
+	if clusterUser != "" {
+		requests = append(requests, &messagesv1.ClusterAuthenticateRequest{
+			ClusterId: clusterID,
+			User:      clusterUser,
+			Password:  clusterPassword,
+		})
+	}
+	if infobaseUser != "" {
+		requests = append(requests, &messagesv1.AuthenticateInfobaseRequest{
+			ClusterId: clusterID,
+			User:      infobaseUser,
+			Password:  infobasePassword,
+		})
+	}
+
+	for _, msg := range requests {
+		if err := sendAuthentication(ctx, endpoint, msg); err != nil {
+			s.logger.Error("RAS authentication failed",
+				zap.String("cluster_id", clusterID),
+				zap.String("request", string(msg.ProtoReflect().Descriptor().Name())),
+				zap.Error(err),
+			)
+			return s.mapRASError(err)
+		}
+	}
+
+	return nil
+}
+
 // sanitizePassword заменяет пароль на маску для логирования
 func sanitizePassword(password string) string {
 	if password == "" {
@@ -257,6 +385,9 @@ func (s *InfobaseManagementServer) UpdateInfobase(
 		return nil, err
 	}
 
+	req.ClusterUser, req.ClusterPassword = s.clusterCredentials(req.ClusterId, req.ClusterUser, req.ClusterPassword)
+	req.InfobaseUser, req.InfobasePassword = s.infobaseCredentials(req.ClusterId, req.InfobaseId, req.InfobaseUser, req.InfobasePassword)
+
 	// Логирование запроса (без паролей!)
 	s.logger.Info("UpdateInfobase request",
 		zap.String("cluster_id", req.ClusterId),
@@ -276,7 +407,7 @@ func (s *InfobaseManagementServer) UpdateInfobase(
 	}
 
 	// 1. Получить endpoint от RAS client
-	endpoint, err := s.client.GetEndpoint(ctx)
+	endpoint, release, err := s.getEndpoint(ctx)
 	if err != nil {
 		s.logger.Error("Failed to get RAS endpoint",
 			zap.String("cluster_id", req.ClusterId),
@@ -285,6 +416,15 @@ func (s *InfobaseManagementServer) UpdateInfobase(
 		)
 		return nil, s.mapRASError(err)
 	}
+	defer release()
+
+	// Аутентификация на endpoint (RAS отклоняет изменения без нее, если в кластере есть администраторы)
+	if err := s.authenticate(ctx, endpoint, req.ClusterId,
+		req.GetClusterUser(), req.GetClusterPassword(),
+		req.GetInfobaseUser(), req.GetInfobasePassword(),
+	); err != nil {
+		return nil, err
+	}
 
 	// 2. Построить InfobaseInfo для обновления
 	//    ВАЖНО: Только измененные поля! (partial update)
@@ -333,6 +473,12 @@ func (s *InfobaseManagementServer) UpdateInfobase(
 	if req.SecurityLevel != nil {
 		infobaseInfo.SecurityLevel = mapSecurityLevelToInt(*req.SecurityLevel)
 	}
+	if req.SecurityProfileName != nil {
+		infobaseInfo.SecurityProfileName = *req.SecurityProfileName
+	}
+	if req.SafeModeSecurityProfileName != nil {
+		infobaseInfo.SafeModeSecurityProfileName = *req.SafeModeSecurityProfileName
+	}
 
 	// 3. Упаковать в EndpointRequest
 	anyRequest, err := anypb.New(infobaseInfo)
@@ -417,6 +563,8 @@ func (s *InfobaseManagementServer) CreateInfobase(
 		}
 	}
 
+	req.ClusterUser, req.ClusterPassword = s.clusterCredentials(req.ClusterId, req.ClusterUser, req.ClusterPassword)
+
 	// Логирование запроса (без паролей!)
 	s.logger.Info("CreateInfobase request",
 		zap.String("cluster_id", req.ClusterId),
@@ -438,7 +586,7 @@ func (s *InfobaseManagementServer) CreateInfobase(
 	}
 
 	// 1. Получить endpoint от RAS client
-	endpoint, err := s.client.GetEndpoint(ctx)
+	endpoint, release, err := s.getEndpoint(ctx)
 	if err != nil {
 		s.logger.Error("Failed to get RAS endpoint",
 			zap.String("cluster_id", req.ClusterId),
@@ -446,6 +594,14 @@ func (s *InfobaseManagementServer) CreateInfobase(
 		)
 		return nil, s.mapRASError(err)
 	}
+	defer release()
+
+	// Аутентификация на endpoint
+	if err := s.authenticate(ctx, endpoint, req.ClusterId,
+		req.GetClusterUser(), req.GetClusterPassword(), "", "",
+	); err != nil {
+		return nil, err
+	}
 
 	// 2. IDEMPOTENCY CHECK: Проверяем существование базы с таким же именем
 	existingInfobase, err := s.findInfobaseByName(ctx, endpoint, req.ClusterId, req.Name)
@@ -602,6 +758,9 @@ func (s *InfobaseManagementServer) DropInfobase(
 		)
 	}
 
+	req.ClusterUser, req.ClusterPassword = s.clusterCredentials(req.ClusterId, req.ClusterUser, req.ClusterPassword)
+	req.InfobaseUser, req.InfobasePassword = s.infobaseCredentials(req.ClusterId, req.InfobaseId, req.InfobaseUser, req.InfobasePassword)
+
 	// ⚠️ AUDIT LOG ПЕРЕД операцией
 	s.logger.Warn("Destructive operation requested",
 		zap.String("operation", "DropInfobase"),
@@ -629,7 +788,7 @@ func (s *InfobaseManagementServer) DropInfobase(
 	}
 
 	// 1. Получить endpoint от RAS client
-	endpoint, err := s.client.GetEndpoint(ctx)
+	endpoint, release, err := s.getEndpoint(ctx)
 	if err != nil {
 		s.logger.Error("Failed to get RAS endpoint",
 			zap.String("cluster_id", req.ClusterId),
@@ -638,6 +797,15 @@ func (s *InfobaseManagementServer) DropInfobase(
 		)
 		return nil, s.mapRASError(err)
 	}
+	defer release()
+
+	// Аутентификация на endpoint
+	if err := s.authenticate(ctx, endpoint, req.ClusterId,
+		req.GetClusterUser(), req.GetClusterPassword(),
+		req.GetInfobaseUser(), req.GetInfobasePassword(),
+	); err != nil {
+		return nil, err
+	}
 
 	// 2. Построить DeleteInfobaseRequest
 	// ВАЖНО: RAS использует DELETE_INFOBASE_REQUEST message type
@@ -753,12 +921,19 @@ func (s *InfobaseManagementServer) LockInfobase(
 		// proceed
 	}
 
+	// Окно блокировки отслеживает шлюз, RAS не снимает блокировку регламентных заданий сам
+	if s.scheduledLocks != nil && req.DeniedFrom != nil && req.DeniedTo != nil {
+		return s.scheduleLock(ctx, req)
+	}
+
 	// Построить UpdateInfobaseRequest с параметрами блокировки
 	updateReq := &pb.UpdateInfobaseRequest{
-		ClusterId:       req.ClusterId,
-		InfobaseId:      req.InfobaseId,
-		ClusterUser:     req.ClusterUser,
-		ClusterPassword: req.ClusterPassword,
+		ClusterId:        req.ClusterId,
+		InfobaseId:       req.InfobaseId,
+		ClusterUser:      req.ClusterUser,
+		ClusterPassword:  req.ClusterPassword,
+		InfobaseUser:     req.InfobaseUser,
+		InfobasePassword: req.InfobasePassword,
 	}
 
 	// Установить флаги блокировки
@@ -823,10 +998,12 @@ func (s *InfobaseManagementServer) UnlockInfobase(
 
 	// Построить UpdateInfobaseRequest с параметрами разблокировки
 	updateReq := &pb.UpdateInfobaseRequest{
-		ClusterId:       req.ClusterId,
-		InfobaseId:      req.InfobaseId,
-		ClusterUser:     req.ClusterUser,
-		ClusterPassword: req.ClusterPassword,
+		ClusterId:        req.ClusterId,
+		InfobaseId:       req.InfobaseId,
+		ClusterUser:      req.ClusterUser,
+		ClusterPassword:  req.ClusterPassword,
+		InfobaseUser:     req.InfobaseUser,
+		InfobasePassword: req.InfobasePassword,
 	}
 
 	// Снять флаги блокировки
@@ -857,6 +1034,110 @@ func (s *InfobaseManagementServer) UnlockInfobase(
 	}, nil
 }
 
+// ==================== READ OPERATIONS ====================
+
+// GetInfobase возвращает полные сведения об информационной базе.
+// RAS отдает их только endpoint, аутентифицированному в информационной базе.
+func (s *InfobaseManagementServer) GetInfobase(
+	ctx context.Context,
+	req *pb.GetInfobaseRequest,
+) (*pb.GetInfobaseResponse, error) {
+	if err := s.validateClusterId(req.ClusterId); err != nil {
+		return nil, err
+	}
+	if err := s.validateInfobaseId(req.InfobaseId); err != nil {
+		return nil, err
+	}
+
+	req.ClusterUser, req.ClusterPassword = s.clusterCredentials(req.ClusterId, req.ClusterUser, req.ClusterPassword)
+	req.InfobaseUser, req.InfobasePassword = s.infobaseCredentials(req.ClusterId, req.InfobaseId, req.InfobaseUser, req.InfobasePassword)
+
+	s.logger.Info("GetInfobase request",
+		zap.String("cluster_id", req.ClusterId),
+		zap.String("infobase_id", req.InfobaseId),
+	)
+
+	endpoint, release, err := s.getEndpoint(ctx)
+	if err != nil {
+		return nil, s.mapRASError(err)
+	}
+	defer release()
+
+	if err := s.authenticate(ctx, endpoint, req.ClusterId,
+		req.GetClusterUser(), req.GetClusterPassword(),
+		req.GetInfobaseUser(), req.GetInfobasePassword(),
+	); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetInfobaseInfoResponse{}
+	err = sendEndpointRequest(ctx, endpoint, &cluster_service.GetInfobaseInfoRequest{
+		ClusterId:  req.ClusterId,
+		InfobaseId: req.InfobaseId,
+	}, resp)
+	if err != nil {
+		s.logger.Error("Failed to get infobase via RAS",
+			zap.String("cluster_id", req.ClusterId),
+			zap.String("infobase_id", req.InfobaseId),
+			zap.Error(err),
+		)
+		return nil, s.mapRASError(err)
+	}
+	if resp.GetInfo() == nil {
+		return nil, status.Errorf(codes.NotFound, "infobase '%s' not found", req.InfobaseId)
+	}
+
+	// RAS не всегда возвращает UUID в ответе на запрос одной базы
+	if resp.Info.Uuid == "" {
+		resp.Info.Uuid = req.InfobaseId
+	}
+
+	return &pb.GetInfobaseResponse{Infobase: mapInfobaseDetails(resp.GetInfo())}, nil
+}
+
+// ListInfobases возвращает полные сведения обо всех информационных базах кластера
+func (s *InfobaseManagementServer) ListInfobases(
+	ctx context.Context,
+	req *pb.ListInfobasesRequest,
+) (*pb.ListInfobasesResponse, error) {
+	if err := s.validateClusterId(req.ClusterId); err != nil {
+		return nil, err
+	}
+
+	req.ClusterUser, req.ClusterPassword = s.clusterCredentials(req.ClusterId, req.ClusterUser, req.ClusterPassword)
+
+	s.logger.Info("ListInfobases request", zap.String("cluster_id", req.ClusterId))
+
+	endpoint, release, err := s.getEndpoint(ctx)
+	if err != nil {
+		return nil, s.mapRASError(err)
+	}
+	defer release()
+
+	if err := s.authenticate(ctx, endpoint, req.ClusterId,
+		req.GetClusterUser(), req.GetClusterPassword(), "", "",
+	); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetInfobasesResponse{}
+	err = sendEndpointRequest(ctx, endpoint, &cluster_service.GetInfobasesRequest{ClusterId: req.ClusterId}, resp)
+	if err != nil {
+		s.logger.Error("Failed to list infobases via RAS",
+			zap.String("cluster_id", req.ClusterId),
+			zap.Error(err),
+		)
+		return nil, s.mapRASError(err)
+	}
+
+	infobases := make([]*pb.InfobaseDetails, 0, len(resp.GetInfobases()))
+	for _, info := range resp.GetInfobases() {
+		infobases = append(infobases, mapInfobaseDetails(info))
+	}
+
+	return &pb.ListInfobasesResponse{Infobases: infobases}, nil
+}
+
 // ==================== HELPER FUNCTIONS ====================
 
 // mapDBMSTypeToString converts protobuf enum to string for RAS
@@ -899,3 +1180,70 @@ func mapLicenseDistributionToInt(allow bool) int32 {
 	}
 	return 1 // запрещено
 }
+
+// mapStringToDBMSType converts RAS DBMS name back to protobuf enum
+func mapStringToDBMSType(dbms string) pb.DBMSType {
+	switch strings.ToLower(dbms) {
+	case "mssqlserver":
+		return pb.DBMSType_DBMS_TYPE_MSSQL_SERVER
+	case "postgresql":
+		return pb.DBMSType_DBMS_TYPE_POSTGRESQL
+	case "ibmdb2":
+		return pb.DBMSType_DBMS_TYPE_IBM_DB2
+	case "oracledatabase":
+		return pb.DBMSType_DBMS_TYPE_ORACLE
+	default:
+		return pb.DBMSType_DBMS_TYPE_UNSPECIFIED
+	}
+}
+
+// mapIntToSecurityLevel converts RAS security level back to protobuf enum
+func mapIntToSecurityLevel(level int32) pb.SecurityLevel {
+	switch level {
+	case 0:
+		return pb.SecurityLevel_SECURITY_LEVEL_0
+	case 1:
+		return pb.SecurityLevel_SECURITY_LEVEL_1
+	case 2:
+		return pb.SecurityLevel_SECURITY_LEVEL_2
+	case 3:
+		return pb.SecurityLevel_SECURITY_LEVEL_3
+	default:
+		return pb.SecurityLevel_SECURITY_LEVEL_UNSPECIFIED
+	}
+}
+
+// mapIntToLicenseDistribution is the inverse of mapLicenseDistributionToInt
+func mapIntToLicenseDistribution(value int32) bool {
+	return value == 0
+}
+
+// mapInfobaseDetails converts RAS infobase info to the API message,
+// the database password is never returned
+func mapInfobaseDetails(info *serializev1.InfobaseInfo) *pb.InfobaseDetails {
+	return &pb.InfobaseDetails{
+		InfobaseId:                             info.GetUuid(),
+		Name:                                   info.GetName(),
+		Description:                            info.GetDescr(),
+		Dbms:                                   mapStringToDBMSType(info.GetDbms()),
+		DbServer:                               info.GetDbServer(),
+		DbName:                                 info.GetDbName(),
+		DbUser:                                 info.GetDbUser(),
+		Locale:                                 info.GetLocale(),
+		DateOffset:                             info.GetDateOffset(),
+		SessionsDeny:                           info.GetSessionsDeny(),
+		DeniedFrom:                             info.GetDeniedFrom(),
+		DeniedTo:                               info.GetDeniedTo(),
+		DeniedMessage:                          info.GetDeniedMessage(),
+		DeniedParameter:                        info.GetDeniedParameter(),
+		PermissionCode:                         info.GetPermissionCode(),
+		ScheduledJobsDeny:                      info.GetScheduledJobsDeny(),
+		SecurityLevel:                          mapIntToSecurityLevel(info.GetSecurityLevel()),
+		LicenseDistributionAllow:               mapIntToLicenseDistribution(info.GetLicenseDistribution()),
+		SecurityProfileName:                    info.GetSecurityProfileName(),
+		SafeModeSecurityProfileName:            info.GetSafeModeSecurityProfileName(),
+		ExternalSessionManagerConnectionString: info.GetExternalSessionManagerConnectionString(),
+		ExternalSessionManagerRequired:         info.GetExternalSessionManagerRequired(),
+		ReserveWorkingProcesses:                info.GetReserveWorkingProcesses(),
+	}
+}
@@ -2,17 +2,22 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
 	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
 	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
 	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
@@ -783,6 +788,202 @@ func TestLockInfobase_ValidationErrors(t *testing.T) {
 	}
 }
 
+// recordingEndpoint records the names of the messages sent to RAS
+// and may pretend the endpoint is already authenticated
+type recordingEndpoint struct {
+	sent          []string
+	authenticated bool
+	authErr       error
+}
+
+func (e *recordingEndpoint) Request(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+	name := string(req.Request.MessageName().Name())
+	e.sent = append(e.sent, name)
+	if e.authErr != nil && name != "InfobaseInfo" {
+		return nil, e.authErr
+	}
+	return req.Respond, nil
+}
+
+func (e *recordingEndpoint) Authenticated(*anypb.Any) bool {
+	return e.authenticated
+}
+
+func newRecordingServer(endpoint clientv1.EndpointServiceImpl) *InfobaseManagementServer {
+	return &InfobaseManagementServer{
+		logger: zap.NewNop(),
+		client: &MockRASClient{
+			GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+				return endpoint, nil
+			},
+		},
+	}
+}
+
+func TestLockInfobase_AuthenticatesEndpoint(t *testing.T) {
+	endpoint := &recordingEndpoint{}
+	server := newRecordingServer(endpoint)
+
+	req := &pb.LockInfobaseRequest{
+		ClusterId:        "cluster-123",
+		InfobaseId:       "infobase-123",
+		SessionsDeny:     true,
+		ClusterUser:      proto.String("admin"),
+		ClusterPassword:  proto.String("secret"),
+		InfobaseUser:     proto.String("ib-admin"),
+		InfobasePassword: proto.String("ib-secret"),
+	}
+
+	_, err := server.LockInfobase(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ClusterAuthenticateRequest", "AuthenticateInfobaseRequest", "InfobaseInfo"}, endpoint.sent)
+}
+
+func TestUpdateInfobase_SkipsCachedAuthentication(t *testing.T) {
+	endpoint := &recordingEndpoint{authenticated: true}
+	server := newRecordingServer(endpoint)
+
+	req := &pb.UpdateInfobaseRequest{
+		ClusterId:       "cluster-123",
+		InfobaseId:      "infobase-123",
+		ClusterUser:     proto.String("admin"),
+		ClusterPassword: proto.String("secret"),
+	}
+
+	_, err := server.UpdateInfobase(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"InfobaseInfo"}, endpoint.sent)
+}
+
+func TestInfobaseManagement_ClosesEndpoints(t *testing.T) {
+	endpoint := numberedEndpoint{
+		MockEndpoint: &MockEndpoint{
+			RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+				return anypb.New(&cluster_service.GetInfobaseInfoResponse{Info: &serializev1.InfobaseInfo{Uuid: "infobase-123"}})
+			},
+		},
+		EndpointImpl: protocolv1.NewEndpoint(7, 10),
+	}
+	counter := &countingClient{RASClient: &MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	}}
+	server := NewInfobaseManagementServer(counter)
+	server.logger = zap.NewNop()
+
+	req := &pb.GetInfobaseRequest{
+		ClusterId:       "cluster-123",
+		InfobaseId:      "infobase-123",
+		ClusterUser:     proto.String("admin"),
+		ClusterPassword: proto.String("secret"),
+	}
+	for i := 0; i < 3; i++ {
+		_, err := server.GetInfobase(context.Background(), req)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), counter.opened.Load())
+	assert.Equal(t, int32(3), counter.closed.Load(), "no endpoint is left open after the call")
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("endpoint_id", "7"))
+	_, err := server.GetInfobase(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), counter.closed.Load(), "the endpoint of the caller stays open")
+}
+
+func TestDropInfobase_AuthenticationError(t *testing.T) {
+	endpoint := &recordingEndpoint{authErr: fmt.Errorf("permission denied: wrong password")}
+	server := newRecordingServer(endpoint)
+
+	req := &pb.DropInfobaseRequest{
+		ClusterId:   "cluster-123",
+		InfobaseId:  "infobase-123",
+		DropMode:    pb.DropMode_DROP_MODE_UNREGISTER_ONLY,
+		ClusterUser: proto.String("admin"),
+	}
+
+	_, err := server.DropInfobase(context.Background(), req)
+	assert.Error(t, err)
+	assert.Equal(t, []string{"ClusterAuthenticateRequest"}, endpoint.sent, "nothing is dropped without authentication")
+}
+
+// ==================== GetInfobase / ListInfobases Tests ====================
+
+// infobaseInfoEndpoint answers infobase info requests with infos
+type infobaseInfoEndpoint struct {
+	recordingEndpoint
+	infos []*serializev1.InfobaseInfo
+}
+
+func (e *infobaseInfoEndpoint) Request(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+	if _, err := e.recordingEndpoint.Request(ctx, req); err != nil {
+		return nil, err
+	}
+	switch req.Request.MessageName().Name() {
+	case "GetInfobasesRequest":
+		return anypb.New(&cluster_service.GetInfobasesResponse{Infobases: e.infos})
+	case "GetInfobaseInfoRequest":
+		return anypb.New(&cluster_service.GetInfobaseInfoResponse{Info: e.infos[0]})
+	}
+	return req.Respond, nil
+}
+
+func TestGetInfobase_Success(t *testing.T) {
+	endpoint := &infobaseInfoEndpoint{infos: []*serializev1.InfobaseInfo{{
+		Name:                "accounting",
+		Dbms:                "PostgreSQL",
+		DbServer:            "pg-01",
+		DbName:              "accounting",
+		DbPwd:               "db-secret",
+		SessionsDeny:        true,
+		SecurityLevel:       2,
+		LicenseDistribution: 1,
+	}}}
+	server := newRecordingServer(endpoint)
+
+	resp, err := server.GetInfobase(context.Background(), &pb.GetInfobaseRequest{
+		ClusterId:    "cluster-123",
+		InfobaseId:   "infobase-123",
+		InfobaseUser: proto.String("ib-admin"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AuthenticateInfobaseRequest", "GetInfobaseInfoRequest"}, endpoint.sent)
+
+	ib := resp.GetInfobase()
+	assert.Equal(t, "infobase-123", ib.GetInfobaseId())
+	assert.Equal(t, pb.DBMSType_DBMS_TYPE_POSTGRESQL, ib.GetDbms())
+	assert.Equal(t, pb.SecurityLevel_SECURITY_LEVEL_2, ib.GetSecurityLevel())
+	assert.False(t, ib.GetLicenseDistributionAllow())
+	assert.True(t, ib.GetSessionsDeny())
+}
+
+func TestGetInfobase_ValidationErrors(t *testing.T) {
+	server := newRecordingServer(&recordingEndpoint{})
+
+	_, err := server.GetInfobase(context.Background(), &pb.GetInfobaseRequest{ClusterId: "cluster-123"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListInfobases_Success(t *testing.T) {
+	endpoint := &infobaseInfoEndpoint{infos: []*serializev1.InfobaseInfo{
+		{Uuid: "ib-1", Name: "accounting", Dbms: "MSSQLServer"},
+		{Uuid: "ib-2", Name: "hrm", Dbms: "PostgreSQL", ScheduledJobsDeny: true},
+	}}
+	server := newRecordingServer(endpoint)
+
+	resp, err := server.ListInfobases(context.Background(), &pb.ListInfobasesRequest{
+		ClusterId:   "cluster-123",
+		ClusterUser: proto.String("admin"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ClusterAuthenticateRequest", "GetInfobasesRequest"}, endpoint.sent)
+
+	require.Len(t, resp.GetInfobases(), 2)
+	assert.Equal(t, pb.DBMSType_DBMS_TYPE_MSSQL_SERVER, resp.GetInfobases()[0].GetDbms())
+	assert.Equal(t, "hrm", resp.GetInfobases()[1].GetName())
+	assert.True(t, resp.GetInfobases()[1].GetScheduledJobsDeny())
+}
+
 // ==================== UnlockInfobase Tests ====================
 
 func TestUnlockInfobase_Success(t *testing.T) {
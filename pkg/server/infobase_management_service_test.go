@@ -228,6 +228,33 @@ func TestMapLicenseDistributionToInt(t *testing.T) {
 	assert.Equal(t, int32(1), mapLicenseDistributionToInt(false))
 }
 
+func TestMapStringToDBMSType(t *testing.T) {
+	for _, dbms := range []pb.DBMSType{
+		pb.DBMSType_DBMS_TYPE_MSSQL_SERVER,
+		pb.DBMSType_DBMS_TYPE_POSTGRESQL,
+		pb.DBMSType_DBMS_TYPE_IBM_DB2,
+		pb.DBMSType_DBMS_TYPE_ORACLE,
+	} {
+		assert.Equal(t, dbms, mapStringToDBMSType(mapDBMSTypeToString(dbms)))
+	}
+	assert.Equal(t, pb.DBMSType_DBMS_TYPE_POSTGRESQL, mapStringToDBMSType("postgresql"))
+	assert.Equal(t, pb.DBMSType_DBMS_TYPE_UNSPECIFIED, mapStringToDBMSType("FileDB"))
+}
+
+func TestMapIntToSecurityLevel(t *testing.T) {
+	for _, level := range []pb.SecurityLevel{
+		pb.SecurityLevel_SECURITY_LEVEL_0,
+		pb.SecurityLevel_SECURITY_LEVEL_1,
+		pb.SecurityLevel_SECURITY_LEVEL_2,
+		pb.SecurityLevel_SECURITY_LEVEL_3,
+	} {
+		assert.Equal(t, level, mapIntToSecurityLevel(mapSecurityLevelToInt(level)))
+	}
+	assert.Equal(t, pb.SecurityLevel_SECURITY_LEVEL_UNSPECIFIED, mapIntToSecurityLevel(7))
+	assert.True(t, mapIntToLicenseDistribution(mapLicenseDistributionToInt(true)))
+	assert.False(t, mapIntToLicenseDistribution(mapLicenseDistributionToInt(false)))
+}
+
 func TestMapRASError(t *testing.T) {
 	srv := &InfobaseManagementServer{logger: zap.NewNop()}
 	tests := []struct {
@@ -0,0 +1,273 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ==================== SCHEDULED LOCKS ====================
+
+// ListScheduledLocks возвращает окна блокировки, отслеживаемые шлюзом
+func (s *InfobaseManagementServer) ListScheduledLocks(
+	ctx context.Context,
+	req *pb.ListScheduledLocksRequest,
+) (*pb.ListScheduledLocksResponse, error) {
+	if s.scheduledLocks == nil {
+		return nil, errScheduledLocksDisabled
+	}
+
+	locks := s.scheduledLocks.List(req.ClusterId, req.InfobaseId)
+	resp := &pb.ListScheduledLocksResponse{Locks: make([]*pb.ScheduledLock, 0, len(locks))}
+	for _, l := range locks {
+		resp.Locks = append(resp.Locks, mapScheduledLock(l))
+	}
+
+	return resp, nil
+}
+
+// CancelScheduledLock отменяет окно блокировки, у начавшегося окна
+// восстанавливается состояние блокировки до него
+func (s *InfobaseManagementServer) CancelScheduledLock(
+	ctx context.Context,
+	req *pb.CancelScheduledLockRequest,
+) (*pb.CancelScheduledLockResponse, error) {
+	if s.scheduledLocks == nil {
+		return nil, errScheduledLocksDisabled
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	s.logger.Info("CancelScheduledLock request", zap.String("lock_id", req.Id))
+
+	l, err := s.scheduledLocks.Cancel(ctx, req.Id)
+	if err != nil {
+		s.logger.Error("Failed to cancel scheduled lock",
+			zap.String("lock_id", req.Id),
+			zap.Error(err),
+		)
+		return nil, scheduleError(err)
+	}
+
+	message := "Scheduled lock cancelled"
+	if l.Status == schedule.StatusActive {
+		message = "Scheduled lock cancelled, previous lock state restored"
+	}
+
+	return &pb.CancelScheduledLockResponse{
+		Lock:    mapScheduledLock(l),
+		Message: message,
+		Success: true,
+	}, nil
+}
+
+var errScheduledLocksDisabled = status.Error(codes.FailedPrecondition,
+	"scheduled locks are disabled, set SCHEDULED_LOCKS_FILE to enable them")
+
+// scheduleLock hands the lock window of the request over to the
+// scheduled lock manager
+func (s *InfobaseManagementServer) scheduleLock(
+	ctx context.Context,
+	req *pb.LockInfobaseRequest,
+) (*pb.LockInfobaseResponse, error) {
+	// Окно снимает шлюз без запроса, с учетными данными из хранилища
+	if err := s.scheduledLockCredentials(req.ClusterId, req.InfobaseId); err != nil {
+		return nil, err
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	l, err := s.scheduledLocks.Schedule(ctx, schedule.Lock{
+		ClusterID:         req.ClusterId,
+		InfobaseID:        req.InfobaseId,
+		RASHost:           firstMetadata(md, metadataRASHost),
+		From:              req.DeniedFrom.AsTime(),
+		To:                req.DeniedTo.AsTime(),
+		SessionsDeny:      req.SessionsDeny,
+		DeniedMessage:     req.GetDeniedMessage(),
+		PermissionCode:    req.GetPermissionCode(),
+		ScheduledJobsDeny: req.ScheduledJobsDeny,
+	})
+	if err != nil {
+		s.logger.Error("Failed to schedule infobase lock",
+			zap.String("cluster_id", req.ClusterId),
+			zap.String("infobase_id", req.InfobaseId),
+			zap.Error(err),
+		)
+		return nil, scheduleError(err)
+	}
+
+	message := fmt.Sprintf("Lock scheduled from %s to %s", l.From.Format(time.RFC3339), l.To.Format(time.RFC3339))
+	if l.Status == schedule.StatusActive {
+		message = fmt.Sprintf("Infobase locked until %s", l.To.Format(time.RFC3339))
+	}
+
+	return &pb.LockInfobaseResponse{
+		InfobaseId:      req.InfobaseId,
+		Message:         message,
+		Success:         true,
+		ScheduledLockId: l.ID,
+	}, nil
+}
+
+// scheduledLockCredentials checks that the vault has the credentials
+// the window will be applied and released with
+func (s *InfobaseManagementServer) scheduledLockCredentials(clusterID, infobaseID string) error {
+	if _, ok := s.vault.Cluster(clusterID); !ok {
+		return status.Errorf(codes.FailedPrecondition,
+			"scheduled locks need the credentials of cluster '%s' in the vault", clusterID)
+	}
+	if _, ok := s.vault.Infobase(clusterID, infobaseID); !ok {
+		return status.Errorf(codes.FailedPrecondition,
+			"scheduled locks need the credentials of infobase '%s' in the vault", infobaseID)
+	}
+	return nil
+}
+
+// scheduleError converts scheduled lock manager errors to gRPC status,
+// RAS errors of the applier already are
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, schedule.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, schedule.ErrInvalidLock):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, schedule.ErrOverlap),
+		errors.Is(err, schedule.ErrMaintenance),
+		errors.Is(err, schedule.ErrNoMaintenance):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Internal, "scheduled locks: %v", err)
+}
+
+// scheduledLockApplier changes the locks of scheduled windows in RAS.
+// Windows outlive the request, so the credentials stored in the vault are used.
+type scheduledLockApplier struct {
+	s *InfobaseManagementServer
+}
+
+var _ schedule.Applier = scheduledLockApplier{}
+
+func (a scheduledLockApplier) Apply(ctx context.Context, l schedule.Lock) (schedule.LockState, error) {
+	ctx = scheduledLockContext(ctx, l)
+
+	info, err := a.s.GetInfobase(ctx, &pb.GetInfobaseRequest{
+		ClusterId:  l.ClusterID,
+		InfobaseId: l.InfobaseID,
+	})
+	if err != nil {
+		return schedule.LockState{}, err
+	}
+	previous := scheduleLockState(infobaseLockState(info.GetInfobase()))
+
+	// Блокировку, не заданную окном, оставить как была
+	update := lockStateUpdate(l.ClusterID, l.InfobaseID, infobaseLockStateOf(l.Applied(previous)))
+	if _, err := a.s.UpdateInfobase(ctx, update); err != nil {
+		return schedule.LockState{}, err
+	}
+
+	return previous, nil
+}
+
+func (a scheduledLockApplier) Restore(ctx context.Context, l schedule.Lock, state schedule.LockState) error {
+	_, err := a.s.UpdateInfobase(scheduledLockContext(ctx, l),
+		lockStateUpdate(l.ClusterID, l.InfobaseID, infobaseLockStateOf(state)))
+	return err
+}
+
+// scheduledLockContext routes the requests of the window to the RAS
+// service it was scheduled on
+func scheduledLockContext(ctx context.Context, l schedule.Lock) context.Context {
+	md := metadata.MD{}
+	if l.RASHost != "" {
+		md.Set(metadataRASHost, l.RASHost)
+	}
+	return withRouteCluster(metadata.NewIncomingContext(ctx, md), l.ClusterID)
+}
+
+// lockStateUpdate builds the update setting every lock setting of state
+func lockStateUpdate(clusterID, infobaseID string, state *pb.InfobaseLockState) *pb.UpdateInfobaseRequest {
+	return &pb.UpdateInfobaseRequest{
+		ClusterId:         clusterID,
+		InfobaseId:        infobaseID,
+		SessionsDeny:      proto.Bool(state.GetSessionsDeny()),
+		DeniedFrom:        state.GetDeniedFrom(),
+		DeniedTo:          state.GetDeniedTo(),
+		DeniedMessage:     proto.String(state.GetDeniedMessage()),
+		PermissionCode:    proto.String(state.GetPermissionCode()),
+		ScheduledJobsDeny: proto.Bool(state.GetScheduledJobsDeny()),
+	}
+}
+
+func scheduleLockState(state *pb.InfobaseLockState) schedule.LockState {
+	res := schedule.LockState{
+		SessionsDeny:      state.GetSessionsDeny(),
+		DeniedMessage:     state.GetDeniedMessage(),
+		PermissionCode:    state.GetPermissionCode(),
+		ScheduledJobsDeny: state.GetScheduledJobsDeny(),
+	}
+	if state.GetDeniedFrom() != nil {
+		res.DeniedFrom = state.GetDeniedFrom().AsTime()
+	}
+	if state.GetDeniedTo() != nil {
+		res.DeniedTo = state.GetDeniedTo().AsTime()
+	}
+	return res
+}
+
+func infobaseLockStateOf(state schedule.LockState) *pb.InfobaseLockState {
+	return &pb.InfobaseLockState{
+		SessionsDeny:      state.SessionsDeny,
+		DeniedFrom:        optionalTimestamp(state.DeniedFrom),
+		DeniedTo:          optionalTimestamp(state.DeniedTo),
+		DeniedMessage:     state.DeniedMessage,
+		PermissionCode:    state.PermissionCode,
+		ScheduledJobsDeny: state.ScheduledJobsDeny,
+	}
+}
+
+func mapScheduledLock(l schedule.Lock) *pb.ScheduledLock {
+	res := &pb.ScheduledLock{
+		Id:                l.ID,
+		ClusterId:         l.ClusterID,
+		InfobaseId:        l.InfobaseID,
+		DeniedFrom:        timestamppb.New(l.From),
+		DeniedTo:          timestamppb.New(l.To),
+		SessionsDeny:      l.SessionsDeny,
+		DeniedMessage:     l.DeniedMessage,
+		HasPermissionCode: l.PermissionCode != "",
+		ScheduledJobsDeny: l.ScheduledJobsDeny,
+		Status:            pb.ScheduledLockStatus_SCHEDULED_LOCK_STATUS_PENDING,
+		LastError:         l.LastError,
+		RetryAt:           optionalTimestamp(l.RetryAt),
+		CreatedAt:         timestamppb.New(l.CreatedAt),
+	}
+	if l.Status == schedule.StatusActive {
+		res.Status = pb.ScheduledLockStatus_SCHEDULED_LOCK_STATUS_ACTIVE
+	}
+	if l.Previous != nil {
+		res.PreviousState = infobaseLockStateOf(*l.Previous)
+	}
+	return res
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
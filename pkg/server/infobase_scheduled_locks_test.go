@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestScheduledLocks_LockListCancel(t *testing.T) {
+	endpoint := &maintenanceEndpoint{info: &serializev1.InfobaseInfo{
+		Uuid:          testInfobaseID,
+		SessionsDeny:  true,
+		DeniedMessage: "Закрытие месяца",
+	}}
+	server := newRecordingServer(endpoint)
+	ctx := context.Background()
+
+	_, err := server.ListScheduledLocks(ctx, &pb.ListScheduledLocksRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "disabled without SCHEDULED_LOCKS_FILE")
+
+	locks, err := schedule.Open(filepath.Join(t.TempDir(), "locks.json"), scheduledLockApplier{s: server}, zap.NewNop())
+	require.NoError(t, err)
+	server.scheduledLocks = locks
+	server.vault = newTestVault(t)
+
+	now := time.Now()
+	req := &pb.LockInfobaseRequest{
+		ClusterId:         testClusterID,
+		InfobaseId:        testInfobaseID,
+		DeniedFrom:        timestamppb.New(now.Add(time.Hour)),
+		DeniedTo:          timestamppb.New(now.Add(2 * time.Hour)),
+		ScheduledJobsDeny: true,
+		ClusterUser:       proto.String("admin"),
+		ClusterPassword:   proto.String("secret"),
+	}
+	_, err = server.LockInfobase(ctx, req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "credentials of the request are gone when the window opens")
+
+	require.NoError(t, server.vault.Set(testClusterID, "", vault.Credentials{User: "admin", Password: "secret"}))
+	_, err = server.LockInfobase(ctx, req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the infobase credentials are required too")
+	assert.Empty(t, locks.List("", ""))
+
+	require.NoError(t, server.vault.Set(testClusterID, vault.AnyInfobase, vault.Credentials{User: "ib-admin", Password: "ib-secret"}))
+	resp, err := server.LockInfobase(ctx, &pb.LockInfobaseRequest{
+		ClusterId:         testClusterID,
+		InfobaseId:        testInfobaseID,
+		DeniedFrom:        timestamppb.New(now.Add(-time.Minute)),
+		DeniedTo:          timestamppb.New(now.Add(time.Hour)),
+		ScheduledJobsDeny: true,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetScheduledLockId())
+
+	require.Len(t, endpoint.updates, 1)
+	assert.True(t, endpoint.updates[0].GetScheduledJobsDeny())
+	assert.True(t, endpoint.updates[0].GetSessionsDeny(), "the session lock outside the window is kept")
+	assert.Equal(t, "Закрытие месяца", endpoint.updates[0].GetDeniedMessage())
+
+	list, err := server.ListScheduledLocks(ctx, &pb.ListScheduledLocksRequest{InfobaseId: testInfobaseID})
+	require.NoError(t, err)
+	require.Len(t, list.GetLocks(), 1)
+	lock := list.GetLocks()[0]
+	assert.Equal(t, pb.ScheduledLockStatus_SCHEDULED_LOCK_STATUS_ACTIVE, lock.GetStatus())
+	assert.False(t, lock.GetPreviousState().GetScheduledJobsDeny())
+
+	_, err = server.LockInfobase(ctx, &pb.LockInfobaseRequest{
+		ClusterId:    testClusterID,
+		InfobaseId:   testInfobaseID,
+		SessionsDeny: true,
+		DeniedFrom:   timestamppb.New(now.Add(30 * time.Minute)),
+		DeniedTo:     timestamppb.New(now.Add(2 * time.Hour)),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "windows of an infobase must not overlap")
+
+	cancelled, err := server.CancelScheduledLock(ctx, &pb.CancelScheduledLockRequest{Id: lock.GetId()})
+	require.NoError(t, err)
+	assert.Equal(t, lock.GetId(), cancelled.GetLock().GetId())
+
+	require.Len(t, endpoint.updates, 2)
+	assert.False(t, endpoint.updates[1].GetScheduledJobsDeny(), "the previous state is restored")
+	assert.True(t, endpoint.updates[1].GetSessionsDeny())
+
+	_, err = server.CancelScheduledLock(ctx, &pb.CancelScheduledLockRequest{Id: lock.GetId()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestScheduledLocks_MaintenanceDuringWindow(t *testing.T) {
+	endpoint := &maintenanceEndpoint{
+		info:      &serializev1.InfobaseInfo{Uuid: testInfobaseID, DeniedMessage: "old"},
+		snapshots: [][]*serializev1.SessionInfo{{}},
+	}
+	server := newRecordingServer(endpoint)
+	locks, err := schedule.Open(filepath.Join(t.TempDir(), "locks.json"), scheduledLockApplier{s: server}, zap.NewNop())
+	require.NoError(t, err)
+	server.scheduledLocks = locks
+	server.vault = newTestVault(t)
+	require.NoError(t, server.vault.Set(testClusterID, "", vault.Credentials{User: "admin"}))
+	require.NoError(t, server.vault.Set(testClusterID, testInfobaseID, vault.Credentials{User: "ib-admin"}))
+	ctx := context.Background()
+
+	now := time.Now()
+	_, err = server.LockInfobase(ctx, &pb.LockInfobaseRequest{
+		ClusterId:         testClusterID,
+		InfobaseId:        testInfobaseID,
+		DeniedFrom:        timestamppb.New(now.Add(-time.Minute)),
+		DeniedTo:          timestamppb.New(now.Add(time.Hour)),
+		ScheduledJobsDeny: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, endpoint.updates, 1)
+
+	// RAS reports the lock of the window from now on
+	endpoint.info = endpoint.updates[0]
+
+	stream := &maintenanceStream{ctx: ctx}
+	err = server.BeginMaintenance(&pb.BeginMaintenanceRequest{
+		ClusterId:   testClusterID,
+		InfobaseId:  testInfobaseID,
+		GracePeriod: durationpb.New(0),
+	}, stream)
+	require.NoError(t, err)
+	previous := stream.progress[len(stream.progress)-1].GetPreviousState()
+	assert.False(t, previous.GetScheduledJobsDeny(), "the state before the window is saved, not the window lock")
+
+	resp, err := server.EndMaintenance(ctx, &pb.EndMaintenanceRequest{ClusterId: testClusterID, InfobaseId: testInfobaseID})
+	require.NoError(t, err)
+	assert.True(t, resp.GetRestoredState().GetScheduledJobsDeny(), "the window is still open")
+
+	restored := endpoint.updates[len(endpoint.updates)-1]
+	assert.True(t, restored.GetScheduledJobsDeny())
+	assert.False(t, restored.GetSessionsDeny())
+	assert.Equal(t, "old", restored.GetDeniedMessage())
+
+	list, err := server.ListScheduledLocks(ctx, &pb.ListScheduledLocksRequest{InfobaseId: testInfobaseID})
+	require.NoError(t, err)
+	require.Len(t, list.GetLocks(), 1)
+	assert.False(t, list.GetLocks()[0].GetPreviousState().GetScheduledJobsDeny())
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+)
+
+var _ cluster_service.LocksServiceServer = (*rasClientServiceServer)(nil)
+
+// GetLocks lists the managed locks of a cluster
+func (s *rasClientServiceServer) GetLocks(ctx context.Context, request *cluster_service.GetLocksRequest) (*cluster_service.GetLocksResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetLocksResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	setLocksCluster(request.GetClusterId(), resp.GetLocks())
+
+	return resp, nil
+}
+
+// GetInfobaseLocks lists the managed locks of an infobase
+func (s *rasClientServiceServer) GetInfobaseLocks(ctx context.Context, request *cluster_service.GetInfobaseLocksRequest) (*cluster_service.GetInfobaseLocksResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "infobase_id", request.GetInfobaseId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetInfobaseLocksResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	setLocksCluster(request.GetClusterId(), resp.GetLocks())
+
+	return resp, nil
+}
+
+// GetSessionLocks lists the managed locks held by a session
+func (s *rasClientServiceServer) GetSessionLocks(ctx context.Context, request *cluster_service.GetSessionLocksRequest) (*cluster_service.GetSessionLocksResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "infobase_id", request.GetInfobaseId(),
+		"session_id", request.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetSessionLocksResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	setLocksCluster(request.GetClusterId(), resp.GetLocks())
+
+	return resp, nil
+}
+
+// GetConnectionLocks lists the managed locks held by a connection
+func (s *rasClientServiceServer) GetConnectionLocks(ctx context.Context, request *cluster_service.GetConnectionLocksRequest) (*cluster_service.GetConnectionLocksResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "connection_id", request.GetConnectionId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetConnectionLocksResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	setLocksCluster(request.GetClusterId(), resp.GetLocks())
+
+	return resp, nil
+}
+
+func setLocksCluster(clusterID string, locks []*cluster_service.LockInfo) {
+	for _, lock := range locks {
+		lock.ClusterId = clusterID
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testSessionID = "3f2e1d0c-b9a8-4766-8554-43322110ffee"
+
+func TestGetSessionLocks(t *testing.T) {
+	lockedAt := timestamppb.New(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	var requests []*anypb.Any
+	srv := respondingServer(&cluster_service.GetSessionLocksResponse{
+		Locks: []*cluster_service.LockInfo{
+			{ConnectionId: testConnectionID, SessionId: testSessionID, Descr: "БД(Документ.Заказ)", LockedAt: lockedAt},
+		},
+	}, &requests)
+
+	resp, err := srv.GetSessionLocks(context.Background(), &cluster_service.GetSessionLocksRequest{
+		ClusterId:  testClusterID,
+		InfobaseId: testInfobaseID,
+		SessionId:  testSessionID,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetLocks(), 1)
+	assert.Equal(t, testSessionID, resp.GetLocks()[0].GetSessionId())
+	assert.Equal(t, testClusterID, resp.GetLocks()[0].GetClusterId())
+
+	_, err = srv.GetSessionLocks(context.Background(), &cluster_service.GetSessionLocksRequest{ClusterId: testClusterID, SessionId: testSessionID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, requests, 1)
+}
+
+func TestLockInfo_Encoding(t *testing.T) {
+	resp := &cluster_service.GetLocksResponse{
+		Locks: []*cluster_service.LockInfo{{
+			ConnectionId: testConnectionID,
+			Descr:        "Разделяемая БД",
+			LockedAt:     timestamppb.New(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)),
+			Object:       testInfobaseID,
+			SessionId:    testSessionID,
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, resp.Formatter(&buf, 10))
+
+	parsed := &cluster_service.GetLocksResponse{}
+	require.NoError(t, parsed.Parse(&buf, 10))
+	assert.True(t, proto.Equal(resp, parsed), "got %v", parsed)
+}
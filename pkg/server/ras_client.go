@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
 	"github.com/v8platform/ras-grpc-gw/pkg/client"
@@ -15,20 +16,24 @@ type RASClient interface {
 	GetEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, error)
 }
 
-// clientConnAdapter adapts client.ClientConn to RASClient interface
-// This allows existing code to work with the new interface without changes
-type clientConnAdapter struct {
-	conn *client.ClientConn
-}
+var _ RASClient = (*client.Pool)(nil)
 
-// NewRASClient creates a new RASClient from ClientConn
+// NewRASClient creates a new RASClient backed by a pool of RAS connections
+// Concurrent calls are spread over the pool, while a known endpoint_id
+// keeps the caller on the connection that owns the endpoint
 func NewRASClient(rasAddr string) RASClient {
-	return &clientConnAdapter{
-		conn: client.NewClientConn(rasAddr),
-	}
+	return newRASClient(rasAddr, 0)
 }
 
-// GetEndpoint implements RASClient interface
-func (a *clientConnAdapter) GetEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
-	return a.conn.GetEndpoint(ctx)
+// newRASClient creates a pooled RASClient, a positive idleTimeout
+// overrides how long unused endpoints and connections are kept open
+func newRASClient(rasAddr string, idleTimeout time.Duration) RASClient {
+	opts := client.DefaultPoolOptions()
+	if idleTimeout > 0 {
+		opts.IdleTimeout = idleTimeout
+		if opts.IdleCheckFrequency > idleTimeout {
+			opts.IdleCheckFrequency = idleTimeout
+		}
+	}
+	return client.NewPool(rasAddr, opts)
 }
@@ -0,0 +1,217 @@
+package server
+
+import (
+	"context"
+
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ cluster_service.ResourcesServiceServer = (*rasClientServiceServer)(nil)
+
+// GetResourceCounters lists the resource consumption counters of a cluster
+func (s *rasClientServiceServer) GetResourceCounters(ctx context.Context, request *cluster_service.GetResourceCountersRequest) (*cluster_service.GetResourceCountersResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetResourceCountersResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetResourceCounterInfo returns a single resource counter
+func (s *rasClientServiceServer) GetResourceCounterInfo(ctx context.Context, request *cluster_service.GetResourceCounterInfoRequest) (*cluster_service.GetResourceCounterInfoResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "name", request.GetName()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetResourceCounterInfoResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CreateResourceCounter creates a resource counter, the name must be free
+func (s *rasClientServiceServer) CreateResourceCounter(ctx context.Context, request *cluster_service.RegResourceCounterRequest) (*emptypb.Empty, error) {
+	return s.regResourceCounter(ctx, request, false)
+}
+
+// UpdateResourceCounter replaces an existing resource counter
+func (s *rasClientServiceServer) UpdateResourceCounter(ctx context.Context, request *cluster_service.RegResourceCounterRequest) (*emptypb.Empty, error) {
+	return s.regResourceCounter(ctx, request, true)
+}
+
+func (s *rasClientServiceServer) regResourceCounter(ctx context.Context, request *cluster_service.RegResourceCounterRequest, update bool) (*emptypb.Empty, error) {
+
+	name := request.GetCounter().GetName()
+	if err := requireIDs("cluster_id", request.GetClusterId(), "counter.name", name); err != nil {
+		return nil, err
+	}
+
+	counters, err := s.GetResourceCounters(ctx, &cluster_service.GetResourceCountersRequest{ClusterId: request.GetClusterId()})
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, c := range counters.GetCounters() {
+		found = found || c.GetName() == name
+	}
+	if err := checkExistence("resource counter", name, update, found); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("Save resource counter",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("counter", name),
+		zap.Bool("update", update),
+	)
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DropResourceCounter removes a resource counter
+func (s *rasClientServiceServer) DropResourceCounter(ctx context.Context, request *cluster_service.UnregResourceCounterRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "name", request.GetName()); err != nil {
+		return nil, err
+	}
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetResourceLimits lists the resource consumption limits of a cluster
+func (s *rasClientServiceServer) GetResourceLimits(ctx context.Context, request *cluster_service.GetResourceLimitsRequest) (*cluster_service.GetResourceLimitsResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetResourceLimitsResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetResourceLimitInfo returns a single resource limit
+func (s *rasClientServiceServer) GetResourceLimitInfo(ctx context.Context, request *cluster_service.GetResourceLimitInfoRequest) (*cluster_service.GetResourceLimitInfoResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "name", request.GetName()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetResourceLimitInfoResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CreateResourceLimit creates a resource limit, the name must be free
+func (s *rasClientServiceServer) CreateResourceLimit(ctx context.Context, request *cluster_service.RegResourceLimitRequest) (*emptypb.Empty, error) {
+	return s.regResourceLimit(ctx, request, false)
+}
+
+// UpdateResourceLimit replaces an existing resource limit
+func (s *rasClientServiceServer) UpdateResourceLimit(ctx context.Context, request *cluster_service.RegResourceLimitRequest) (*emptypb.Empty, error) {
+	return s.regResourceLimit(ctx, request, true)
+}
+
+func (s *rasClientServiceServer) regResourceLimit(ctx context.Context, request *cluster_service.RegResourceLimitRequest, update bool) (*emptypb.Empty, error) {
+
+	name := request.GetLimit().GetName()
+	if err := requireIDs("cluster_id", request.GetClusterId(), "limit.name", name,
+		"limit.counter", request.GetLimit().GetCounter()); err != nil {
+		return nil, err
+	}
+
+	limits, err := s.GetResourceLimits(ctx, &cluster_service.GetResourceLimitsRequest{ClusterId: request.GetClusterId()})
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, l := range limits.GetLimits() {
+		found = found || l.GetName() == name
+	}
+	if err := checkExistence("resource limit", name, update, found); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("Save resource limit",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("limit", name),
+		zap.String("counter", request.GetLimit().GetCounter()),
+		zap.Stringer("action", request.GetLimit().GetAction()),
+		zap.Bool("update", update),
+	)
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DropResourceLimit removes a resource limit
+func (s *rasClientServiceServer) DropResourceLimit(ctx context.Context, request *cluster_service.UnregResourceLimitRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "name", request.GetName()); err != nil {
+		return nil, err
+	}
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetCounterValues returns the current values of a counter per grouping object
+func (s *rasClientServiceServer) GetCounterValues(ctx context.Context, request *cluster_service.GetCounterValuesRequest) (*cluster_service.GetCounterValuesResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "counter", request.GetCounter()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetCounterValuesResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetCounterAccumulatedValues returns the accumulated values of a counter per grouping object
+func (s *rasClientServiceServer) GetCounterAccumulatedValues(ctx context.Context, request *cluster_service.GetCounterAccumulatedValuesRequest) (*cluster_service.GetCounterAccumulatedValuesResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "counter", request.GetCounter()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetCounterAccumulatedValuesResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestResourceLimit_CreateUpdate(t *testing.T) {
+	var sent []proto.Message
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			sent = append(sent, msg)
+			if _, ok := msg.(*cluster_service.GetResourceLimitsRequest); ok {
+				return anypb.New(&cluster_service.GetResourceLimitsResponse{
+					Limits: []*cluster_service.ResourceLimitInfo{{Name: "long-calls", Counter: "sessions"}},
+				})
+			}
+			return anypb.New(&emptypb.Empty{})
+		},
+	}
+	srv := newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+	ctx := context.Background()
+
+	_, err := srv.CreateResourceLimit(ctx, &cluster_service.RegResourceLimitRequest{
+		ClusterId: testClusterID,
+		Limit:     &cluster_service.ResourceLimitInfo{Name: "long-calls"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "counter is required")
+
+	_, err = srv.CreateResourceLimit(ctx, &cluster_service.RegResourceLimitRequest{
+		ClusterId: testClusterID,
+		Limit:     &cluster_service.ResourceLimitInfo{Name: "long-calls", Counter: "sessions"},
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = srv.UpdateResourceLimit(ctx, &cluster_service.RegResourceLimitRequest{
+		ClusterId: testClusterID,
+		Limit: &cluster_service.ResourceLimitInfo{
+			Name:     "long-calls",
+			Counter:  "sessions",
+			Action:   cluster_service.ResourceLimitAction_RESOURCE_LIMIT_ACTION_INTERRUPT_CURRENT_CALL,
+			Duration: 600,
+		},
+	})
+	require.NoError(t, err)
+
+	reg := sent[len(sent)-1].(*cluster_service.RegResourceLimitRequest)
+	assert.Equal(t, int64(600), reg.GetLimit().GetDuration())
+
+	_, err = srv.UpdateResourceCounter(ctx, &cluster_service.RegResourceCounterRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResourceCounter_Encoding(t *testing.T) {
+	req := &cluster_service.RegResourceCounterRequest{
+		ClusterId: testClusterID,
+		Counter: &cluster_service.ResourceCounterInfo{
+			Name:           "sessions",
+			CollectionTime: 60,
+			Group:          cluster_service.ResourceCounterGroup_RESOURCE_COUNTER_GROUP_DATA_SEPARATION,
+			FilterType:     cluster_service.ResourceCounterFilterType_RESOURCE_COUNTER_FILTER_TYPE_ALL,
+			Duration:       true,
+			CpuTime:        true,
+			Descr:          "Длительные вызовы",
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, req.Formatter(&buf, 10))
+	parsed := &cluster_service.RegResourceCounterRequest{}
+	require.NoError(t, parsed.Parse(&buf, 10))
+	assert.True(t, proto.Equal(req, parsed), "got %v", parsed)
+
+	values := &cluster_service.GetCounterValuesResponse{
+		Values: []*cluster_service.CounterValue{{Object: testSessionID, CpuTime: 1200, Memory: 1 << 20}},
+	}
+	buf.Reset()
+	require.NoError(t, values.Formatter(&buf, 10))
+	parsedValues := &cluster_service.GetCounterValuesResponse{}
+	require.NoError(t, parsedValues.Parse(&buf, 10))
+	assert.True(t, proto.Equal(values, parsedValues), "got %v", parsedValues)
+}
@@ -0,0 +1,448 @@
+package server
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid/v3"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	"github.com/v8platform/ras-grpc-gw/pkg/client"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys used to select the target RAS service
+const (
+	metadataRASHost  = "ras_host"
+	metadataClientID = "client_id"
+	metadataCluster  = "cluster_id"
+)
+
+// clusterDiscoveryInterval limits how often unknown cluster IDs
+// trigger a GetClusters round over all known RAS hosts
+const clusterDiscoveryInterval = time.Minute
+
+// endpointIndexSweep is the idxEndpoints size at which endpoints
+// already forgotten by their pools are swept from the index
+const endpointIndexSweep = 1024
+
+var _ RASClient = (*RASServer)(nil)
+
+// ClusterRegistry maps 1C cluster IDs to the RAS host serving the cluster
+type ClusterRegistry struct {
+	mu           sync.RWMutex
+	hosts        map[string]string
+	discoveredAt time.Time
+}
+
+// NewClusterRegistry creates an empty cluster registry
+func NewClusterRegistry() *ClusterRegistry {
+	return &ClusterRegistry{
+		hosts: make(map[string]string),
+	}
+}
+
+// Register routes requests for clusterID to the RAS service at host
+func (r *ClusterRegistry) Register(clusterID, host string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.hosts[clusterID] = host
+}
+
+// Lookup returns the RAS host serving clusterID
+func (r *ClusterRegistry) Lookup(clusterID string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	host, ok := r.hosts[clusterID]
+	return host, ok
+}
+
+// Forget removes all clusters served by host
+func (r *ClusterRegistry) Forget(host string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for clusterID, h := range r.hosts {
+		if h == host {
+			delete(r.hosts, clusterID)
+		}
+	}
+}
+
+// startDiscovery reports whether a new discovery round may start now
+func (r *ClusterRegistry) startDiscovery(now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Sub(r.discoveredAt) < clusterDiscoveryInterval {
+		return false
+	}
+	r.discoveredAt = now
+	return true
+}
+
+type routeClusterKey struct{}
+
+// RouteInterceptor makes the cluster_id of the request available for routing,
+// so a request reaches the RAS service of its cluster without extra metadata
+func RouteInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if typed, ok := req.(interface{ GetClusterId() string }); ok {
+			ctx = withRouteCluster(ctx, typed.GetClusterId())
+		}
+		return handler(ctx, req)
+	}
+}
+
+func withRouteCluster(ctx context.Context, clusterID string) context.Context {
+	if clusterID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, routeClusterKey{}, clusterID)
+}
+
+// Clusters returns the registry used to route requests by cluster ID
+func (s *RASServer) Clusters() *ClusterRegistry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.clusters == nil {
+		s.clusters = NewClusterRegistry()
+	}
+	return s.clusters
+}
+
+// GetEndpoint routes the request to a RAS service and returns an endpoint of its pool.
+//
+// The target is selected by, in order: the client_id metadata, the ras_host
+// metadata, the client owning the endpoint_id metadata, the cluster_id of the
+// request resolved through the cluster registry, and finally the default RAS address.
+func (s *RASServer) GetEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+
+	info, err := s.route(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := info.client.GetEndpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.trackEndpoint(ctx, info, endpoint)
+
+	return endpoint, nil
+}
+
+func (s *RASServer) route(ctx context.Context) (*ClientInfo, error) {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if id := firstMetadata(md, metadataClientID); id != "" {
+		info, ok := s.clientByID(id)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "unknown client_id %q", id)
+		}
+		return info, nil
+	}
+
+	if host := firstMetadata(md, metadataRASHost); host != "" {
+		return s.clientForHost(host)
+	}
+
+	for _, id := range md["endpoint_id"] {
+		if info, ok := s.endpointOwner(id); ok {
+			return info, nil
+		}
+	}
+
+	clusterID, _ := ctx.Value(routeClusterKey{}).(string)
+	if clusterID == "" {
+		clusterID = firstMetadata(md, metadataCluster)
+	}
+	if clusterID != "" {
+		host, err := s.resolveCluster(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		if host != "" {
+			info, ok := s.discoveryClient(host)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown RAS host %q", host)
+			}
+			return info, nil
+		}
+	}
+
+	return s.clientForHost(s.rasAddr)
+}
+
+// clientForHost returns the client of a known RAS host, creating it on first use
+func (s *RASServer) clientForHost(host string) (*ClientInfo, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, info := range s.idxClients {
+		if info.host == host {
+			return info, nil
+		}
+	}
+
+	if !s.knownHost(host) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown RAS host %q", host)
+	}
+
+	return s.addClient(host, "", 0), nil
+}
+
+// discoveryClient returns the client of host for cluster discovery, unlike
+// clientForHost it also creates clients for allowed hosts
+func (s *RASServer) discoveryClient(host string) (*ClientInfo, bool) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, info := range s.idxClients {
+		if info.host == host {
+			return info, true
+		}
+	}
+
+	if !s.knownHost(host) && !slices.Contains(s.allowedHosts, host) {
+		return nil, false
+	}
+
+	return s.addClient(host, "", 0), true
+}
+
+// addClient registers a client for host. Callers must hold s.mu.
+func (s *RASServer) addClient(host, uuid string, idleTimeout time.Duration) *ClientInfo {
+
+	if s.idxClients == nil {
+		s.idxClients = make(map[string]*ClientInfo)
+	}
+
+	if uuid == "" {
+		uuid = shortuuid.New()
+	}
+
+	newClient := s.newClient
+	if newClient == nil {
+		newClient = newRASClient
+	}
+
+	if idleTimeout <= 0 {
+		idleTimeout = client.DefaultPoolOptions().IdleTimeout
+	}
+
+	info := &ClientInfo{
+		uuid:        uuid,
+		host:        host,
+		client:      newClient(host, idleTimeout),
+		IdleTimeout: idleTimeout,
+	}
+	s.idxClients[uuid] = info
+
+	logger.Log.Info("RAS client created",
+		zap.String("client_id", uuid),
+		zap.String("ras_host", host),
+	)
+
+	return info
+}
+
+// knownHost reports whether host is a configured RAS address. Callers must hold s.mu.
+func (s *RASServer) knownHost(host string) bool {
+	if host == s.rasAddr {
+		return true
+	}
+	for _, h := range s.rasHosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *RASServer) clientByID(id string) (*ClientInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.idxClients[id]
+	return info, ok
+}
+
+func (s *RASServer) endpointOwner(id string) (*ClientInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	info, ok := s.idxEndpoints[id]
+	if !ok {
+		return nil, false
+	}
+	return info.client, true
+}
+
+// trackEndpoint remembers which client owns the endpoint handed out to the caller
+func (s *RASServer) trackEndpoint(ctx context.Context, info *ClientInfo, endpoint clientv1.EndpointServiceImpl) {
+
+	id := client.EndpointID(endpoint)
+	if id == "" {
+		return
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.idxEndpoints == nil {
+		s.idxEndpoints = make(map[string]*EndpointInfo)
+	}
+
+	// The pool did not know the requested endpoint_id anymore
+	for _, old := range md["endpoint_id"] {
+		if known, ok := s.idxEndpoints[old]; ok && old != id && known.client == info {
+			delete(s.idxEndpoints, old)
+		}
+	}
+
+	s.idxEndpoints[id] = &EndpointInfo{
+		uuid:       id,
+		client:     info,
+		EndpointId: id,
+	}
+
+	if len(s.idxEndpoints) >= endpointIndexSweep {
+		s.sweepEndpoints()
+	}
+}
+
+// CloseEndpoint closes the endpoint id in the pool that opened it
+func (s *RASServer) CloseEndpoint(ctx context.Context, id string) error {
+
+	s.mu.Lock()
+	info, ok := s.idxEndpoints[id]
+	delete(s.idxEndpoints, id)
+	s.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	if pool, ok := info.client.client.(endpointCloser); ok {
+		return pool.CloseEndpoint(ctx, id)
+	}
+	return nil
+}
+
+// endpointCloser is a RAS client able to close its endpoints before they become idle
+type endpointCloser interface {
+	CloseEndpoint(ctx context.Context, id string) error
+}
+
+// sweepEndpoints drops endpoints evicted by their pools. Callers must hold s.mu.
+func (s *RASServer) sweepEndpoints() {
+	for id, info := range s.idxEndpoints {
+		pool, ok := info.client.client.(interface{ HasEndpoint(id string) bool })
+		if ok && !pool.HasEndpoint(id) {
+			delete(s.idxEndpoints, id)
+		}
+	}
+}
+
+// resolveCluster finds the RAS host serving clusterID. Unknown clusters
+// are looked up on every configured, allowed and registered RAS host,
+// a cluster found on none of them is NotFound. With a single RAS host
+// there is nothing to resolve and the host is empty.
+func (s *RASServer) resolveCluster(ctx context.Context, clusterID string) (string, error) {
+
+	registry := s.Clusters()
+	if host, ok := registry.Lookup(clusterID); ok {
+		return host, nil
+	}
+
+	hosts := s.discoveryHosts()
+	if len(hosts) < 2 {
+		return "", nil
+	}
+	if !registry.startDiscovery(time.Now()) {
+		return "", status.Errorf(codes.NotFound, "cluster %q not found on the RAS hosts", clusterID)
+	}
+
+	// Discovery must not reuse the caller's endpoint of another host
+	discoverCtx := metadata.NewIncomingContext(ctx, metadata.MD{})
+
+	for _, host := range hosts {
+		info, ok := s.discoveryClient(host)
+		if !ok {
+			continue
+		}
+
+		endpoint, err := info.client.GetEndpoint(discoverCtx)
+		if err != nil {
+			logger.Log.Warn("Cluster discovery failed", zap.String("ras_host", host), zap.Error(err))
+			continue
+		}
+
+		resp, err := clientv1.NewClustersService(endpoint).GetClusters(discoverCtx, &messagesv1.GetClustersRequest{})
+		if err != nil {
+			logger.Log.Warn("Cluster discovery failed", zap.String("ras_host", host), zap.Error(err))
+			continue
+		}
+
+		for _, cluster := range resp.GetClusters() {
+			registry.Register(cluster.GetUuid(), host)
+		}
+	}
+
+	if host, ok := registry.Lookup(clusterID); ok {
+		return host, nil
+	}
+	return "", status.Errorf(codes.NotFound, "cluster %q not found on the RAS hosts", clusterID)
+}
+
+// discoveryHosts returns the RAS hosts clusters are looked up on
+func (s *RASServer) discoveryHosts() []string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	others := append(slices.Clone(s.rasHosts), s.allowedHosts...)
+	for _, info := range s.idxClients {
+		others = append(others, info.host)
+	}
+	slices.Sort(others)
+
+	// The default host first, the others in a stable order
+	hosts := []string{s.rasAddr}
+	for _, host := range slices.Compact(others) {
+		if host != s.rasAddr {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// closeClients closes the RAS connections of all clients
+func (s *RASServer) closeClients() {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, info := range s.idxClients {
+		if closer, ok := info.client.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
+func firstMetadata(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// newRoutedServer creates a gateway whose clients hand out endpoints
+// with IDs that tell the hosts apart: 1xx for ras-a, 2xx for ras-b
+func newRoutedServer(clusters map[string][]string) *RASServer {
+	srv := NewRASServer("ras-a:1545", "ras-b:1545")
+
+	bases := map[string]int32{"ras-a:1545": 100, "ras-b:1545": 200}
+	srv.newClient = func(host string, _ time.Duration) RASClient {
+		next := bases[host]
+		return &MockRASClient{
+			GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+				next++
+				endpoint := clientv1.NewEndpointService(nil, &protocolv1.Endpoint{Id: next})
+				if ids, ok := clusters[host]; ok {
+					return &MockEndpoint{
+						RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+							resp := &messagesv1.GetClustersResponse{}
+							for _, id := range ids {
+								resp.Clusters = append(resp.Clusters, &serializev1.ClusterInfo{Uuid: id})
+							}
+							return anypb.New(resp)
+						},
+					}, nil
+				}
+				return endpoint, nil
+			},
+		}
+	}
+
+	return srv
+}
+
+func routedHost(t *testing.T, srv *RASServer, ctx context.Context) string {
+	t.Helper()
+	info, err := srv.route(ctx)
+	require.NoError(t, err)
+	return info.host
+}
+
+func TestRASServer_RouteDefault(t *testing.T) {
+	srv := newRoutedServer(nil)
+
+	assert.Equal(t, "ras-a:1545", routedHost(t, srv, context.Background()))
+	assert.Equal(t, "ras-a:1545", routedHost(t, srv, context.Background()))
+	assert.Len(t, srv.idxClients, 1, "client must be created once per host")
+}
+
+func TestRASServer_RouteByHost(t *testing.T) {
+	srv := newRoutedServer(nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("ras_host", "ras-b:1545"))
+	assert.Equal(t, "ras-b:1545", routedHost(t, srv, ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("ras_host", "evil:1545"))
+	_, err := srv.route(ctx)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, srv.idxClients, 1)
+}
+
+func TestRASServer_RouteByClientID(t *testing.T) {
+	srv := newRoutedServer(nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("ras_host", "ras-b:1545"))
+	info, err := srv.route(ctx)
+	require.NoError(t, err)
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("client_id", info.uuid))
+	assert.Equal(t, "ras-b:1545", routedHost(t, srv, ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("client_id", "unknown"))
+	_, err = srv.route(ctx)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRASServer_RouteByEndpointOwner(t *testing.T) {
+	srv := newRoutedServer(nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("ras_host", "ras-b:1545"))
+	endpoint, err := srv.GetEndpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(201), endpoint.(protocolv1.EndpointImpl).GetId())
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("endpoint_id", "201"))
+	assert.Equal(t, "ras-b:1545", routedHost(t, srv, ctx))
+
+	// The pool hands out a new endpoint, the old ID is forgotten
+	_, err = srv.GetEndpoint(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, srv.idxEndpoints, "201")
+	assert.Contains(t, srv.idxEndpoints, "202")
+}
+
+func TestRASServer_RouteByCluster(t *testing.T) {
+	srv := newRoutedServer(nil)
+	srv.Clusters().Register("cluster-b", "ras-b:1545")
+
+	var routed string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		routed = routedHost(t, srv, ctx)
+		return nil, nil
+	}
+
+	_, err := RouteInterceptor()(context.Background(), &messagesv1.GetSessionsRequest{ClusterId: "cluster-b"}, &grpc.UnaryServerInfo{}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ras-b:1545", routed)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("cluster_id", "cluster-b"))
+	assert.Equal(t, "ras-b:1545", routedHost(t, srv, ctx))
+}
+
+func TestRASServer_RouteDiscoversCluster(t *testing.T) {
+	srv := newRoutedServer(map[string][]string{
+		"ras-a:1545": {"cluster-a"},
+		"ras-b:1545": {"cluster-b1", "cluster-b2"},
+	})
+
+	ctx := withRouteCluster(context.Background(), "cluster-b2")
+	assert.Equal(t, "ras-b:1545", routedHost(t, srv, ctx))
+
+	host, ok := srv.Clusters().Lookup("cluster-a")
+	assert.True(t, ok)
+	assert.Equal(t, "ras-a:1545", host)
+
+	// Unknown clusters are not sent to the default host, also between discovery rounds
+	ctx = withRouteCluster(context.Background(), "cluster-x")
+	_, err := srv.route(ctx)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRASServer_RouteDiscoversAllowedHosts(t *testing.T) {
+	srv := newRoutedServer(map[string][]string{
+		"ras-a:1545": {"cluster-a"},
+		"ras-c:1545": {"cluster-c"},
+		"ras-d:1545": {"cluster-d"},
+	})
+	srv.rasHosts = nil
+	srv.AllowHosts("ras-c:1545", "ras-d:1545")
+
+	_, err := srv.RegisterClient("ras-d:1545", 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ras-a:1545", "ras-c:1545", "ras-d:1545"}, srv.discoveryHosts())
+
+	ctx := withRouteCluster(context.Background(), "cluster-c")
+	assert.Equal(t, "ras-c:1545", routedHost(t, srv, ctx), "allowed hosts are searched")
+
+	host, ok := srv.Clusters().Lookup("cluster-d")
+	assert.True(t, ok, "registered hosts are searched")
+	assert.Equal(t, "ras-d:1545", host)
+}
+
+func TestRASServer_RouteSingleHost(t *testing.T) {
+	srv := newRoutedServer(nil)
+	srv.rasHosts = nil
+
+	ctx := withRouteCluster(context.Background(), "cluster-x")
+	assert.Equal(t, "ras-a:1545", routedHost(t, srv, ctx), "a single RAS host serves every cluster")
+}
@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ cluster_service.SecurityProfilesServiceServer = (*rasClientServiceServer)(nil)
+
+// GetSecurityProfiles lists the security profiles of a cluster
+func (s *rasClientServiceServer) GetSecurityProfiles(ctx context.Context, request *cluster_service.GetSecurityProfilesRequest) (*cluster_service.GetSecurityProfilesResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetSecurityProfilesResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CreateSecurityProfile creates a security profile, the name must be free
+func (s *rasClientServiceServer) CreateSecurityProfile(ctx context.Context, request *cluster_service.CreateSecurityProfileRequest) (*emptypb.Empty, error) {
+	return s.saveSecurityProfile(ctx, request, false)
+}
+
+// UpdateSecurityProfile replaces the settings of an existing security profile
+func (s *rasClientServiceServer) UpdateSecurityProfile(ctx context.Context, request *cluster_service.CreateSecurityProfileRequest) (*emptypb.Empty, error) {
+	return s.saveSecurityProfile(ctx, request, true)
+}
+
+// saveSecurityProfile sends CREATE_SECURITY_PROFILE, which RAS uses
+// both to create and to replace a profile. The existence check keeps
+// Create from silently overwriting and Update from creating profiles.
+func (s *rasClientServiceServer) saveSecurityProfile(ctx context.Context, request *cluster_service.CreateSecurityProfileRequest, exists bool) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "profile.name", request.GetProfile().GetName()); err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.GetSecurityProfiles(ctx, &cluster_service.GetSecurityProfilesRequest{ClusterId: request.GetClusterId()})
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, p := range profiles.GetProfiles() {
+		if p.GetName() == request.GetProfile().GetName() {
+			found = true
+			break
+		}
+	}
+	if err := checkExistence("security profile", request.GetProfile().GetName(), exists, found); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Info("Save security profile",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("profile", request.GetProfile().GetName()),
+		zap.Bool("update", exists),
+	)
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// DropSecurityProfile removes a security profile
+func (s *rasClientServiceServer) DropSecurityProfile(ctx context.Context, request *cluster_service.DropSecurityProfileRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "name", request.GetName()); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Warn("DropSecurityProfile request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("profile", request.GetName()),
+	)
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetVirtualDirectories lists the virtual directories allowed by a security profile
+func (s *rasClientServiceServer) GetVirtualDirectories(ctx context.Context, request *cluster_service.GetVirtualDirectoriesRequest) (*cluster_service.GetVirtualDirectoriesResponse, error) {
+	resp := &cluster_service.GetVirtualDirectoriesResponse{}
+	if err := s.profileRequest(ctx, request.GetClusterId(), request.GetProfileName(), "", "", request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateVirtualDirectory adds an entry to the virtual directories of a security profile
+func (s *rasClientServiceServer) CreateVirtualDirectory(ctx context.Context, request *cluster_service.CreateVirtualDirectoryRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "directory.alias", request.GetDirectory().GetAlias(), request)
+}
+
+// DropVirtualDirectory removes an entry from the virtual directories of a security profile
+func (s *rasClientServiceServer) DropVirtualDirectory(ctx context.Context, request *cluster_service.DropVirtualDirectoryRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "alias", request.GetAlias(), request)
+}
+
+// GetCOMClasses lists the COM classes allowed by a security profile
+func (s *rasClientServiceServer) GetCOMClasses(ctx context.Context, request *cluster_service.GetCOMClassesRequest) (*cluster_service.GetCOMClassesResponse, error) {
+	resp := &cluster_service.GetCOMClassesResponse{}
+	if err := s.profileRequest(ctx, request.GetClusterId(), request.GetProfileName(), "", "", request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateCOMClass adds an entry to the COM classes of a security profile
+func (s *rasClientServiceServer) CreateCOMClass(ctx context.Context, request *cluster_service.CreateCOMClassRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "class.name", request.GetClass().GetName(), request)
+}
+
+// DropCOMClass removes an entry from the COM classes of a security profile
+func (s *rasClientServiceServer) DropCOMClass(ctx context.Context, request *cluster_service.DropCOMClassRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "name", request.GetName(), request)
+}
+
+// GetAllowedAddins lists the add-ins allowed by a security profile
+func (s *rasClientServiceServer) GetAllowedAddins(ctx context.Context, request *cluster_service.GetAllowedAddinsRequest) (*cluster_service.GetAllowedAddinsResponse, error) {
+	resp := &cluster_service.GetAllowedAddinsResponse{}
+	if err := s.profileRequest(ctx, request.GetClusterId(), request.GetProfileName(), "", "", request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateAllowedAddin adds an entry to the add-ins of a security profile
+func (s *rasClientServiceServer) CreateAllowedAddin(ctx context.Context, request *cluster_service.CreateAllowedAddinRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "addin.name", request.GetAddin().GetName(), request)
+}
+
+// DropAllowedAddin removes an entry from the add-ins of a security profile
+func (s *rasClientServiceServer) DropAllowedAddin(ctx context.Context, request *cluster_service.DropAllowedAddinRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "name", request.GetName(), request)
+}
+
+// GetExternalModules lists the external modules allowed by a security profile
+func (s *rasClientServiceServer) GetExternalModules(ctx context.Context, request *cluster_service.GetExternalModulesRequest) (*cluster_service.GetExternalModulesResponse, error) {
+	resp := &cluster_service.GetExternalModulesResponse{}
+	if err := s.profileRequest(ctx, request.GetClusterId(), request.GetProfileName(), "", "", request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateExternalModule adds an entry to the external modules of a security profile
+func (s *rasClientServiceServer) CreateExternalModule(ctx context.Context, request *cluster_service.CreateExternalModuleRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "module.name", request.GetModule().GetName(), request)
+}
+
+// DropExternalModule removes an entry from the external modules of a security profile
+func (s *rasClientServiceServer) DropExternalModule(ctx context.Context, request *cluster_service.DropExternalModuleRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "name", request.GetName(), request)
+}
+
+// GetAllowedApplications lists the applications allowed by a security profile
+func (s *rasClientServiceServer) GetAllowedApplications(ctx context.Context, request *cluster_service.GetAllowedApplicationsRequest) (*cluster_service.GetAllowedApplicationsResponse, error) {
+	resp := &cluster_service.GetAllowedApplicationsResponse{}
+	if err := s.profileRequest(ctx, request.GetClusterId(), request.GetProfileName(), "", "", request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateAllowedApplication adds an entry to the applications of a security profile
+func (s *rasClientServiceServer) CreateAllowedApplication(ctx context.Context, request *cluster_service.CreateAllowedApplicationRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "application.name", request.GetApplication().GetName(), request)
+}
+
+// DropAllowedApplication removes an entry from the applications of a security profile
+func (s *rasClientServiceServer) DropAllowedApplication(ctx context.Context, request *cluster_service.DropAllowedApplicationRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "name", request.GetName(), request)
+}
+
+// GetInternetResources lists the internet resources allowed by a security profile
+func (s *rasClientServiceServer) GetInternetResources(ctx context.Context, request *cluster_service.GetInternetResourcesRequest) (*cluster_service.GetInternetResourcesResponse, error) {
+	resp := &cluster_service.GetInternetResourcesResponse{}
+	if err := s.profileRequest(ctx, request.GetClusterId(), request.GetProfileName(), "", "", request, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateInternetResource adds an entry to the internet resources of a security profile
+func (s *rasClientServiceServer) CreateInternetResource(ctx context.Context, request *cluster_service.CreateInternetResourceRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "resource.name", request.GetResource().GetName(), request)
+}
+
+// DropInternetResource removes an entry from the internet resources of a security profile
+func (s *rasClientServiceServer) DropInternetResource(ctx context.Context, request *cluster_service.DropInternetResourceRequest) (*emptypb.Empty, error) {
+	return s.profileUpdate(ctx, request.GetClusterId(), request.GetProfileName(), "name", request.GetName(), request)
+}
+
+// profileUpdate sends a request changing a list of a security profile
+func (s *rasClientServiceServer) profileUpdate(ctx context.Context, clusterID, profileName, keyField, key string, request proto.Message) (*emptypb.Empty, error) {
+
+	logger.Log.Info("Security profile update",
+		zap.String("cluster_id", clusterID),
+		zap.String("profile", profileName),
+		zap.String("request", string(request.ProtoReflect().Descriptor().Name())),
+		zap.String(keyField, key),
+	)
+
+	if err := s.profileRequest(ctx, clusterID, profileName, keyField, key, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// profileRequest validates the security profile reference and sends request,
+// keyField names the required entry key, if any
+func (s *rasClientServiceServer) profileRequest(ctx context.Context, clusterID, profileName, keyField, key string, request, resp proto.Message) error {
+
+	if err := requireIDs("cluster_id", clusterID, "profile_name", profileName); err != nil {
+		return err
+	}
+	if keyField != "" {
+		if err := requireIDs(keyField, key); err != nil {
+			return err
+		}
+	}
+
+	return s.endpointRequest(ctx, request, resp)
+}
+
+// checkExistence reports a create of an existing or an update of
+// a missing named object, RAS itself does not tell them apart
+func checkExistence(kind, name string, update, found bool) error {
+	switch {
+	case update && !found:
+		return status.Errorf(codes.NotFound, "%s %q not found", kind, name)
+	case !update && found:
+		return status.Errorf(codes.AlreadyExists, "%s %q already exists", kind, name)
+	}
+	return nil
+}
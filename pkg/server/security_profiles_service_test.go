@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	pb "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// profilesEndpoint knows the profiles and records every sent message
+func profilesEndpoint(profiles []string, sent *[]proto.Message) *rasClientServiceServer {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			*sent = append(*sent, msg)
+			if _, ok := msg.(*cluster_service.GetSecurityProfilesRequest); ok {
+				resp := &cluster_service.GetSecurityProfilesResponse{}
+				for _, name := range profiles {
+					resp.Profiles = append(resp.Profiles, &cluster_service.SecurityProfileInfo{Name: name})
+				}
+				return anypb.New(resp)
+			}
+			return anypb.New(&emptypb.Empty{})
+		},
+	}
+	return newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+}
+
+func TestSecurityProfile_CreateUpdate(t *testing.T) {
+	var sent []proto.Message
+	srv := profilesEndpoint([]string{"restricted"}, &sent)
+	ctx := context.Background()
+
+	_, err := srv.CreateSecurityProfile(ctx, &cluster_service.CreateSecurityProfileRequest{
+		ClusterId: testClusterID,
+		Profile:   &cluster_service.SecurityProfileInfo{Name: "restricted"},
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = srv.UpdateSecurityProfile(ctx, &cluster_service.CreateSecurityProfileRequest{
+		ClusterId: testClusterID,
+		Profile:   &cluster_service.SecurityProfileInfo{Name: "missing"},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.UpdateSecurityProfile(ctx, &cluster_service.CreateSecurityProfileRequest{
+		ClusterId: testClusterID,
+		Profile:   &cluster_service.SecurityProfileInfo{Name: "restricted", CryptographyAllowed: true},
+	})
+	require.NoError(t, err)
+
+	last := sent[len(sent)-1].(*cluster_service.CreateSecurityProfileRequest)
+	assert.True(t, last.GetProfile().GetCryptographyAllowed())
+}
+
+func TestSecurityProfile_Lists(t *testing.T) {
+	var sent []proto.Message
+	srv := profilesEndpoint(nil, &sent)
+	ctx := context.Background()
+
+	_, err := srv.CreateInternetResource(ctx, &cluster_service.CreateInternetResourceRequest{
+		ClusterId:   testClusterID,
+		ProfileName: "restricted",
+		Resource:    &cluster_service.InternetResourceInfo{Protocol: "https", Url: "api.example.org", Port: 443},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "resource name is required")
+
+	_, err = srv.DropVirtualDirectory(ctx, &cluster_service.DropVirtualDirectoryRequest{ClusterId: testClusterID, Alias: "tmp"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "profile name is required")
+	assert.Empty(t, sent)
+
+	_, err = srv.CreateVirtualDirectory(ctx, &cluster_service.CreateVirtualDirectoryRequest{
+		ClusterId:   testClusterID,
+		ProfileName: "restricted",
+		Directory:   &cluster_service.VirtualDirectoryInfo{Alias: "tmp", PhysicalPath: "/tmp", AllowedRead: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "/tmp", sent[0].(*cluster_service.CreateVirtualDirectoryRequest).GetDirectory().GetPhysicalPath())
+}
+
+func TestUpdateInfobase_SetsSecurityProfiles(t *testing.T) {
+	var requests []*anypb.Any
+	srv := NewInfobaseManagementServer(authCapture(&requests))
+
+	_, err := srv.UpdateInfobase(context.Background(), &pb.UpdateInfobaseRequest{
+		ClusterId:                   testClusterID,
+		InfobaseId:                  testInfobaseID,
+		SecurityProfileName:         proto.String("restricted"),
+		SafeModeSecurityProfileName: proto.String("external-processing"),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, requests, 1)
+	var info serializev1.InfobaseInfo
+	require.NoError(t, requests[0].UnmarshalTo(&info))
+	assert.Equal(t, "restricted", info.GetSecurityProfileName())
+	assert.Equal(t, "external-processing", info.GetSafeModeSecurityProfileName())
+}
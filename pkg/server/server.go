@@ -6,21 +6,23 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
-	_ "github.com/lithammer/shortuuid/v3"
-	"github.com/spf13/cast"
 	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
 	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
-	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
 	ras_service "github.com/v8platform/protos/gen/ras/service/api/v1"
 	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	"github.com/v8platform/ras-grpc-gw/pkg/auth"
 	"github.com/v8platform/ras-grpc-gw/pkg/client"
 	access_service "github.com/v8platform/ras-grpc-gw/pkg/gen/access/service"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
 	infobase_service "github.com/v8platform/ras-grpc-gw/pkg/gen/infobase/service"
 	"github.com/v8platform/ras-grpc-gw/pkg/logger"
 "github.com/v8platform/ras-grpc-gw/pkg/interceptor"
+	"github.com/v8platform/ras-grpc-gw/pkg/schedule"
 	"github.com/v8platform/ras-grpc-gw/pkg/tlsconfig"
+	"github.com/v8platform/ras-grpc-gw/pkg/vault"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -31,19 +33,27 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-func NewRASServer(rasAddr string) *RASServer {
+// NewRASServer creates a gateway for the RAS service at rasAddr.
+// Additional hosts may be selected per request, see RASServer.GetEndpoint.
+func NewRASServer(rasAddr string, hosts ...string) *RASServer {
 	return &RASServer{
-		rasAddr: rasAddr,
+		rasAddr:  rasAddr,
+		rasHosts: hosts,
 	}
 }
 
 type RASServer struct {
-	rasAddr    string
-	grpcServer *grpc.Server
-	rasService *rasClientServiceServer // Added for HTTP handler access
-
-	idxClients   map[string]*ClientInfo
-	idxEndpoints map[string]*EndpointInfo
+	rasAddr      string
+	rasHosts     []string // Additional RAS services served by the gateway
+	allowedHosts []string // RAS services clients may register, see AllowHosts
+	grpcServer   *grpc.Server
+	rasService   *rasClientServiceServer // Added for HTTP handler access
+
+	mu           sync.Mutex
+	clusters     *ClusterRegistry
+	newClient    func(host string, idleTimeout time.Duration) RASClient
+	idxClients   map[string]*ClientInfo   // client_id -> client
+	idxEndpoints map[string]*EndpointInfo // endpoint_id -> owning client
 }
 
 type EndpointInfo struct {
@@ -52,9 +62,11 @@ type EndpointInfo struct {
 	EndpointId string
 }
 
+// ClientInfo is a RAS service the gateway talks to
 type ClientInfo struct {
 	uuid        string
-	conn        *client.ClientConn
+	host        string
+	client      RASClient
 	IdleTimeout time.Duration
 }
 
@@ -65,11 +77,10 @@ func (s *RASServer) Serve(host string) error {
 		return fmt.Errorf("failed to listen on %s: %w", host, err)
 	}
 
-	srv := NewRasClientServiceServer(s.rasAddr)
-	// Store for HTTP handler access (type assertion)
-	if rasService, ok := srv.(*rasClientServiceServer); ok {
-		s.rasService = rasService
-	}
+	// Requests are routed to the pool of the selected RAS service
+	var rasClient RASClient = s
+
+	srv := newRasClientServiceServer(rasClient)
 
 	// Load TLS configuration
 	tlsConfig, err := tlsconfig.LoadTLSConfig(logger.Log)
@@ -77,14 +88,73 @@ func (s *RASServer) Serve(host string) error {
 		return fmt.Errorf("failed to load TLS config: %w", err)
 	}
 
+	// Load TokenService configuration
+	authConfig, err := auth.LoadConfig(logger.Log)
+	if err != nil {
+		return fmt.Errorf("failed to load auth config: %w", err)
+	}
+	tokens, err := authConfig.NewManager()
+	if err != nil {
+		return fmt.Errorf("failed to create token manager: %w", err)
+	}
+	defer tokens.Close()
+	users, err := authConfig.NewUserStore()
+	if err != nil {
+		return fmt.Errorf("failed to load users: %w", err)
+	}
+
+	policy, err := authConfig.NewPolicy()
+	if err != nil {
+		return fmt.Errorf("failed to load auth policy: %w", err)
+	}
+
+	accessSrv := NewAccessServer(WithClientRegistry(s), WithTokens(tokens, users))
+
+	// Load stored RAS credentials
+	vaultConfig, err := vault.LoadConfig(logger.Log)
+	if err != nil {
+		return fmt.Errorf("failed to load vault config: %w", err)
+	}
+	credVault, err := vaultConfig.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open credential vault: %w", err)
+	}
+	srv.vault = credVault
+
+	watchInterval, err := LoadSessionsWatchInterval()
+	if err != nil {
+		return err
+	}
+	srv.watchers = newSessionWatchers(srv.openSessionsReader, watchInterval)
+
 	// Setup gRPC server options with interceptors
 	var opts []grpc.ServerOption
 
 	// Add interceptors
-	opts = append(opts, grpc.ChainUnaryInterceptor(
+	var unary []grpc.UnaryServerInterceptor
+	var stream []grpc.StreamServerInterceptor
+	if authConfig.Enabled {
+		srv.tokens = accessSrv
+		unary = append(unary, interceptor.AuthInterceptor(logger.Log, accessSrv))
+		stream = append(stream, interceptor.AuthStreamInterceptor(logger.Log, accessSrv))
+	} else {
+		logger.Log.Warn("Authentication disabled - RAS administration open to anyone! Enable with AUTH_ENABLED=true")
+	}
+	unary = append(unary,
 		interceptor.SanitizePasswordsInterceptor(logger.Log),
 		interceptor.AuditInterceptor(logger.Log),
-	))
+	)
+	stream = append(stream,
+		interceptor.SanitizePasswordsStreamInterceptor(logger.Log),
+		interceptor.AuditStreamInterceptor(logger.Log),
+	)
+	if authConfig.Enabled {
+		srv.policy = policy
+		unary = append(unary, interceptor.AuthorizeInterceptor(policy))
+		stream = append(stream, interceptor.AuthorizeStreamInterceptor(policy))
+	}
+	unary = append(unary, RouteInterceptor())
+	opts = append(opts, grpc.ChainUnaryInterceptor(unary...), grpc.ChainStreamInterceptor(stream...))
 
 	// Add TLS if enabled
 	if tlsConfig != nil {
@@ -102,17 +172,41 @@ func (s *RASServer) Serve(host string) error {
 	ras_service.RegisterClustersServiceServer(s.grpcServer, srv)
 	ras_service.RegisterSessionsServiceServer(s.grpcServer, srv)
 	ras_service.RegisterInfobasesServiceServer(s.grpcServer, srv)
-
-	accessSrv := NewAccessServer()
+	cluster_service.RegisterWorkingProcessesServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterWorkingServersServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterConnectionsServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterLocksServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterAssignmentRulesServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterSecurityProfilesServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterResourcesServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterAdminsServiceServer(s.grpcServer, srv)
+	cluster_service.RegisterSessionsServiceServer(s.grpcServer, srv)
 
 	access_service.RegisterClientServiceServer(s.grpcServer, accessSrv)
 	access_service.RegisterTokenServiceServer(s.grpcServer, accessSrv)
 
 	// Register InfobaseManagementService (Sprint 3.2, Day 1-2)
-	rasClient := NewRASClient(s.rasAddr)
-	infobaseMgmtSrv := NewInfobaseManagementServer(rasClient)
+	infobaseMgmtSrv := NewInfobaseManagementServer(rasClient, WithCredentialVault(credVault))
 	infobase_service.RegisterInfobaseManagementServiceServer(s.grpcServer, infobaseMgmtSrv)
 
+	// Lock windows tracked by the gateway
+	scheduledLocks, err := schedule.LoadConfig(logger.Log).Open(scheduledLockApplier{s: infobaseMgmtSrv}, logger.Log)
+	if err != nil {
+		return fmt.Errorf("failed to open scheduled locks: %w", err)
+	}
+	if scheduledLocks != nil {
+		infobaseMgmtSrv.scheduledLocks = scheduledLocks
+		locksCtx, stopLocks := context.WithCancel(context.Background())
+		defer stopLocks()
+		go scheduledLocks.Run(locksCtx)
+	}
+	access_service.RegisterCredentialServiceServer(s.grpcServer, NewCredentialServer(credVault, WithScheduledLocks(scheduledLocks)))
+
+	// Published for the HTTP handlers once fully configured
+	s.mu.Lock()
+	s.rasService = srv
+	s.mu.Unlock()
+
 	logger.Log.Info("Listening on", zap.String("address", host))
 	if err := s.grpcServer.Serve(listener); err != nil {
 		return fmt.Errorf("failed to serve gRPC server: %w", err)
@@ -136,11 +230,13 @@ func (s *RASServer) GracefulStop(ctx context.Context) error {
 		select {
 		case <-stopped:
 			logger.Log.Info("gRPC server stopped gracefully")
+			s.closeClients()
 			return nil
 		case <-ctx.Done():
 			// Если таймаут - форсируем остановку
 			logger.Log.Warn("Graceful shutdown timeout, forcing stop")
 			s.grpcServer.Stop()
+			s.closeClients()
 			return ctx.Err()
 		}
 	}
@@ -169,26 +265,51 @@ func (s *RASServer) Check(ctx context.Context) error {
 }
 
 // GetTerminateSessionHandler returns HTTP handler for TerminateSession endpoint
-// This allows health server to expose /api/v1/sessions/terminate for HTTP clients
+// This allows health server to expose /api/v1/sessions/terminate for HTTP clients.
+// The handler may be registered before Serve, the service is looked up per request.
 func (s *RASServer) GetTerminateSessionHandler() http.HandlerFunc {
-	if s.rasService == nil {
-		// Return error handler if service not initialized
-		return func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		srv := s.rasService
+		s.mu.Unlock()
+
+		if srv == nil {
+			// Serve has not configured the service yet
 			http.Error(w, "RAS service not initialized", http.StatusServiceUnavailable)
+			return
 		}
+		srv.HandleTerminateSession(w, r)
 	}
-	return s.rasService.HandleTerminateSession
 }
 
 func NewRasClientServiceServer(rasAddr string) ras_service.RASServiceServer {
-	return &rasClientServiceServer{
-		client: client.NewClientConn(rasAddr),
+	return newRasClientServiceServer(NewRASClient(rasAddr))
+}
+
+func newRasClientServiceServer(client RASClient) *rasClientServiceServer {
+	srv := &rasClientServiceServer{
+		client: client,
 	}
+	srv.watchers = newSessionWatchers(srv.openSessionsReader, defaultSessionsWatchInterval)
+	return srv
 }
 
 type rasClientServiceServer struct {
 	ras_service.UnimplementedRASServiceServer
-	client *client.ClientConn
+	cluster_service.UnimplementedWorkingProcessesServiceServer
+	cluster_service.UnimplementedWorkingServersServiceServer
+	cluster_service.UnimplementedConnectionsServiceServer
+	cluster_service.UnimplementedLocksServiceServer
+	cluster_service.UnimplementedAssignmentRulesServiceServer
+	cluster_service.UnimplementedSecurityProfilesServiceServer
+	cluster_service.UnimplementedResourcesServiceServer
+	cluster_service.UnimplementedAdminsServiceServer
+	cluster_service.UnimplementedSessionsServiceServer
+	client   RASClient
+	vault    *vault.Vault               // Credentials of requests without a user
+	watchers *sessionWatchers           // Shared RAS polls of WatchSessions
+	tokens   interceptor.TokenValidator // Authenticates HTTP requests, nil when authentication is disabled
+	policy   interceptor.Authorizer     // Authorizes HTTP requests, nil when authentication is disabled
 }
 
 func (s *rasClientServiceServer) AuthenticateCluster(ctx context.Context, request *messagesv1.ClusterAuthenticateRequest) (*emptypb.Empty, error) {
@@ -196,6 +317,16 @@ func (s *rasClientServiceServer) AuthenticateCluster(ctx context.Context, reques
 	var resp *emptypb.Empty
 	var err error
 
+	if request.GetUser() == "" {
+		if stored, ok := s.vault.Cluster(request.GetClusterId()); ok {
+			request = &messagesv1.ClusterAuthenticateRequest{
+				ClusterId: request.GetClusterId(),
+				User:      stored.User,
+				Password:  stored.Password,
+			}
+		}
+	}
+
 	err = s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
 		auth := clientv1.NewAuthService(endpoint)
 		resp, err = auth.AuthenticateCluster(ctx, request)
@@ -219,15 +350,13 @@ func (s *rasClientServiceServer) withEndpoint(ctx context.Context, fn func(clien
 
 	defer func() {
 		if err == nil {
-			var endpointID string
-
-			// Type assertion для извлечения endpoint ID
-			// endpointService встраивает protocolv1.EndpointImpl через embedded field
-			if endpointImpl, ok := endpoint.(protocolv1.EndpointImpl); ok {
-				endpointID = cast.ToString(endpointImpl.GetId())
-				log.Printf("[withEndpoint] Sending endpoint_id in headers: %s (extracted via type assertion)", endpointID)
+			// Pooled endpoints carry their own process-wide ID,
+			// plain endpoints fall back to the RAS endpoint ID
+			endpointID := client.EndpointID(endpoint)
+			if endpointID != "" {
+				log.Printf("[withEndpoint] Sending endpoint_id in headers: %s", endpointID)
 			} else {
-				log.Printf("[withEndpoint] WARNING: Cannot extract endpoint ID (type assertion to protocolv1.EndpointImpl failed)")
+				log.Printf("[withEndpoint] WARNING: Cannot extract endpoint ID from %T", endpoint)
 			}
 
 			if endpointID != "" {
@@ -242,11 +371,27 @@ func (s *rasClientServiceServer) withEndpoint(ctx context.Context, fn func(clien
 	return fn(endpoint)
 }
 
+// metadataInfobase selects the stored credentials used by AuthenticateInfobase
+const metadataInfobase = "infobase_id"
+
 func (s *rasClientServiceServer) AuthenticateInfobase(ctx context.Context, request *messagesv1.AuthenticateInfobaseRequest) (*emptypb.Empty, error) {
 
 	var resp *emptypb.Empty
 	var err error
 
+	// The request names no infobase, the infobase_id metadata selects
+	// stored credentials of a particular one
+	if request.GetUser() == "" {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if stored, ok := s.vault.Infobase(request.GetClusterId(), firstMetadata(md, metadataInfobase)); ok {
+			request = &messagesv1.AuthenticateInfobaseRequest{
+				ClusterId: request.GetClusterId(),
+				User:      stored.User,
+				Password:  stored.Password,
+			}
+		}
+	}
+
 	err = s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
 		auth := clientv1.NewAuthService(endpoint)
 		resp, err = auth.AuthenticateInfobase(ctx, request)
@@ -0,0 +1,445 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	defaultSessionsPageSize = 100
+	maxSessionsPageSize     = 1000
+)
+
+var _ cluster_service.SessionsServiceServer = (*rasClientServiceServer)(nil)
+
+// GetSession returns a single session of a cluster
+func (s *rasClientServiceServer) GetSession(ctx context.Context, request *cluster_service.GetSessionRequest) (*cluster_service.GetSessionResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "session_id", request.GetSessionId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetSessionInfoResponse{}
+	err := s.clusterRequest(ctx, request.GetClusterId(), &cluster_service.GetSessionInfoRequest{
+		ClusterId: request.GetClusterId(),
+		SessionId: request.GetSessionId(),
+	}, resp)
+	if err != nil && !s.sessionGone(ctx, request.GetClusterId(), request.GetSessionId(), err) {
+		return nil, err
+	}
+	if err != nil || resp.GetInfo().GetUuid() == "" {
+		return nil, status.Errorf(codes.NotFound, "session %s not found", request.GetSessionId())
+	}
+
+	return &cluster_service.GetSessionResponse{Session: resp.GetInfo()}, nil
+}
+
+// sessionGone reports whether RAS failed a request for sessionID because
+// the session does not exist. RAS errors carry no code, only a localized
+// message, so the session is confirmed missing from the cluster's session
+// list. Errors of the gateway itself are gRPC statuses and never mean that.
+func (s *rasClientServiceServer) sessionGone(ctx context.Context, clusterID, sessionID string, err error) bool {
+	if _, ok := status.FromError(err); ok {
+		return false
+	}
+
+	sessions, listErr := s.clusterSessions(ctx, clusterID)
+	if listErr != nil {
+		return false
+	}
+	for _, session := range sessions {
+		if session.GetUuid() == sessionID {
+			return false
+		}
+	}
+	return true
+}
+
+// ListSessions lists the sessions of a cluster matching the filter.
+// RAS has no server side filtering, every page is cut from a fresh
+// session list, so sessions started or finished between the pages
+// may shift the page boundaries.
+func (s *rasClientServiceServer) ListSessions(ctx context.Context, request *cluster_service.ListSessionsRequest) (*cluster_service.ListSessionsResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	pageSize := int(request.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultSessionsPageSize
+	case pageSize > maxSessionsPageSize:
+		pageSize = maxSessionsPageSize
+	}
+
+	fingerprint := sessionsQueryFingerprint(request)
+	offset := 0
+	if request.GetPageToken() != "" {
+		var err error
+		if offset, err = decodeSessionsPageToken(request.GetPageToken(), fingerprint); err != nil {
+			return nil, err
+		}
+	}
+
+	sessions, err := s.clusterSessions(ctx, request.GetClusterId())
+	if err != nil {
+		return nil, err
+	}
+
+	matched := filterSessions(sessions, request.GetFilter(), time.Now())
+	sortSessions(matched, request.GetSortBy(), request.GetDescending())
+
+	resp := &cluster_service.ListSessionsResponse{TotalSize: int32(len(matched))}
+	if offset >= len(matched) {
+		return resp, nil
+	}
+
+	end := offset + pageSize
+	if end < len(matched) {
+		resp.NextPageToken = encodeSessionsPageToken(end, fingerprint)
+	} else {
+		end = len(matched)
+	}
+	resp.Sessions = matched[offset:end]
+
+	return resp, nil
+}
+
+// TerminateSessions terminates every session matching the filter.
+// A failed session does not stop the others, the outcome of each
+// session is reported in the results.
+func (s *rasClientServiceServer) TerminateSessions(ctx context.Context, request *cluster_service.TerminateSessionsRequest) (*cluster_service.TerminateSessionsResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+	if request.GetFilter() == nil || proto.Size(request.GetFilter()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "filter is required")
+	}
+
+	// One endpoint authenticated once serves the listing and every termination
+	var resp *cluster_service.TerminateSessionsResponse
+	err := s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+		if err := s.authenticateCluster(ctx, endpoint, request.GetClusterId()); err != nil {
+			return err
+		}
+		sessions, err := endpointSessions(ctx, endpoint, request.GetClusterId())
+		if err != nil {
+			return err
+		}
+		resp = terminateMatchedSessions(ctx, endpoint, request, sessions)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !request.GetDryRun() {
+		logger.Log.Info("TerminateSessions finished",
+			zap.String("cluster_id", request.GetClusterId()),
+			zap.Int32("terminated", resp.Terminated),
+			zap.Int32("failed", resp.Failed),
+		)
+	}
+
+	return resp, nil
+}
+
+// terminateMatchedSessions terminates the sessions matching the filter
+// of request one by one, the endpoint serializes its requests anyway
+func terminateMatchedSessions(
+	ctx context.Context,
+	endpoint clientv1.EndpointServiceImpl,
+	request *cluster_service.TerminateSessionsRequest,
+	sessions []*serializev1.SessionInfo,
+) *cluster_service.TerminateSessionsResponse {
+
+	matched := filterSessions(sessions, request.GetFilter(), time.Now())
+	sortSessions(matched, cluster_service.SessionSortField_SESSION_SORT_FIELD_UNSPECIFIED, false)
+
+	logger.Log.Warn("TerminateSessions request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.Int("matched", len(matched)),
+		zap.Bool("dry_run", request.GetDryRun()),
+	)
+
+	resp := &cluster_service.TerminateSessionsResponse{
+		Results: make([]*cluster_service.SessionTerminationResult, len(matched)),
+		Matched: int32(len(matched)),
+	}
+	if request.GetDryRun() {
+		for i, session := range matched {
+			resp.Results[i] = &cluster_service.SessionTerminationResult{
+				Session: session,
+				Status:  cluster_service.SessionTerminationStatus_SESSION_TERMINATION_STATUS_MATCHED,
+			}
+		}
+		return resp
+	}
+
+	for i, session := range matched {
+		result := terminateSession(ctx, endpoint, request.GetClusterId(), session, request.GetMessage())
+		resp.Results[i] = result
+		if result.GetStatus() == cluster_service.SessionTerminationStatus_SESSION_TERMINATION_STATUS_TERMINATED {
+			resp.Terminated++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	return resp
+}
+
+// terminateSession terminates a single session on an authenticated
+// endpoint and reports the outcome
+func terminateSession(ctx context.Context, endpoint clientv1.EndpointServiceImpl, clusterID string, session *serializev1.SessionInfo, message string) *cluster_service.SessionTerminationResult {
+
+	result := &cluster_service.SessionTerminationResult{Session: session}
+
+	err := ctx.Err()
+	if err == nil {
+		err = sendEndpointRequest(ctx, endpoint, &cluster_service.TerminateSessionRequest{
+			ClusterId: clusterID,
+			SessionId: session.GetUuid(),
+			Message:   message,
+		}, &emptypb.Empty{})
+	}
+	if err != nil {
+		logger.Log.Error("Failed to terminate session via RAS",
+			zap.String("cluster_id", clusterID),
+			zap.String("session_id", session.GetUuid()),
+			zap.Error(err),
+		)
+		result.Status = cluster_service.SessionTerminationStatus_SESSION_TERMINATION_STATUS_FAILED
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = cluster_service.SessionTerminationStatus_SESSION_TERMINATION_STATUS_TERMINATED
+	return result
+}
+
+// clusterSessions reads all sessions of a cluster on an endpoint
+// authenticated with the stored cluster administrator
+func (s *rasClientServiceServer) clusterSessions(ctx context.Context, clusterID string) ([]*serializev1.SessionInfo, error) {
+
+	var sessions []*serializev1.SessionInfo
+	err := s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+		if err := s.authenticateCluster(ctx, endpoint, clusterID); err != nil {
+			return err
+		}
+		var err error
+		sessions, err = endpointSessions(ctx, endpoint, clusterID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// endpointSessions reads all sessions of a cluster on an authenticated endpoint
+func endpointSessions(ctx context.Context, endpoint clientv1.EndpointServiceImpl, clusterID string) ([]*serializev1.SessionInfo, error) {
+	resp, err := clientv1.NewSessionsService(endpoint).GetSessions(ctx, &messagesv1.GetSessionsRequest{ClusterId: clusterID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSessions(), nil
+}
+
+// filterSessions returns the sessions matching filter, now is the
+// reference point of the idle time
+func filterSessions(sessions []*serializev1.SessionInfo, filter *cluster_service.SessionFilter, now time.Time) []*serializev1.SessionInfo {
+
+	matched := make([]*serializev1.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		if matchSession(session, filter, now) {
+			matched = append(matched, session)
+		}
+	}
+	return matched
+}
+
+// matchSession reports whether session satisfies every set field of filter
+func matchSession(session *serializev1.SessionInfo, filter *cluster_service.SessionFilter, now time.Time) bool {
+
+	if filter == nil {
+		return true
+	}
+	if filter.GetInfobaseId() != "" && session.GetInfobaseId() != filter.GetInfobaseId() {
+		return false
+	}
+	if filter.GetUserName() != "" && !strings.EqualFold(session.GetUserName(), filter.GetUserName()) {
+		return false
+	}
+	if filter.GetHost() != "" && !strings.EqualFold(session.GetHost(), filter.GetHost()) {
+		return false
+	}
+	if len(filter.GetAppIds()) > 0 && !containsFold(filter.GetAppIds(), session.GetAppId()) {
+		return false
+	}
+	if containsFold(filter.GetExcludeAppIds(), session.GetAppId()) ||
+		containsFold(filter.GetExcludeUserNames(), session.GetUserName()) {
+		return false
+	}
+	if filter.Hibernated != nil && session.GetHibernate() != filter.GetHibernated() {
+		return false
+	}
+	if idle := filter.GetIdleLongerThan(); idle != nil && now.Sub(sessionLastActive(session)) <= idle.AsDuration() {
+		return false
+	}
+	if filter.GetLicenseType() != cluster_service.SessionLicenseType_SESSION_LICENSE_TYPE_UNSPECIFIED &&
+		sessionLicenseType(session) != filter.GetLicenseType() {
+		return false
+	}
+	return true
+}
+
+// sessionLastActive is the last activity of session, sessions without
+// a call yet are active since their start
+func sessionLastActive(session *serializev1.SessionInfo) time.Time {
+	if session.GetLastActiveAt() != nil {
+		return session.GetLastActiveAt().AsTime()
+	}
+	return session.GetStartedAt().AsTime()
+}
+
+// sessionLicenseType maps the RAS license type of the first license
+// of session, RAS reports 0 for software licenses and 1 for HASP keys
+func sessionLicenseType(session *serializev1.SessionInfo) cluster_service.SessionLicenseType {
+	if len(session.GetLicenses()) == 0 {
+		return cluster_service.SessionLicenseType_SESSION_LICENSE_TYPE_NONE
+	}
+	if session.GetLicenses()[0].GetLicenseType() == 1 {
+		return cluster_service.SessionLicenseType_SESSION_LICENSE_TYPE_HASP
+	}
+	return cluster_service.SessionLicenseType_SESSION_LICENSE_TYPE_SOFTWARE
+}
+
+// sortSessions orders sessions by field, ties are broken by the session
+// UUID so that pages stay stable between requests
+func sortSessions(sessions []*serializev1.SessionInfo, field cluster_service.SessionSortField, descending bool) {
+
+	compare := func(a, b *serializev1.SessionInfo) int {
+		switch field {
+		case cluster_service.SessionSortField_SESSION_SORT_FIELD_LAST_ACTIVE_AT:
+			return compareTime(sessionLastActive(a), sessionLastActive(b))
+		case cluster_service.SessionSortField_SESSION_SORT_FIELD_USER_NAME:
+			return strings.Compare(strings.ToLower(a.GetUserName()), strings.ToLower(b.GetUserName()))
+		case cluster_service.SessionSortField_SESSION_SORT_FIELD_APP_ID:
+			return strings.Compare(a.GetAppId(), b.GetAppId())
+		case cluster_service.SessionSortField_SESSION_SORT_FIELD_HOST:
+			return strings.Compare(strings.ToLower(a.GetHost()), strings.ToLower(b.GetHost()))
+		case cluster_service.SessionSortField_SESSION_SORT_FIELD_SESSION_NUMBER:
+			return compareInt(int64(a.GetId()), int64(b.GetId()))
+		case cluster_service.SessionSortField_SESSION_SORT_FIELD_MEMORY_TOTAL:
+			return compareInt(a.GetMemoryTotal(), b.GetMemoryTotal())
+		default:
+			return compareTime(a.GetStartedAt().AsTime(), b.GetStartedAt().AsTime())
+		}
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		c := compare(sessions[i], sessions[j])
+		if c == 0 {
+			return sessions[i].GetUuid() < sessions[j].GetUuid()
+		}
+		if descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+func compareTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// sessionsPageToken is the decoded page token of ListSessions
+type sessionsPageToken struct {
+	Offset      int    `json:"o"`
+	Fingerprint uint64 `json:"f"`
+}
+
+// sessionsQueryFingerprint hashes the query of request without the
+// paging fields, a page token is only valid for the query it came from
+func sessionsQueryFingerprint(request *cluster_service.ListSessionsRequest) uint64 {
+	query := &cluster_service.ListSessionsRequest{
+		ClusterId:  request.GetClusterId(),
+		Filter:     request.GetFilter(),
+		SortBy:     request.GetSortBy(),
+		Descending: request.GetDescending(),
+	}
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(query)
+
+	h := fnv.New64a()
+	_, _ = h.Write(data)
+	return h.Sum64()
+}
+
+func encodeSessionsPageToken(offset int, fingerprint uint64) string {
+	data, _ := json.Marshal(sessionsPageToken{Offset: offset, Fingerprint: fingerprint})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeSessionsPageToken(token string, fingerprint uint64) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	var decoded sessionsPageToken
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Offset < 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	if decoded.Fingerprint != fingerprint {
+		return 0, status.Error(codes.InvalidArgument, "page_token does not match the filter and sorting of the request")
+	}
+
+	return decoded.Offset, nil
+}
@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sessionsEndpoint answers GetSessions and GetSessionInfo with sessions,
+// terminate requests are passed to terminate when it is set
+func sessionsEndpoint(sessions []*serializev1.SessionInfo, terminate ...func(*cluster_service.TerminateSessionRequest) error) *rasClientServiceServer {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			switch msg := msg.(type) {
+			case *messagesv1.GetSessionsRequest:
+				return anypb.New(&messagesv1.GetSessionsResponse{Sessions: sessions})
+			case *cluster_service.GetSessionInfoRequest:
+				for _, session := range sessions {
+					if session.GetUuid() == msg.GetSessionId() {
+						return anypb.New(&cluster_service.GetSessionInfoResponse{Info: session})
+					}
+				}
+				return nil, errors.New("Сеанс не найден")
+			case *cluster_service.TerminateSessionRequest:
+				if len(terminate) > 0 {
+					if err := terminate[0](msg); err != nil {
+						return nil, err
+					}
+				}
+				return anypb.New(&emptypb.Empty{})
+			}
+			return nil, status.Errorf(codes.Unimplemented, "unexpected %T", msg)
+		},
+	}
+	return newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+}
+
+// countingClient counts the endpoints opened and closed through RASClient
+type countingClient struct {
+	RASClient
+	opened atomic.Int32
+	closed atomic.Int32
+}
+
+func (c *countingClient) GetEndpoint(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+	c.opened.Add(1)
+	return c.RASClient.GetEndpoint(ctx)
+}
+
+func (c *countingClient) CloseEndpoint(ctx context.Context, id string) error {
+	c.closed.Add(1)
+	return nil
+}
+
+func testSessions(now time.Time) []*serializev1.SessionInfo {
+	ago := func(d time.Duration) *timestamppb.Timestamp { return timestamppb.New(now.Add(-d)) }
+	return []*serializev1.SessionInfo{
+		{Uuid: "s-1", Id: 1, AppId: "1CV8C", UserName: "Ivanov", Host: "PC-01", InfobaseId: testInfobaseID,
+			StartedAt: ago(3 * time.Hour), LastActiveAt: ago(2 * time.Hour),
+			Licenses: []*serializev1.LicenseInfo{{LicenseType: 0}}},
+		{Uuid: "s-2", Id: 2, AppId: "Designer", UserName: "admin", Host: "pc-02", InfobaseId: testInfobaseID,
+			StartedAt: ago(2 * time.Hour), LastActiveAt: ago(time.Minute), Hibernate: true,
+			Licenses: []*serializev1.LicenseInfo{{LicenseType: 1}}},
+		{Uuid: "s-3", Id: 3, AppId: "BackgroundJob", InfobaseId: testServerID,
+			StartedAt: ago(time.Hour), LastActiveAt: ago(30 * time.Minute)},
+		{Uuid: "s-4", Id: 4, AppId: "1CV8C", UserName: "petrov", Host: "PC-01", InfobaseId: testInfobaseID,
+			StartedAt: ago(30 * time.Second), MemoryTotal: 1 << 20,
+			Licenses: []*serializev1.LicenseInfo{{LicenseType: 0}}},
+	}
+}
+
+func sessionIDs(sessions []*serializev1.SessionInfo) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.GetUuid())
+	}
+	return ids
+}
+
+func TestMatchSession_Filters(t *testing.T) {
+	now := time.Now()
+	sessions := testSessions(now)
+
+	tests := []struct {
+		name   string
+		filter *cluster_service.SessionFilter
+		want   []string
+	}{
+		{"no filter", nil, []string{"s-1", "s-2", "s-3", "s-4"}},
+		{"infobase", &cluster_service.SessionFilter{InfobaseId: testInfobaseID}, []string{"s-1", "s-2", "s-4"}},
+		{"user ignores case", &cluster_service.SessionFilter{UserName: "IVANOV"}, []string{"s-1"}},
+		{"app ids", &cluster_service.SessionFilter{AppIds: []string{"designer", "BackgroundJob"}}, []string{"s-2", "s-3"}},
+		{"host", &cluster_service.SessionFilter{Host: "pc-01"}, []string{"s-1", "s-4"}},
+		{"hibernated", &cluster_service.SessionFilter{Hibernated: proto.Bool(true)}, []string{"s-2"}},
+		{"active", &cluster_service.SessionFilter{Hibernated: proto.Bool(false), AppIds: []string{"Designer"}}, nil},
+		{"idle", &cluster_service.SessionFilter{IdleLongerThan: durationpb.New(20 * time.Minute)}, []string{"s-1", "s-3"}},
+		{"no license", &cluster_service.SessionFilter{LicenseType: cluster_service.SessionLicenseType_SESSION_LICENSE_TYPE_NONE}, []string{"s-3"}},
+		{"hasp", &cluster_service.SessionFilter{LicenseType: cluster_service.SessionLicenseType_SESSION_LICENSE_TYPE_HASP}, []string{"s-2"}},
+		{"exclusions", &cluster_service.SessionFilter{
+			InfobaseId:       testInfobaseID,
+			ExcludeAppIds:    []string{"Designer"},
+			ExcludeUserNames: []string{"Petrov"},
+		}, []string{"s-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sessionIDs(filterSessions(sessions, tt.filter, now))
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSortSessions(t *testing.T) {
+	sessions := testSessions(time.Now())
+
+	sortSessions(sessions, cluster_service.SessionSortField_SESSION_SORT_FIELD_UNSPECIFIED, false)
+	assert.Equal(t, []string{"s-1", "s-2", "s-3", "s-4"}, sessionIDs(sessions))
+
+	sortSessions(sessions, cluster_service.SessionSortField_SESSION_SORT_FIELD_LAST_ACTIVE_AT, true)
+	assert.Equal(t, []string{"s-4", "s-2", "s-3", "s-1"}, sessionIDs(sessions))
+
+	sortSessions(sessions, cluster_service.SessionSortField_SESSION_SORT_FIELD_HOST, false)
+	assert.Equal(t, []string{"s-3", "s-1", "s-4", "s-2"}, sessionIDs(sessions), "equal hosts are ordered by UUID")
+}
+
+func TestListSessions_Pages(t *testing.T) {
+	srv := sessionsEndpoint(testSessions(time.Now()))
+	ctx := context.Background()
+
+	request := &cluster_service.ListSessionsRequest{
+		ClusterId: testClusterID,
+		Filter:    &cluster_service.SessionFilter{InfobaseId: testInfobaseID},
+		SortBy:    cluster_service.SessionSortField_SESSION_SORT_FIELD_SESSION_NUMBER,
+		PageSize:  2,
+	}
+	first, err := srv.ListSessions(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s-1", "s-2"}, sessionIDs(first.GetSessions()))
+	assert.Equal(t, int32(3), first.GetTotalSize())
+	require.NotEmpty(t, first.GetNextPageToken())
+
+	request.PageToken = first.GetNextPageToken()
+	second, err := srv.ListSessions(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s-4"}, sessionIDs(second.GetSessions()))
+	assert.Empty(t, second.GetNextPageToken())
+
+	request.Descending = true
+	_, err = srv.ListSessions(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "token of another query")
+
+	request.PageToken = "not-a-token"
+	_, err = srv.ListSessions(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetSession(t *testing.T) {
+	srv := sessionsEndpoint(testSessions(time.Now()))
+	ctx := context.Background()
+
+	resp, err := srv.GetSession(ctx, &cluster_service.GetSessionRequest{ClusterId: testClusterID, SessionId: "s-2"})
+	require.NoError(t, err)
+	assert.Equal(t, "Designer", resp.GetSession().GetAppId())
+
+	_, err = srv.GetSession(ctx, &cluster_service.GetSessionRequest{ClusterId: testClusterID, SessionId: "s-9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.GetSession(ctx, &cluster_service.GetSessionRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetSession_ConfirmsMissingSession(t *testing.T) {
+	failure := errors.New("Ошибка сервера кластера")
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := msg.(*messagesv1.GetSessionsRequest); ok {
+				return anypb.New(&messagesv1.GetSessionsResponse{Sessions: testSessions(time.Now())})
+			}
+			return nil, failure
+		},
+	}
+	srv := newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+	ctx := context.Background()
+
+	// The message does not matter, the session list does
+	_, err := srv.GetSession(ctx, &cluster_service.GetSessionRequest{ClusterId: testClusterID, SessionId: "s-9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.GetSession(ctx, &cluster_service.GetSessionRequest{ClusterId: testClusterID, SessionId: "s-1"})
+	assert.ErrorIs(t, err, failure, "a listed session is not reported missing")
+}
+
+func TestGetSession_RequestsSingleSession(t *testing.T) {
+	var requests []proto.Message
+	srv := newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return &MockEndpoint{
+				RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+					msg, err := req.Request.UnmarshalNew()
+					if err != nil {
+						return nil, err
+					}
+					requests = append(requests, msg)
+					return anypb.New(&cluster_service.GetSessionInfoResponse{})
+				},
+			}, nil
+		},
+	})
+
+	_, err := srv.GetSession(context.Background(), &cluster_service.GetSessionRequest{ClusterId: testClusterID, SessionId: "s-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "RAS returned no session")
+
+	require.Len(t, requests, 1, "the session list is not downloaded")
+	assert.True(t, proto.Equal(&cluster_service.GetSessionInfoRequest{ClusterId: testClusterID, SessionId: "s-1"}, requests[0]))
+}
+
+func TestTerminateSessions_DryRun(t *testing.T) {
+	var terminated int32
+	srv := sessionsEndpoint(testSessions(time.Now()), func(*cluster_service.TerminateSessionRequest) error {
+		atomic.AddInt32(&terminated, 1)
+		return nil
+	})
+
+	resp, err := srv.TerminateSessions(context.Background(), &cluster_service.TerminateSessionsRequest{
+		ClusterId: testClusterID,
+		Filter:    &cluster_service.SessionFilter{InfobaseId: testInfobaseID, ExcludeAppIds: []string{"Designer"}},
+		DryRun:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), resp.GetMatched())
+	require.Len(t, resp.GetResults(), 2)
+	for _, result := range resp.GetResults() {
+		assert.Equal(t, cluster_service.SessionTerminationStatus_SESSION_TERMINATION_STATUS_MATCHED, result.GetStatus())
+	}
+	assert.Zero(t, atomic.LoadInt32(&terminated), "dry run terminates nothing")
+}
+
+func TestTerminateSessions_ReportsEverySession(t *testing.T) {
+	var requests []*cluster_service.TerminateSessionRequest
+	srv := sessionsEndpoint(testSessions(time.Now()), func(req *cluster_service.TerminateSessionRequest) error {
+		requests = append(requests, req)
+		if req.GetSessionId() == "s-1" {
+			return errors.New("session is busy")
+		}
+		return nil
+	})
+
+	counter := &countingClient{RASClient: srv.client}
+	srv.client = counter
+
+	resp, err := srv.TerminateSessions(context.Background(), &cluster_service.TerminateSessionsRequest{
+		ClusterId: testClusterID,
+		Filter:    &cluster_service.SessionFilter{ExcludeAppIds: []string{"Designer"}},
+		Message:   "Обновление конфигурации",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), resp.GetMatched())
+	assert.Equal(t, int32(2), resp.GetTerminated())
+	assert.Equal(t, int32(1), resp.GetFailed())
+	assert.Equal(t, int32(1), counter.opened.Load(), "one endpoint serves every termination")
+	var results []*serializev1.SessionInfo
+	for _, r := range resp.GetResults() {
+		results = append(results, r.GetSession())
+	}
+	assert.Equal(t, []string{"s-1", "s-3", "s-4"}, sessionIDs(results), "results keep the session order")
+	assert.Equal(t, cluster_service.SessionTerminationStatus_SESSION_TERMINATION_STATUS_FAILED, resp.GetResults()[0].GetStatus())
+	assert.Contains(t, resp.GetResults()[0].GetError(), "busy")
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, "Обновление конфигурации", requests[0].GetMessage())
+	var terminated []string
+	for _, req := range requests {
+		terminated = append(terminated, req.GetSessionId())
+	}
+	assert.Equal(t, []string{"s-1", "s-3", "s-4"}, terminated, "sessions are terminated one by one")
+}
+
+func TestTerminateSessions_RequiresFilter(t *testing.T) {
+	srv := sessionsEndpoint(testSessions(time.Now()))
+
+	_, err := srv.TerminateSessions(context.Background(), &cluster_service.TerminateSessionsRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.TerminateSessions(context.Background(), &cluster_service.TerminateSessionsRequest{
+		ClusterId: testClusterID,
+		Filter:    &cluster_service.SessionFilter{},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "empty filter matches the whole cluster")
+}
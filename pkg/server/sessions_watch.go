@@ -0,0 +1,463 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	"github.com/v8platform/ras-grpc-gw/pkg/client"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSessionsWatchInterval = 5 * time.Second
+	minSessionsWatchInterval     = time.Second
+
+	// sessionsWatchBacklog is the number of undelivered polls after which
+	// a subscriber is considered stuck and its stream is closed
+	sessionsWatchBacklog = 16
+)
+
+// LoadSessionsWatchInterval reads the RAS poll interval of WatchSessions
+// from SESSIONS_WATCH_INTERVAL
+func LoadSessionsWatchInterval() (time.Duration, error) {
+	value := os.Getenv("SESSIONS_WATCH_INTERVAL")
+	if value == "" {
+		return defaultSessionsWatchInterval, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < minSessionsWatchInterval {
+		return 0, fmt.Errorf("invalid SESSIONS_WATCH_INTERVAL %q: want a duration of at least %s", value, minSessionsWatchInterval)
+	}
+	return d, nil
+}
+
+// WatchSessions streams the changes of the sessions matching the filter.
+// The sessions present at subscription are sent first as EXISTING events.
+func (s *rasClientServiceServer) WatchSessions(request *cluster_service.WatchSessionsRequest, stream cluster_service.SessionsService_WatchSessionsServer) error {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	sub := s.watchers.subscribe(ctx, request.GetClusterId(), request.GetFilter())
+	defer s.watchers.unsubscribe(sub)
+
+	logger.Log.Info("WatchSessions subscribed", zap.String("cluster_id", request.GetClusterId()))
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case events, ok := <-sub.events:
+			if !ok {
+				return sub.err
+			}
+			for _, event := range events {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// sessionWatchers shares one RAS poll per cluster between all
+// WatchSessions subscribers of the cluster
+type sessionWatchers struct {
+	interval time.Duration
+	open     func(ctx context.Context, clusterID string) (sessionsReader, error)
+
+	mu      sync.Mutex
+	pollers map[string]*sessionPoller
+}
+
+// sessionPoller polls the sessions of a cluster while it has subscribers
+type sessionPoller struct {
+	key       string
+	clusterID string
+	cancel    context.CancelFunc
+	subs      map[*sessionSubscriber]struct{}
+	snapshot  []*serializev1.SessionInfo // nil until the first poll
+	polledAt  time.Time
+}
+
+// sessionSubscriber receives the events of one WatchSessions stream.
+// events is closed by the poller, err tells why.
+type sessionSubscriber struct {
+	poller *sessionPoller
+	filter *cluster_service.SessionFilter
+	events chan []*cluster_service.SessionEvent
+	err    error
+}
+
+// sessionsReader reads the sessions of the cluster of a poller on every
+// tick. It is closed once the poller stops.
+type sessionsReader interface {
+	Sessions(ctx context.Context) ([]*serializev1.SessionInfo, error)
+	Close() error
+}
+
+func newSessionWatchers(open func(ctx context.Context, clusterID string) (sessionsReader, error), interval time.Duration) *sessionWatchers {
+	return &sessionWatchers{
+		interval: interval,
+		open:     open,
+		pollers:  make(map[string]*sessionPoller),
+	}
+}
+
+// subscribe registers a subscriber for clusterID, the poll of the cluster
+// starts with its first subscriber. Subscribers routed to different RAS
+// services or passing their own endpoint_id get separate polls.
+func (w *sessionWatchers) subscribe(ctx context.Context, clusterID string, filter *cluster_service.SessionFilter) *sessionSubscriber {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	route := metadata.MD{}
+	for _, key := range []string{metadataClientID, metadataRASHost, "endpoint_id"} {
+		if values := md.Get(key); len(values) > 0 {
+			route.Set(key, values[0])
+		}
+	}
+	key := strings.Join([]string{
+		clusterID,
+		firstMetadata(route, metadataClientID),
+		firstMetadata(route, metadataRASHost),
+		firstMetadata(route, "endpoint_id"),
+	}, "|")
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	poller, ok := w.pollers[key]
+	if !ok {
+		pollCtx, cancel := context.WithCancel(withRouteCluster(metadata.NewIncomingContext(context.Background(), route), clusterID))
+		poller = &sessionPoller{
+			key:       key,
+			clusterID: clusterID,
+			cancel:    cancel,
+			subs:      make(map[*sessionSubscriber]struct{}),
+		}
+		w.pollers[key] = poller
+		go w.run(pollCtx, poller)
+	}
+
+	sub := &sessionSubscriber{
+		poller: poller,
+		filter: filter,
+		events: make(chan []*cluster_service.SessionEvent, sessionsWatchBacklog),
+	}
+	poller.subs[sub] = struct{}{}
+	if poller.snapshot != nil {
+		sub.events <- sub.match(existingSessionEvents(poller.snapshot, poller.polledAt), poller.polledAt)
+	}
+
+	return sub
+}
+
+// unsubscribe removes sub, the poll stops with the last subscriber
+func (w *sessionWatchers) unsubscribe(sub *sessionSubscriber) {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	poller := sub.poller
+	if _, ok := poller.subs[sub]; !ok {
+		return
+	}
+	delete(poller.subs, sub)
+	if len(poller.subs) == 0 {
+		w.stop(poller, nil)
+	}
+}
+
+// openSessionsReader opens the endpoint a poller reads the sessions of
+// clusterID on. The endpoint of the subscriber's endpoint_id is used as
+// the subscriber authenticated it, otherwise a new endpoint is
+// authenticated once with the stored cluster administrator.
+func (s *rasClientServiceServer) openSessionsReader(ctx context.Context, clusterID string) (sessionsReader, error) {
+
+	endpoint, err := s.client.GetEndpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	id := client.EndpointID(endpoint)
+	_, stored := s.vault.Cluster(clusterID)
+	reader := &endpointSessionsReader{
+		client:    s.client,
+		endpoint:  endpoint,
+		clusterID: clusterID,
+		borrowed:  id != "" && slices.Contains(md.Get("endpoint_id"), id),
+	}
+	reader.credentials = reader.borrowed || stored
+
+	if err := s.authenticateCluster(ctx, endpoint, clusterID); err != nil {
+		_ = reader.Close()
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+// endpointSessionsReader reads the sessions of a cluster on one endpoint
+type endpointSessionsReader struct {
+	client    RASClient
+	endpoint  clientv1.EndpointServiceImpl
+	clusterID string
+
+	borrowed    bool // The endpoint belongs to the subscriber and stays open
+	credentials bool // The endpoint is authenticated by the subscriber or the vault
+	polled      bool
+}
+
+func (r *endpointSessionsReader) Sessions(ctx context.Context) ([]*serializev1.SessionInfo, error) {
+	sessions, err := endpointSessions(ctx, r.endpoint, r.clusterID)
+	if err != nil && !r.polled && !r.credentials {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"sessions of cluster '%s' are unavailable without the cluster administrator: "+
+				"pass the endpoint_id authenticated by AuthenticateCluster or store the credentials in the vault: %v",
+			r.clusterID, err)
+	}
+	r.polled = r.polled || err == nil
+	return sessions, err
+}
+
+// Close closes the endpoint, endpoints of clients unable to close them
+// are left to the idle eviction
+func (r *endpointSessionsReader) Close() error {
+
+	closer, ok := r.client.(endpointCloser)
+	id := client.EndpointID(r.endpoint)
+	if !ok || id == "" || r.borrowed {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return closer.CloseEndpoint(ctx, id)
+}
+
+// run polls the cluster until the poller is stopped or a poll fails
+func (w *sessionWatchers) run(ctx context.Context, poller *sessionPoller) {
+
+	// One reader serves every poll of the poller
+	reader, err := w.open(ctx, poller.clusterID)
+	if err == nil {
+		defer func() {
+			if err := reader.Close(); err != nil {
+				logger.Log.Warn("WatchSessions failed to close sessions reader",
+					zap.String("cluster_id", poller.clusterID),
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		var sessions []*serializev1.SessionInfo
+		if err == nil {
+			sessions, err = reader.Sessions(ctx)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Log.Warn("WatchSessions poll failed",
+				zap.String("cluster_id", poller.clusterID),
+				zap.Error(err),
+			)
+			if _, ok := status.FromError(err); !ok {
+				err = status.Errorf(codes.Unavailable, "sessions poll failed: %v", err)
+			}
+			w.mu.Lock()
+			w.stop(poller, err)
+			w.mu.Unlock()
+			return
+		}
+
+		w.publish(poller, sessions, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish diffs sessions with the previous snapshot and delivers the
+// events to every subscriber
+func (w *sessionWatchers) publish(poller *sessionPoller, sessions []*serializev1.SessionInfo, now time.Time) {
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.pollers[poller.key] != poller {
+		return
+	}
+
+	first := poller.snapshot == nil
+	var events []*cluster_service.SessionEvent
+	if first {
+		events = existingSessionEvents(sessions, now)
+	} else {
+		events = diffSessions(poller.snapshot, sessions, now)
+	}
+	poller.snapshot = sessions
+	if poller.snapshot == nil {
+		poller.snapshot = []*serializev1.SessionInfo{}
+	}
+	poller.polledAt = now
+
+	if !first && len(events) == 0 {
+		return
+	}
+	for sub := range poller.subs {
+		matched := sub.match(events, now)
+		if !first && len(matched) == 0 {
+			continue
+		}
+		select {
+		case sub.events <- matched:
+		default:
+			w.drop(sub, status.Error(codes.ResourceExhausted, "session watcher is too slow, events were dropped"))
+		}
+	}
+	if len(poller.subs) == 0 {
+		w.stop(poller, nil)
+	}
+}
+
+// stop ends poller and closes its subscribers with err. Callers must hold w.mu.
+func (w *sessionWatchers) stop(poller *sessionPoller, err error) {
+	poller.cancel()
+	if w.pollers[poller.key] == poller {
+		delete(w.pollers, poller.key)
+	}
+	for sub := range poller.subs {
+		w.drop(sub, err)
+	}
+}
+
+// drop closes the stream of sub with err. Callers must hold w.mu.
+func (w *sessionWatchers) drop(sub *sessionSubscriber, err error) {
+	delete(sub.poller.subs, sub)
+	sub.err = err
+	close(sub.events)
+}
+
+// match returns the events of sessions matching the filter of sub,
+// ended sessions are matched by their last known state
+func (sub *sessionSubscriber) match(events []*cluster_service.SessionEvent, now time.Time) []*cluster_service.SessionEvent {
+	matched := make([]*cluster_service.SessionEvent, 0, len(events))
+	for _, event := range events {
+		if matchSession(event.GetSession(), sub.filter, now) {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
+
+// existingSessionEvents reports every session as present at subscription
+func existingSessionEvents(sessions []*serializev1.SessionInfo, now time.Time) []*cluster_service.SessionEvent {
+	observedAt := timestamppb.New(now)
+	events := make([]*cluster_service.SessionEvent, 0, len(sessions))
+	for _, session := range sessions {
+		events = append(events, &cluster_service.SessionEvent{
+			Type:       cluster_service.SessionEventType_SESSION_EVENT_TYPE_EXISTING,
+			Session:    session,
+			ObservedAt: observedAt,
+		})
+	}
+	return events
+}
+
+// diffSessions returns the changes between two session snapshots,
+// ordered by session UUID
+func diffSessions(prev, cur []*serializev1.SessionInfo, now time.Time) []*cluster_service.SessionEvent {
+
+	observedAt := timestamppb.New(now)
+	event := func(eventType cluster_service.SessionEventType, session, previous *serializev1.SessionInfo) *cluster_service.SessionEvent {
+		return &cluster_service.SessionEvent{
+			Type:       eventType,
+			Session:    session,
+			Previous:   previous,
+			ObservedAt: observedAt,
+		}
+	}
+
+	before := make(map[string]*serializev1.SessionInfo, len(prev))
+	for _, session := range prev {
+		before[session.GetUuid()] = session
+	}
+	after := make(map[string]*serializev1.SessionInfo, len(cur))
+	for _, session := range cur {
+		after[session.GetUuid()] = session
+	}
+
+	var events []*cluster_service.SessionEvent
+	for id, session := range after {
+		old, ok := before[id]
+		if !ok {
+			events = append(events, event(cluster_service.SessionEventType_SESSION_EVENT_TYPE_STARTED, session, nil))
+			continue
+		}
+		if !old.GetHibernate() && session.GetHibernate() {
+			events = append(events, event(cluster_service.SessionEventType_SESSION_EVENT_TYPE_HIBERNATED, session, old))
+		}
+		if old.GetHibernate() && !session.GetHibernate() {
+			events = append(events, event(cluster_service.SessionEventType_SESSION_EVENT_TYPE_AWAKENED, session, old))
+		}
+		if !sameLicenses(old.GetLicenses(), session.GetLicenses()) {
+			events = append(events, event(cluster_service.SessionEventType_SESSION_EVENT_TYPE_LICENSE_CHANGED, session, old))
+		}
+		if old.GetBlockedByDbms() != session.GetBlockedByDbms() || old.GetBlockedByLs() != session.GetBlockedByLs() {
+			events = append(events, event(cluster_service.SessionEventType_SESSION_EVENT_TYPE_BLOCKED_CHANGED, session, old))
+		}
+	}
+	for id, old := range before {
+		if _, ok := after[id]; !ok {
+			events = append(events, event(cluster_service.SessionEventType_SESSION_EVENT_TYPE_ENDED, old, nil))
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if a, b := events[i].GetSession().GetUuid(), events[j].GetSession().GetUuid(); a != b {
+			return a < b
+		}
+		return events[i].GetType() < events[j].GetType()
+	})
+	return events
+}
+
+func sameLicenses(a, b []*serializev1.LicenseInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,294 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	protocolv1 "github.com/v8platform/protos/gen/ras/protocol/v1"
+	serializev1 "github.com/v8platform/protos/gen/v8platform/serialize/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// scriptedPoll returns the snapshots in order, repeating the last one
+type scriptedPoll struct {
+	mu        sync.Mutex
+	snapshots [][]*serializev1.SessionInfo
+	calls     int
+	closed    int
+	err       error
+}
+
+func (p *scriptedPoll) open(ctx context.Context, clusterID string) (sessionsReader, error) {
+	return p, nil
+}
+
+func (p *scriptedPoll) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed++
+	return nil
+}
+
+func (p *scriptedPoll) Sessions(ctx context.Context) ([]*serializev1.SessionInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	i := p.calls
+	p.calls++
+	if p.err != nil && i > 0 {
+		return nil, p.err
+	}
+	if i >= len(p.snapshots) {
+		i = len(p.snapshots) - 1
+	}
+	return p.snapshots[i], nil
+}
+
+// watchStream collects the events sent to a WatchSessions stream
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	mu     sync.Mutex
+	events []*cluster_service.SessionEvent
+}
+
+func (s *watchStream) Context() context.Context { return s.ctx }
+
+func (s *watchStream) Send(event *cluster_service.SessionEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *watchStream) types() []cluster_service.SessionEventType {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var types []cluster_service.SessionEventType
+	for _, e := range s.events {
+		types = append(types, e.GetType())
+	}
+	return types
+}
+
+func TestDiffSessions(t *testing.T) {
+	prev := []*serializev1.SessionInfo{
+		{Uuid: "s-1", AppId: "1CV8C"},
+		{Uuid: "s-2", AppId: "1CV8C", Licenses: []*serializev1.LicenseInfo{{LicenseType: 0}}},
+		{Uuid: "s-3", AppId: "Designer", Hibernate: true},
+		{Uuid: "s-4", AppId: "BackgroundJob"},
+	}
+	cur := []*serializev1.SessionInfo{
+		{Uuid: "s-1", AppId: "1CV8C", Hibernate: true, BlockedByDbms: 12},
+		{Uuid: "s-2", AppId: "1CV8C", Licenses: []*serializev1.LicenseInfo{{LicenseType: 1}}},
+		{Uuid: "s-3", AppId: "Designer"},
+		{Uuid: "s-5", AppId: "WebClient"},
+	}
+
+	var got []string
+	for _, e := range diffSessions(prev, cur, time.Now()) {
+		got = append(got, e.GetSession().GetUuid()+" "+e.GetType().String())
+	}
+	assert.Equal(t, []string{
+		"s-1 SESSION_EVENT_TYPE_HIBERNATED",
+		"s-1 SESSION_EVENT_TYPE_BLOCKED_CHANGED",
+		"s-2 SESSION_EVENT_TYPE_LICENSE_CHANGED",
+		"s-3 SESSION_EVENT_TYPE_AWAKENED",
+		"s-4 SESSION_EVENT_TYPE_ENDED",
+		"s-5 SESSION_EVENT_TYPE_STARTED",
+	}, got)
+
+	assert.Empty(t, diffSessions(cur, cur, time.Now()))
+}
+
+func TestWatchSessions_SharedPoll(t *testing.T) {
+	poll := &scriptedPoll{snapshots: [][]*serializev1.SessionInfo{
+		{{Uuid: "s-1", AppId: "1CV8C"}, {Uuid: "s-2", AppId: "Designer"}},
+		{{Uuid: "s-1", AppId: "1CV8C"}, {Uuid: "s-2", AppId: "Designer"}, {Uuid: "s-3", AppId: "1CV8C"}},
+	}}
+	srv := newRasClientServiceServer(nil)
+	srv.watchers = newSessionWatchers(poll.open, 20*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	all := &watchStream{ctx: ctx}
+	clients := &watchStream{ctx: ctx}
+
+	var wg sync.WaitGroup
+	for _, watch := range []struct {
+		stream *watchStream
+		filter *cluster_service.SessionFilter
+	}{
+		{all, nil},
+		{clients, &cluster_service.SessionFilter{ExcludeAppIds: []string{"Designer"}}},
+	} {
+		wg.Add(1)
+		go func(stream *watchStream, filter *cluster_service.SessionFilter) {
+			defer wg.Done()
+			err := srv.WatchSessions(&cluster_service.WatchSessionsRequest{ClusterId: testClusterID, Filter: filter}, stream)
+			assert.NoError(t, err)
+		}(watch.stream, watch.filter)
+	}
+
+	require.Eventually(t, func() bool { return len(clients.types()) == 2 && len(all.types()) == 3 }, time.Second, 5*time.Millisecond)
+
+	srv.watchers.mu.Lock()
+	assert.Len(t, srv.watchers.pollers, 1, "one poll serves every subscriber of the cluster")
+	srv.watchers.mu.Unlock()
+
+	cancel()
+	wg.Wait()
+
+	assert.Equal(t, []cluster_service.SessionEventType{
+		cluster_service.SessionEventType_SESSION_EVENT_TYPE_EXISTING,
+		cluster_service.SessionEventType_SESSION_EVENT_TYPE_STARTED,
+	}, clients.types())
+	assert.Equal(t, "s-3", clients.events[1].GetSession().GetUuid())
+
+	srv.watchers.mu.Lock()
+	assert.Empty(t, srv.watchers.pollers, "the poll stops with the last subscriber")
+	srv.watchers.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		poll.mu.Lock()
+		defer poll.mu.Unlock()
+		return poll.closed == 1
+	}, time.Second, 5*time.Millisecond, "the reader is closed with the poll")
+}
+
+// numberedEndpoint is a mock endpoint with a RAS endpoint ID
+type numberedEndpoint struct {
+	*MockEndpoint
+	protocolv1.EndpointImpl
+}
+
+func TestWatchSessions_ReusesEndpoint(t *testing.T) {
+	var polls atomic.Int32
+	endpoint := numberedEndpoint{
+		MockEndpoint: &MockEndpoint{
+			RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+				polls.Add(1)
+				return anypb.New(&messagesv1.GetSessionsResponse{Sessions: testSessions(time.Now())})
+			},
+		},
+		EndpointImpl: protocolv1.NewEndpoint(7, 10),
+	}
+	counter := &countingClient{RASClient: &MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	}}
+	srv := newRasClientServiceServer(counter)
+	srv.watchers = newSessionWatchers(srv.openSessionsReader, 5*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.WatchSessions(&cluster_service.WatchSessionsRequest{ClusterId: testClusterID}, &watchStream{ctx: ctx})
+	}()
+
+	require.Eventually(t, func() bool { return polls.Load() >= 5 }, time.Second, time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.Equal(t, int32(1), counter.opened.Load(), "every poll reuses the endpoint")
+	require.Eventually(t, func() bool { return counter.closed.Load() == 1 }, time.Second, time.Millisecond,
+		"the endpoint is closed with the poll")
+}
+
+func TestWatchSessions_UsesCallerEndpoint(t *testing.T) {
+	authenticated := numberedEndpoint{
+		MockEndpoint: &MockEndpoint{
+			RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+				return anypb.New(&messagesv1.GetSessionsResponse{Sessions: testSessions(time.Now())})
+			},
+		},
+		EndpointImpl: protocolv1.NewEndpoint(7, 10),
+	}
+	fresh := numberedEndpoint{
+		MockEndpoint: &MockEndpoint{
+			RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+				return nil, errors.New("Администратор кластера не аутентифицирован")
+			},
+		},
+		EndpointImpl: protocolv1.NewEndpoint(8, 10),
+	}
+	counter := &countingClient{RASClient: &MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			if len(md.Get("endpoint_id")) > 0 {
+				return authenticated, nil
+			}
+			return fresh, nil
+		},
+	}}
+	srv := newRasClientServiceServer(counter)
+	srv.watchers = newSessionWatchers(srv.openSessionsReader, 5*time.Millisecond)
+
+	// Neither the caller nor the vault authenticate the endpoint
+	err := srv.WatchSessions(&cluster_service.WatchSessionsRequest{ClusterId: testClusterID}, &watchStream{ctx: context.Background()})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "AuthenticateCluster")
+	require.Eventually(t, func() bool { return counter.closed.Load() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(metadata.NewIncomingContext(context.Background(), metadata.Pairs("endpoint_id", "7")))
+	stream := &watchStream{ctx: ctx}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.WatchSessions(&cluster_service.WatchSessionsRequest{ClusterId: testClusterID}, stream)
+	}()
+
+	require.Eventually(t, func() bool { return len(stream.types()) > 0 }, time.Second, time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	srv.watchers.mu.Lock()
+	assert.Empty(t, srv.watchers.pollers)
+	srv.watchers.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, int32(1), counter.closed.Load(), "the endpoint of the caller stays open")
+}
+
+func TestWatchSessions_PollError(t *testing.T) {
+	poll := &scriptedPoll{
+		snapshots: [][]*serializev1.SessionInfo{{{Uuid: "s-1"}}},
+		err:       errors.New("connection refused"),
+	}
+	srv := newRasClientServiceServer(nil)
+	srv.watchers = newSessionWatchers(poll.open, 10*time.Millisecond)
+
+	stream := &watchStream{ctx: context.Background()}
+	err := srv.WatchSessions(&cluster_service.WatchSessionsRequest{ClusterId: testClusterID}, stream)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, []cluster_service.SessionEventType{cluster_service.SessionEventType_SESSION_EVENT_TYPE_EXISTING}, stream.types())
+
+	err = srv.WatchSessions(&cluster_service.WatchSessionsRequest{}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestLoadSessionsWatchInterval(t *testing.T) {
+	t.Setenv("SESSIONS_WATCH_INTERVAL", "")
+	d, err := LoadSessionsWatchInterval()
+	require.NoError(t, err)
+	assert.Equal(t, defaultSessionsWatchInterval, d)
+
+	t.Setenv("SESSIONS_WATCH_INTERVAL", "30s")
+	d, err = LoadSessionsWatchInterval()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, d)
+
+	t.Setenv("SESSIONS_WATCH_INTERVAL", "100ms")
+	_, err = LoadSessionsWatchInterval()
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+)
+
+var _ cluster_service.WorkingProcessesServiceServer = (*rasClientServiceServer)(nil)
+
+// GetWorkingProcesses lists the working processes of a cluster
+func (s *rasClientServiceServer) GetWorkingProcesses(ctx context.Context, request *cluster_service.GetWorkingProcessesRequest) (*cluster_service.GetWorkingProcessesResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetWorkingProcessesResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	for _, process := range resp.GetProcesses() {
+		process.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetServerWorkingProcesses lists the working processes started by a working server
+func (s *rasClientServiceServer) GetServerWorkingProcesses(ctx context.Context, request *cluster_service.GetServerWorkingProcessesRequest) (*cluster_service.GetServerWorkingProcessesResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetServerWorkingProcessesResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	for _, process := range resp.GetProcesses() {
+		process.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetWorkingProcessInfo returns the full information of a working process
+func (s *rasClientServiceServer) GetWorkingProcessInfo(ctx context.Context, request *cluster_service.GetWorkingProcessInfoRequest) (*cluster_service.GetWorkingProcessInfoResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "process_id", request.GetProcessId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetWorkingProcessInfoResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	if resp.GetInfo() != nil {
+		resp.Info.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
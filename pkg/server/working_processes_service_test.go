@@ -0,0 +1,105 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	messagesv1 "github.com/v8platform/protos/gen/ras/messages/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testClusterID = "8f6d7a2e-2f0b-4c55-9d43-7a1b2c3d4e5f"
+	testProcessID = "0a1b2c3d-4e5f-6071-8293-a4b5c6d7e8f9"
+)
+
+// respondingServer is a RAS service answering every request with respond
+func respondingServer(respond proto.Message, requests *[]*anypb.Any) *rasClientServiceServer {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			*requests = append(*requests, req.Request)
+			return anypb.New(respond)
+		},
+	}
+	return newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+}
+
+func TestGetWorkingProcesses(t *testing.T) {
+	var requests []*anypb.Any
+	srv := respondingServer(&cluster_service.GetWorkingProcessesResponse{
+		Processes: []*cluster_service.ProcessInfo{
+			{Uuid: testProcessID, Pid: "4242", MemorySize: 1024, Connections: 3, AvailablePerformance: 150, Enable: true, Running: 1},
+		},
+	}, &requests)
+
+	resp, err := srv.GetWorkingProcesses(context.Background(), &cluster_service.GetWorkingProcessesRequest{ClusterId: testClusterID})
+	require.NoError(t, err)
+	require.Len(t, resp.GetProcesses(), 1)
+
+	process := resp.GetProcesses()[0]
+	assert.Equal(t, "4242", process.GetPid())
+	assert.Equal(t, int32(150), process.GetAvailablePerformance())
+	assert.Equal(t, testClusterID, process.GetClusterId())
+
+	require.Len(t, requests, 1)
+	var sent cluster_service.GetWorkingProcessesRequest
+	require.NoError(t, requests[0].UnmarshalTo(&sent))
+	assert.Equal(t, testClusterID, sent.GetClusterId())
+}
+
+func TestGetWorkingProcessInfo_RequiresIDs(t *testing.T) {
+	var requests []*anypb.Any
+	srv := respondingServer(&cluster_service.GetWorkingProcessInfoResponse{}, &requests)
+
+	_, err := srv.GetWorkingProcessInfo(context.Background(), &cluster_service.GetWorkingProcessInfoRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.GetServerWorkingProcesses(context.Background(), &cluster_service.GetServerWorkingProcessesRequest{ServerId: testProcessID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, requests)
+}
+
+func TestProcessInfo_Encoding(t *testing.T) {
+	resp := &cluster_service.GetWorkingProcessesResponse{
+		Processes: []*cluster_service.ProcessInfo{{
+			Uuid:        testProcessID,
+			AvgCallTime: 1.5,
+			Host:        "srv-1c",
+			Port:        1560,
+			Pid:         "4242",
+			Running:     1,
+			StartedAt:   timestamppb.New(time.Now().Truncate(time.Millisecond)),
+			Reserve:     true,
+		}},
+	}
+	assert.Equal(t, messagesv1.MessageType_GET_WORKING_PROCESSES_RESPONSE, resp.GetMessageType())
+
+	for _, version := range []int32{8, 10} {
+		var buf bytes.Buffer
+		require.NoError(t, resp.Formatter(&buf, version))
+
+		parsed := &cluster_service.GetWorkingProcessesResponse{}
+		require.NoError(t, parsed.Parse(&buf, version))
+		require.Len(t, parsed.GetProcesses(), 1)
+
+		got := parsed.GetProcesses()[0]
+		assert.Equal(t, "srv-1c", got.GetHost())
+		assert.Equal(t, int32(1560), got.GetPort())
+		assert.Equal(t, 1.5, got.GetAvgCallTime())
+		assert.Equal(t, resp.Processes[0].GetStartedAt().AsTime(), got.GetStartedAt().AsTime())
+		assert.Equal(t, version >= 9, got.GetReserve())
+	}
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"github.com/v8platform/ras-grpc-gw/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ cluster_service.WorkingServersServiceServer = (*rasClientServiceServer)(nil)
+
+// GetWorkingServers lists the working servers of a cluster
+func (s *rasClientServiceServer) GetWorkingServers(ctx context.Context, request *cluster_service.GetWorkingServersRequest) (*cluster_service.GetWorkingServersResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetWorkingServersResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	for _, server := range resp.GetServers() {
+		server.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// GetWorkingServerInfo returns the settings of a working server
+func (s *rasClientServiceServer) GetWorkingServerInfo(ctx context.Context, request *cluster_service.GetWorkingServerInfoRequest) (*cluster_service.GetWorkingServerInfoResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId()); err != nil {
+		return nil, err
+	}
+
+	resp := &cluster_service.GetWorkingServerInfoResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+	if resp.GetInfo() != nil {
+		resp.Info.ClusterId = request.GetClusterId()
+	}
+
+	return resp, nil
+}
+
+// RegWorkingServer registers a new working server in a cluster
+func (s *rasClientServiceServer) RegWorkingServer(ctx context.Context, request *cluster_service.RegWorkingServerRequest) (*cluster_service.RegWorkingServerResponse, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId()); err != nil {
+		return nil, err
+	}
+	if request.GetInfo().GetAgentHost() == "" {
+		return nil, status.Error(codes.InvalidArgument, "info.agent_host is required")
+	}
+
+	logger.Log.Info("RegWorkingServer request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("agent_host", request.GetInfo().GetAgentHost()),
+		zap.Int32("agent_port", request.GetInfo().GetAgentPort()),
+	)
+
+	resp := &cluster_service.RegWorkingServerResponse{}
+	if err := s.endpointRequest(ctx, request, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// UpdateWorkingServer changes the given settings of a working server.
+// RAS has no separate update message, the current settings are read
+// and registered again under the same server ID on one endpoint.
+func (s *rasClientServiceServer) UpdateWorkingServer(ctx context.Context, request *cluster_service.UpdateWorkingServerRequest) (*cluster_service.ServerInfo, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId()); err != nil {
+		return nil, err
+	}
+	for _, r := range request.GetPortRanges() {
+		if r.GetLow() <= 0 || r.GetHigh() > 65535 || r.GetLow() > r.GetHigh() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid port range %d:%d", r.GetLow(), r.GetHigh())
+		}
+	}
+
+	var info *cluster_service.ServerInfo
+	err := s.withEndpoint(ctx, func(endpoint clientv1.EndpointServiceImpl) error {
+
+		current := &cluster_service.GetWorkingServerInfoResponse{}
+		err := sendEndpointRequest(ctx, endpoint, &cluster_service.GetWorkingServerInfoRequest{
+			ClusterId: request.GetClusterId(),
+			ServerId:  request.GetServerId(),
+		}, current)
+		if err != nil {
+			return err
+		}
+		if current.GetInfo() == nil {
+			return status.Errorf(codes.NotFound, "working server %s not found", request.GetServerId())
+		}
+
+		info = current.GetInfo()
+		applyServerUpdate(info, request)
+
+		return sendEndpointRequest(ctx, endpoint, &cluster_service.RegWorkingServerRequest{
+			ClusterId: request.GetClusterId(),
+			Info:      info,
+		}, &cluster_service.RegWorkingServerResponse{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info.ClusterId = request.GetClusterId()
+	return info, nil
+}
+
+// UnregWorkingServer removes a working server from a cluster
+func (s *rasClientServiceServer) UnregWorkingServer(ctx context.Context, request *cluster_service.UnregWorkingServerRequest) (*emptypb.Empty, error) {
+
+	if err := requireIDs("cluster_id", request.GetClusterId(), "server_id", request.GetServerId()); err != nil {
+		return nil, err
+	}
+
+	logger.Log.Warn("UnregWorkingServer request",
+		zap.String("cluster_id", request.GetClusterId()),
+		zap.String("server_id", request.GetServerId()),
+	)
+
+	if err := s.endpointRequest(ctx, request, &emptypb.Empty{}); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// applyServerUpdate copies the settings present in request to info
+func applyServerUpdate(info *cluster_service.ServerInfo, request *cluster_service.UpdateWorkingServerRequest) {
+
+	if len(request.GetPortRanges()) > 0 {
+		info.PortRanges = request.GetPortRanges()
+	}
+	if request.InfobasesLimit != nil {
+		info.InfobasesLimit = request.GetInfobasesLimit()
+	}
+	if request.ConnectionsLimit != nil {
+		info.ConnectionsLimit = request.GetConnectionsLimit()
+	}
+	if request.MemoryLimit != nil {
+		info.MemoryLimit = request.GetMemoryLimit()
+	}
+	if request.SafeWorkingProcessesMemoryLimit != nil {
+		info.SafeWorkingProcessesMemoryLimit = request.GetSafeWorkingProcessesMemoryLimit()
+	}
+	if request.SafeCallMemoryLimit != nil {
+		info.SafeCallMemoryLimit = request.GetSafeCallMemoryLimit()
+	}
+	if request.CriticalTotalMemory != nil {
+		info.CriticalTotalMemory = request.GetCriticalTotalMemory()
+	}
+	if request.TemporaryAllowedTotalMemory != nil {
+		info.TemporaryAllowedTotalMemory = request.GetTemporaryAllowedTotalMemory()
+	}
+	if request.TemporaryAllowedTotalMemoryTimeLimit != nil {
+		info.TemporaryAllowedTotalMemoryTimeLimit = request.GetTemporaryAllowedTotalMemoryTimeLimit()
+	}
+	if request.DedicateManagers != nil {
+		info.DedicateManagers = request.GetDedicateManagers()
+	}
+}
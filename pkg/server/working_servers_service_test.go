@@ -0,0 +1,137 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clientv1 "github.com/v8platform/protos/gen/ras/client/v1"
+	cluster_service "github.com/v8platform/ras-grpc-gw/pkg/gen/cluster/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const testServerID = "5c3e2a10-7b4d-4f1e-9a6c-2d8b0e1f3a47"
+
+// serverEndpoint answers GetWorkingServerInfo with info and records
+// the registered servers
+func serverEndpoint(info *cluster_service.ServerInfo, registered *[]*cluster_service.RegWorkingServerRequest) *rasClientServiceServer {
+	endpoint := &MockEndpoint{
+		RequestFunc: func(ctx context.Context, req *clientv1.EndpointRequest) (*anypb.Any, error) {
+			msg, err := req.Request.UnmarshalNew()
+			if err != nil {
+				return nil, err
+			}
+			switch msg := msg.(type) {
+			case *cluster_service.GetWorkingServerInfoRequest:
+				return anypb.New(&cluster_service.GetWorkingServerInfoResponse{Info: proto.Clone(info).(*cluster_service.ServerInfo)})
+			case *cluster_service.RegWorkingServerRequest:
+				*registered = append(*registered, msg)
+				return anypb.New(&cluster_service.RegWorkingServerResponse{ServerId: msg.GetInfo().GetUuid()})
+			case *cluster_service.UnregWorkingServerRequest:
+				return anypb.New(&emptypb.Empty{})
+			}
+			return nil, status.Errorf(codes.Unimplemented, "unexpected %T", msg)
+		},
+	}
+	return newRasClientServiceServer(&MockRASClient{
+		GetEndpointFunc: func(ctx context.Context) (clientv1.EndpointServiceImpl, error) {
+			return endpoint, nil
+		},
+	})
+}
+
+func TestUpdateWorkingServer_KeepsUnsetSettings(t *testing.T) {
+	current := &cluster_service.ServerInfo{
+		Uuid:             testServerID,
+		AgentHost:        "srv-1c",
+		AgentPort:        1540,
+		PortRanges:       []*cluster_service.PortRange{{Low: 1560, High: 1591}},
+		InfobasesLimit:   8,
+		ConnectionsLimit: 128,
+		MemoryLimit:      1 << 30,
+	}
+	var registered []*cluster_service.RegWorkingServerRequest
+	srv := serverEndpoint(current, &registered)
+
+	connections := int32(256)
+	dedicate := cluster_service.DedicateManagers_DEDICATE_MANAGERS_ALL
+	info, err := srv.UpdateWorkingServer(context.Background(), &cluster_service.UpdateWorkingServerRequest{
+		ClusterId:        testClusterID,
+		ServerId:         testServerID,
+		PortRanges:       []*cluster_service.PortRange{{Low: 1600, High: 1650}},
+		ConnectionsLimit: &connections,
+		DedicateManagers: &dedicate,
+	})
+	require.NoError(t, err)
+	require.Len(t, registered, 1)
+
+	sent := registered[0].GetInfo()
+	assert.Equal(t, testServerID, sent.GetUuid())
+	assert.Equal(t, int32(1600), sent.GetPortRanges()[0].GetLow())
+	assert.Equal(t, int32(256), sent.GetConnectionsLimit())
+	assert.Equal(t, int32(8), sent.GetInfobasesLimit(), "unset limits are kept")
+	assert.Equal(t, int64(1<<30), sent.GetMemoryLimit())
+	assert.Equal(t, dedicate, sent.GetDedicateManagers())
+	assert.Equal(t, testClusterID, info.GetClusterId())
+}
+
+func TestUpdateWorkingServer_InvalidPortRange(t *testing.T) {
+	var registered []*cluster_service.RegWorkingServerRequest
+	srv := serverEndpoint(&cluster_service.ServerInfo{}, &registered)
+
+	_, err := srv.UpdateWorkingServer(context.Background(), &cluster_service.UpdateWorkingServerRequest{
+		ClusterId:  testClusterID,
+		ServerId:   testServerID,
+		PortRanges: []*cluster_service.PortRange{{Low: 1700, High: 1600}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, registered)
+}
+
+func TestRegUnregWorkingServer(t *testing.T) {
+	var registered []*cluster_service.RegWorkingServerRequest
+	srv := serverEndpoint(nil, &registered)
+	ctx := context.Background()
+
+	_, err := srv.RegWorkingServer(ctx, &cluster_service.RegWorkingServerRequest{ClusterId: testClusterID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := srv.RegWorkingServer(ctx, &cluster_service.RegWorkingServerRequest{
+		ClusterId: testClusterID,
+		Info:      &cluster_service.ServerInfo{Uuid: testServerID, AgentHost: "srv-2", AgentPort: 1540},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, testServerID, resp.GetServerId())
+
+	_, err = srv.UnregWorkingServer(ctx, &cluster_service.UnregWorkingServerRequest{ClusterId: testClusterID, ServerId: testServerID})
+	require.NoError(t, err)
+}
+
+func TestServerInfo_Encoding(t *testing.T) {
+	req := &cluster_service.RegWorkingServerRequest{
+		ClusterId: testClusterID,
+		Info: &cluster_service.ServerInfo{
+			AgentHost:                   "srv-1c",
+			AgentPort:                   1540,
+			PortRanges:                  []*cluster_service.PortRange{{Low: 1560, High: 1591}, {Low: 1600, High: 1610}},
+			DedicateManagers:            cluster_service.DedicateManagers_DEDICATE_MANAGERS_ALL,
+			SafeCallMemoryLimit:         512 << 20,
+			TemporaryAllowedTotalMemory: 8 << 30,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, req.Formatter(&buf, 10))
+
+	parsed := &cluster_service.RegWorkingServerRequest{}
+	require.NoError(t, parsed.Parse(&buf, 10))
+	parsed.Info.Uuid = ""
+	req.Info.Uuid = ""
+	assert.True(t, proto.Equal(req, parsed), "got %v", parsed)
+}
@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// Config holds the credential vault settings
+type Config struct {
+	File      string // Vault file, the vault is disabled if empty
+	MasterKey []byte
+}
+
+// LoadConfig loads the vault settings from environment variables.
+//
+// Environment variables:
+//
+//	VAULT_FILE=/path             - Encrypted credentials file
+//	VAULT_MASTER_KEY=...         - Master key, at least 32 bytes
+//	VAULT_MASTER_KEY_FILE=/path  - File holding the master key
+func LoadConfig(logger *zap.Logger) (*Config, error) {
+
+	cfg := &Config{File: os.Getenv("VAULT_FILE")}
+
+	switch key, keyFile := os.Getenv("VAULT_MASTER_KEY"), os.Getenv("VAULT_MASTER_KEY_FILE"); {
+	case key != "":
+		cfg.MasterKey = []byte(key)
+	case keyFile != "":
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read vault master key: %w", err)
+		}
+		cfg.MasterKey = []byte(strings.TrimSpace(string(data)))
+	}
+
+	if cfg.File == "" {
+		logger.Info("VAULT_FILE not set, stored RAS credentials are disabled")
+		return cfg, nil
+	}
+	if len(cfg.MasterKey) == 0 {
+		return nil, errors.New("VAULT_FILE requires VAULT_MASTER_KEY or VAULT_MASTER_KEY_FILE")
+	}
+
+	return cfg, nil
+}
+
+// Open opens the vault of the config, nil if the vault is disabled
+func (c *Config) Open() (*Vault, error) {
+	if c.File == "" {
+		return nil, nil
+	}
+	return Open(c.File, c.MasterKey)
+}
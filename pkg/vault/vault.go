@@ -0,0 +1,358 @@
+// Package vault keeps RAS credentials of the gateway encrypted at rest.
+//
+// Credentials are stored per cluster and infobase:
+//   - infobase "" holds the cluster administrator
+//   - infobase "*" holds the default infobase user of the cluster
+//   - any other infobase ID holds the user of that infobase
+//
+// The vault file is a JSON envelope with the AES-256-GCM sealed entries.
+// The encryption key is derived from the master key with HKDF-SHA256, the
+// master key itself never touches the disk.
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MinMasterKeySize is the minimum length of the master key in bytes
+const MinMasterKeySize = 32
+
+// AnyInfobase stores the credentials used for infobases
+// without credentials of their own
+const AnyInfobase = "*"
+
+const (
+	fileVersion = 1
+	keyInfo     = "ras-grpc-gw credential vault"
+)
+
+var (
+	ErrNotFound = errors.New("credentials not found")
+	// ErrWrongKey is returned when the vault file cannot be decrypted
+	ErrWrongKey = errors.New("vault file cannot be decrypted with the master key")
+)
+
+// Credentials of a RAS cluster administrator or infobase user
+type Credentials struct {
+	User     string `json:"user"`
+	Password string `json:"password"`
+}
+
+// Entry describes stored credentials without the password
+type Entry struct {
+	ClusterID  string
+	InfobaseID string
+	User       string
+	UpdatedAt  time.Time
+}
+
+type record struct {
+	ClusterID  string      `json:"cluster_id"`
+	InfobaseID string      `json:"infobase_id,omitempty"`
+	Creds      Credentials `json:"credentials"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// envelope is the on-disk format of the vault
+type envelope struct {
+	Version int    `json:"version"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// Vault is a file of encrypted credentials. It is safe for concurrent use.
+// A nil *Vault holds no credentials.
+type Vault struct {
+	path string
+	aead cipher.AEAD
+	now  func() time.Time
+
+	mu      sync.RWMutex
+	records map[string]record
+}
+
+// Open opens the vault file at path, a missing file is created
+// on the first change
+func Open(path string, masterKey []byte) (*Vault, error) {
+
+	if len(masterKey) < MinMasterKeySize {
+		return nil, fmt.Errorf("vault master key must be at least %d bytes", MinMasterKeySize)
+	}
+
+	key, err := hkdf.Key(sha256.New, masterKey, nil, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Vault{
+		path:    path,
+		aead:    aead,
+		now:     time.Now,
+		records: map[string]record{},
+	}
+
+	if err := v.load(); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// Get returns the credentials stored for exactly clusterID and infobaseID
+func (v *Vault) Get(clusterID, infobaseID string) (Credentials, bool) {
+	if v == nil {
+		return Credentials{}, false
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	r, ok := v.records[recordKey(clusterID, infobaseID)]
+	return r.Creds, ok
+}
+
+// Cluster returns the administrator credentials of the cluster
+func (v *Vault) Cluster(clusterID string) (Credentials, bool) {
+	return v.Get(clusterID, "")
+}
+
+// Infobase returns the credentials of the infobase, falling back
+// to the default infobase user of the cluster
+func (v *Vault) Infobase(clusterID, infobaseID string) (Credentials, bool) {
+	if infobaseID != "" {
+		if c, ok := v.Get(clusterID, infobaseID); ok {
+			return c, true
+		}
+	}
+	return v.Get(clusterID, AnyInfobase)
+}
+
+// Set stores the credentials, replacing the stored ones
+func (v *Vault) Set(clusterID, infobaseID string, c Credentials) error {
+
+	if clusterID == "" {
+		return errors.New("cluster ID is required")
+	}
+	if c.User == "" {
+		return errors.New("user is required")
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.update(func(records map[string]record) error {
+		records[recordKey(clusterID, infobaseID)] = record{
+			ClusterID:  clusterID,
+			InfobaseID: infobaseID,
+			Creds:      c,
+			UpdatedAt:  v.now().UTC(),
+		}
+		return nil
+	})
+}
+
+// Rotate replaces the password of the stored credentials
+func (v *Vault) Rotate(clusterID, infobaseID, password string) error {
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.update(func(records map[string]record) error {
+		key := recordKey(clusterID, infobaseID)
+		r, ok := records[key]
+		if !ok {
+			return ErrNotFound
+		}
+		r.Creds.Password = password
+		r.UpdatedAt = v.now().UTC()
+		records[key] = r
+		return nil
+	})
+}
+
+// Delete removes the stored credentials
+func (v *Vault) Delete(clusterID, infobaseID string) error {
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.update(func(records map[string]record) error {
+		key := recordKey(clusterID, infobaseID)
+		if _, ok := records[key]; !ok {
+			return ErrNotFound
+		}
+		delete(records, key)
+		return nil
+	})
+}
+
+// List describes the stored credentials of the cluster, or of all
+// clusters if clusterID is empty, ordered by cluster and infobase
+func (v *Vault) List(clusterID string) []Entry {
+	if v == nil {
+		return nil
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	var res []Entry
+	for _, r := range v.records {
+		if clusterID != "" && r.ClusterID != clusterID {
+			continue
+		}
+		res = append(res, Entry{
+			ClusterID:  r.ClusterID,
+			InfobaseID: r.InfobaseID,
+			User:       r.Creds.User,
+			UpdatedAt:  r.UpdatedAt,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].ClusterID != res[j].ClusterID {
+			return res[i].ClusterID < res[j].ClusterID
+		}
+		return res[i].InfobaseID < res[j].InfobaseID
+	})
+
+	return res
+}
+
+// update applies fn to a copy of the records and saves the result,
+// the vault is left unchanged if either fails
+func (v *Vault) update(fn func(map[string]record) error) error {
+
+	records := make(map[string]record, len(v.records))
+	for k, r := range v.records {
+		records[k] = r
+	}
+
+	if err := fn(records); err != nil {
+		return err
+	}
+	if err := v.save(records); err != nil {
+		return err
+	}
+
+	v.records = records
+	return nil
+}
+
+func (v *Vault) load() error {
+
+	data, err := os.ReadFile(v.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read vault: %w", err)
+	}
+
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return fmt.Errorf("invalid vault file %s: %w", v.path, err)
+	}
+	if env.Version != fileVersion {
+		return fmt.Errorf("unsupported vault file version %d", env.Version)
+	}
+	if len(env.Nonce) != v.aead.NonceSize() {
+		return fmt.Errorf("invalid vault file %s: bad nonce", v.path)
+	}
+
+	plain, err := v.aead.Open(nil, env.Nonce, env.Data, []byte(keyInfo))
+	if err != nil {
+		return ErrWrongKey
+	}
+
+	var records []record
+	if err := json.Unmarshal(plain, &records); err != nil {
+		return fmt.Errorf("invalid vault contents: %w", err)
+	}
+	for _, r := range records {
+		v.records[recordKey(r.ClusterID, r.InfobaseID)] = r
+	}
+
+	return nil
+}
+
+// save seals the records into a temporary file and renames it over the
+// vault, so a crash never leaves a partially written vault
+func (v *Vault) save(records map[string]record) error {
+
+	list := make([]record, 0, len(records))
+	for _, r := range records {
+		list = append(list, r)
+	}
+	plain, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(envelope{
+		Version: fileVersion,
+		Nonce:   nonce,
+		Data:    v.aead.Seal(nil, nonce, plain, []byte(keyInfo)),
+	})
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(v.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create vault dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(v.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write vault: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write vault: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write vault: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write vault: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), v.path); err != nil {
+		return fmt.Errorf("failed to write vault: %w", err)
+	}
+
+	return nil
+}
+
+func recordKey(clusterID, infobaseID string) string {
+	return clusterID + "/" + infobaseID
+}
@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestOpen_RejectsShortKey(t *testing.T) {
+	_, err := Open(filepath.Join(t.TempDir(), "vault.json"), []byte("short"))
+	assert.Error(t, err)
+}
+
+func TestVault_SetGetPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.json")
+
+	v, err := Open(path, testKey)
+	require.NoError(t, err)
+	require.NoError(t, v.Set("cluster-1", "", Credentials{User: "admin", Password: "s3cret"}))
+	require.NoError(t, v.Set("cluster-1", "ib-1", Credentials{User: "ib-user", Password: "ib-pass"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.False(t, strings.Contains(string(data), "s3cret"), "passwords are encrypted")
+	assert.False(t, strings.Contains(string(data), "cluster-1"), "keys are encrypted")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	v, err = Open(path, testKey)
+	require.NoError(t, err)
+
+	c, ok := v.Cluster("cluster-1")
+	require.True(t, ok)
+	assert.Equal(t, Credentials{User: "admin", Password: "s3cret"}, c)
+
+	c, ok = v.Infobase("cluster-1", "ib-1")
+	require.True(t, ok)
+	assert.Equal(t, "ib-user", c.User)
+
+	_, err = Open(path, []byte("another-key-another-key-another-k"))
+	assert.ErrorIs(t, err, ErrWrongKey)
+}
+
+func TestVault_InfobaseFallback(t *testing.T) {
+	v, err := Open(filepath.Join(t.TempDir(), "vault.json"), testKey)
+	require.NoError(t, err)
+
+	_, ok := v.Infobase("cluster-1", "ib-1")
+	assert.False(t, ok)
+
+	require.NoError(t, v.Set("cluster-1", AnyInfobase, Credentials{User: "default"}))
+	c, ok := v.Infobase("cluster-1", "ib-1")
+	require.True(t, ok)
+	assert.Equal(t, "default", c.User)
+
+	_, ok = v.Cluster("cluster-1")
+	assert.False(t, ok, "infobase users are not cluster administrators")
+}
+
+func TestVault_RotateDeleteList(t *testing.T) {
+	v, err := Open(filepath.Join(t.TempDir(), "vault.json"), testKey)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, v.Rotate("cluster-1", "", "new"), ErrNotFound)
+	assert.ErrorIs(t, v.Delete("cluster-1", ""), ErrNotFound)
+
+	require.NoError(t, v.Set("cluster-2", "", Credentials{User: "admin2"}))
+	require.NoError(t, v.Set("cluster-1", "ib-1", Credentials{User: "user"}))
+	require.NoError(t, v.Set("cluster-1", "", Credentials{User: "admin", Password: "old"}))
+
+	require.NoError(t, v.Rotate("cluster-1", "", "new"))
+	c, _ := v.Cluster("cluster-1")
+	assert.Equal(t, Credentials{User: "admin", Password: "new"}, c)
+
+	all := v.List("")
+	require.Len(t, all, 3)
+	assert.Equal(t, []string{"cluster-1/", "cluster-1/ib-1", "cluster-2/"}, []string{
+		all[0].ClusterID + "/" + all[0].InfobaseID,
+		all[1].ClusterID + "/" + all[1].InfobaseID,
+		all[2].ClusterID + "/" + all[2].InfobaseID,
+	})
+	assert.Len(t, v.List("cluster-2"), 1)
+
+	require.NoError(t, v.Delete("cluster-1", "ib-1"))
+	_, ok := v.Infobase("cluster-1", "ib-1")
+	assert.False(t, ok)
+}
+
+func TestVault_Nil(t *testing.T) {
+	var v *Vault
+	_, ok := v.Cluster("cluster-1")
+	assert.False(t, ok)
+	assert.Empty(t, v.List(""))
+}